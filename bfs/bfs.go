@@ -0,0 +1,121 @@
+// Contains algos and logic related to breadth-first graph traversal.
+package bfs
+
+import (
+	"sort"
+
+	"github.com/sdboyer/gogl"
+)
+
+// Performs a breadth-first traversal outward from all of the provided source vertices at once,
+// returning a map of each reached vertex's hop distance to its nearest source.
+//
+// Every source is seeded into the queue at distance 0, so the traversal expands evenly from all
+// of them; the first source to reach a vertex necessarily reaches it by the shortest route.
+// Vertices that are unreachable from every source are absent from the returned map, as are any
+// provided sources that are not present in the graph.
+//
+// If the graph is a Digraph, traversal follows arc direction only.
+func MultiSourceBFS(g gogl.Graph, sources ...gogl.Vertex) map[gogl.Vertex]int {
+	dist := make(map[gogl.Vertex]int)
+	queue := make([]gogl.Vertex, 0, len(sources))
+
+	for _, s := range sources {
+		if _, seen := dist[s]; !seen && g.HasVertex(s) {
+			dist[s] = 0
+			queue = append(queue, s)
+		}
+	}
+
+	for len(queue) > 0 {
+		v := queue[0]
+		queue = queue[1:]
+
+		successorsOf(g, v, func(adj gogl.Vertex) (terminate bool) {
+			if _, seen := dist[adj]; !seen {
+				dist[adj] = dist[v] + 1
+				queue = append(queue, adj)
+			}
+			return
+		})
+	}
+
+	return dist
+}
+
+// Groups the vertices reachable from the given source by their hop distance from it, such
+// that layer i contains exactly the vertices at distance i. Layer 0 is thus just the source
+// itself, layer 1 its neighbors, and so on. Vertices unreachable from the source are
+// omitted; if the source is not present in the graph, the result is empty.
+//
+// Within each layer, vertices are in their natural order (per gogl.VertexLess), so the
+// result is reproducible. If the graph is a Digraph, traversal follows arc direction only.
+func DistanceLayers(g gogl.Graph, source gogl.Vertex) [][]gogl.Vertex {
+	dist := MultiSourceBFS(g, source)
+	if len(dist) == 0 {
+		return nil
+	}
+
+	var max int
+	for _, d := range dist {
+		if d > max {
+			max = d
+		}
+	}
+
+	layers := make([][]gogl.Vertex, max+1)
+	for v, d := range dist {
+		layers[d] = append(layers[d], v)
+	}
+
+	for _, layer := range layers {
+		sort.Slice(layer, func(i, j int) bool {
+			return gogl.VertexLess(layer[i], layer[j])
+		})
+	}
+
+	return layers
+}
+
+// Folds over the vertices reachable from the given start vertex in breadth-first order,
+// threading an accumulator through the given step func: each visited vertex, the start
+// included, is passed to step along with the current accumulator, and the result becomes
+// the accumulator for the next vertex. Returns the final accumulator; if start is not
+// present in the graph, that is init.
+//
+// If the graph is a Digraph, traversal follows arc direction only.
+func Fold[A any](g gogl.Graph, start gogl.Vertex, init A, step func(acc A, v gogl.Vertex) A) A {
+	acc := init
+	if !g.HasVertex(start) {
+		return acc
+	}
+
+	visited := map[gogl.Vertex]struct{}{start: {}}
+	queue := []gogl.Vertex{start}
+
+	for len(queue) > 0 {
+		v := queue[0]
+		queue = queue[1:]
+		acc = step(acc, v)
+
+		successorsOf(g, v, func(adj gogl.Vertex) (terminate bool) {
+			if _, seen := visited[adj]; !seen {
+				visited[adj] = struct{}{}
+				queue = append(queue, adj)
+			}
+			return
+		})
+	}
+
+	return acc
+}
+
+// Enumerates the vertices reachable in a single hop from the given vertex. For digraphs
+// that means successors only; for undirected graphs, all adjacent vertices.
+func successorsOf(g gogl.Graph, v gogl.Vertex, f gogl.VertexStep) {
+	if dg, ok := g.(gogl.Digraph); ok {
+		dg.SuccessorsOf(v, f)
+	} else {
+		g.AdjacentTo(v, f)
+	}
+}
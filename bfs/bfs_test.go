@@ -0,0 +1,155 @@
+package bfs
+
+import (
+	"testing"
+
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+// Hook gocheck into the go test runner
+func Test(t *testing.T) { TestingT(t) }
+
+type cell struct {
+	x, y int
+}
+
+// Builds an undirected w x h grid graph with cell vertices.
+func grid(w, h int) gogl.Graph {
+	el := gogl.EdgeList{}
+	for x := 0; x < w; x++ {
+		for y := 0; y < h; y++ {
+			if x+1 < w {
+				el = append(el, gogl.NewEdge(cell{x, y}, cell{x + 1, y}))
+			}
+			if y+1 < h {
+				el = append(el, gogl.NewEdge(cell{x, y}, cell{x, y + 1}))
+			}
+		}
+	}
+
+	return gogl.Spec().Using(el).Create(al.G)
+}
+
+func abs(i int) int {
+	if i < 0 {
+		return -i
+	}
+	return i
+}
+
+type MultiSourceBFSSuite struct{}
+
+var _ = Suite(&MultiSourceBFSSuite{})
+
+func (s *MultiSourceBFSSuite) TestGridTwoSources(c *C) {
+	g := grid(5, 4)
+	a, b := cell{0, 0}, cell{4, 3}
+
+	dist := MultiSourceBFS(g, a, b)
+	c.Assert(len(dist), Equals, 20)
+
+	for v, d := range dist {
+		cv := v.(cell)
+		da := abs(cv.x-a.x) + abs(cv.y-a.y)
+		db := abs(cv.x-b.x) + abs(cv.y-b.y)
+		if db < da {
+			da = db
+		}
+		c.Assert(d, Equals, da, Commentf("distance mismatch for %v", cv))
+	}
+
+	c.Assert(dist[a], Equals, 0)
+	c.Assert(dist[b], Equals, 0)
+}
+
+func (s *MultiSourceBFSSuite) TestUnreachableAbsent(c *C) {
+	g := gogl.Spec().Directed().Using(gogl.ArcList{
+		gogl.NewArc("foo", "bar"),
+		gogl.NewArc("bar", "baz"),
+		gogl.NewArc("qux", "foo"),
+	}).Create(al.G)
+
+	dist := MultiSourceBFS(g, "foo", "missing")
+	c.Assert(dist, DeepEquals, map[gogl.Vertex]int{"foo": 0, "bar": 1, "baz": 2})
+}
+
+type DistanceLayersSuite struct{}
+
+var _ = Suite(&DistanceLayersSuite{})
+
+func (s *DistanceLayersSuite) TestPath(c *C) {
+	el := gogl.EdgeList{}
+	for i := 0; i < 9; i++ {
+		el = append(el, gogl.NewEdge(i, i+1))
+	}
+	g := gogl.Spec().Using(gogl.WithIsolates(el, "isolate")).Create(al.G)
+
+	layers := DistanceLayers(g, 0)
+	c.Assert(layers, HasLen, 10)
+	for i, layer := range layers {
+		c.Assert(layer, DeepEquals, []gogl.Vertex{i})
+	}
+
+	// from the middle, layers spread both ways
+	layers = DistanceLayers(g, 4)
+	c.Assert(layers, HasLen, 6)
+	c.Assert(layers[0], DeepEquals, []gogl.Vertex{4})
+	c.Assert(layers[1], DeepEquals, []gogl.Vertex{3, 5})
+	c.Assert(layers[5], DeepEquals, []gogl.Vertex{9})
+
+	c.Assert(DistanceLayers(g, "nope"), HasLen, 0)
+	c.Assert(DistanceLayers(g, "isolate"), DeepEquals, [][]gogl.Vertex{{"isolate"}})
+}
+
+func (s *DistanceLayersSuite) TestDirected(c *C) {
+	g := gogl.Spec().Directed().Using(gogl.ArcList{
+		gogl.NewArc("a", "b"),
+		gogl.NewArc("a", "c"),
+		gogl.NewArc("c", "d"),
+		gogl.NewArc("e", "a"),
+	}).Create(al.G)
+
+	c.Assert(DistanceLayers(g, "a"), DeepEquals, [][]gogl.Vertex{{"a"}, {"b", "c"}, {"d"}})
+}
+
+type FoldSuite struct{}
+
+var _ = Suite(&FoldSuite{})
+
+func (s *FoldSuite) TestSumReachable(c *C) {
+	g := gogl.Spec().Directed().Using(gogl.ArcList{
+		gogl.NewArc(1, 2),
+		gogl.NewArc(2, 3),
+		gogl.NewArc(1, 3),
+		gogl.NewArc(3, 1),
+		gogl.NewArc(4, 3),
+		gogl.NewArc(5, 6),
+	}).Create(al.G).(gogl.Graph)
+
+	var manual int
+	for v := range MultiSourceBFS(g, 1) {
+		manual += v.(int)
+	}
+
+	sum := Fold(g, 1, 0, func(acc int, v gogl.Vertex) int {
+		return acc + v.(int)
+	})
+	c.Assert(sum, Equals, manual)
+	c.Assert(sum, Equals, 6)
+}
+
+func (s *FoldSuite) TestBreadthFirstOrder(c *C) {
+	g := grid(4, 4)
+	dist := MultiSourceBFS(g, cell{0, 0})
+
+	// Distances along the visit order never decrease.
+	last := Fold(g, cell{0, 0}, 0, func(acc int, v gogl.Vertex) int {
+		c.Assert(dist[v] >= acc, Equals, true)
+		return dist[v]
+	})
+	c.Assert(last, Equals, 6)
+
+	c.Assert(Fold(g, "missing", 7, func(acc int, v gogl.Vertex) int { return 0 }), Equals, 7)
+}
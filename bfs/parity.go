@@ -0,0 +1,65 @@
+package bfs
+
+import "github.com/sdboyer/gogl"
+
+// A vertex paired with the parity of the number of edges taken to reach it.
+type parityState struct {
+	v   gogl.Vertex
+	odd bool
+}
+
+// Finds a shortest walk from source to target whose number of edges is even (if even is
+// true) or odd, returning it along with its length. The final return value is false if no
+// such walk exists, or either vertex is not present in the graph.
+//
+// This is a breadth-first search over the doubled graph in which each vertex v becomes two
+// states, (v, even) and (v, odd), and each edge leads from one parity to the other. The
+// result is a walk rather than a simple path: meeting a parity constraint may require
+// revisiting a vertex, such as going back and forth over an edge. The walk is made up of
+// the graph's own edges, and the empty walk satisfies an even search from a vertex to
+// itself. If the graph is a Digraph, traversal follows arc direction only.
+func ShortestPathWithParity(g gogl.Graph, source, target gogl.Vertex, even bool) (gogl.Path, int, bool) {
+	if !g.HasVertex(source) || !g.HasVertex(target) {
+		return nil, 0, false
+	}
+
+	type step struct {
+		from parityState
+		e    gogl.Edge
+	}
+
+	start, goal := parityState{source, false}, parityState{target, !even}
+	parent := map[parityState]step{start: {}}
+	queue := []parityState{start}
+
+	for len(queue) > 0 && queue[0] != goal {
+		s := queue[0]
+		queue = queue[1:]
+
+		outEdgesOf(g, s.v, func(e gogl.Edge) (terminate bool) {
+			u, adj := e.Both()
+			if adj == s.v {
+				adj = u
+			}
+			next := parityState{adj, !s.odd}
+			if _, seen := parent[next]; !seen {
+				parent[next] = step{s, e}
+				queue = append(queue, next)
+			}
+			return
+		})
+	}
+
+	if _, reached := parent[goal]; !reached {
+		return nil, 0, false
+	}
+
+	path := gogl.Path{}
+	for s := goal; s != start; s = parent[s].from {
+		path = append(path, parent[s].e)
+	}
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path, len(path), true
+}
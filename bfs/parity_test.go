@@ -0,0 +1,67 @@
+package bfs
+
+import (
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+type ParitySuite struct{}
+
+var _ = Suite(&ParitySuite{})
+
+func (s *ParitySuite) TestUndirected(c *C) {
+	// a-d directly is odd (1 edge); a-b-c-d is odd too (3); the odd cycle b-c-e lets an
+	// even route through: a-b-e-c-d, 4 edges.
+	g := gogl.Spec().Using(gogl.EdgeList{
+		gogl.NewEdge("a", "d"),
+		gogl.NewEdge("a", "b"),
+		gogl.NewEdge("b", "c"),
+		gogl.NewEdge("c", "d"),
+		gogl.NewEdge("b", "e"),
+		gogl.NewEdge("e", "c"),
+	}).Create(al.G)
+
+	path, n, ok := ShortestPathWithParity(g, "a", "d", false)
+	c.Assert(ok, Equals, true)
+	c.Assert(n, Equals, 1)
+	c.Assert(path, HasLen, 1)
+
+	path, n, ok = ShortestPathWithParity(g, "a", "d", true)
+	c.Assert(ok, Equals, true)
+	c.Assert(n, Equals, 4)
+	c.Assert(gogl.IsValidPath(g, path), Equals, true)
+
+	// From a vertex to itself: empty when even, around the triangle when odd.
+	path, n, ok = ShortestPathWithParity(g, "b", "b", true)
+	c.Assert(ok, Equals, true)
+	c.Assert(n, Equals, 0)
+	c.Assert(path, HasLen, 0)
+	_, n, ok = ShortestPathWithParity(g, "b", "b", false)
+	c.Assert(ok, Equals, true)
+	c.Assert(n, Equals, 3)
+}
+
+func (s *ParitySuite) TestBipartiteAndDirected(c *C) {
+	// In a bipartite graph, walks between the two sides are always odd; only going back
+	// and forth is possible, and it never changes parity.
+	g := grid(3, 3)
+	_, _, ok := ShortestPathWithParity(g, cell{0, 0}, cell{1, 0}, true)
+	c.Assert(ok, Equals, false)
+	_, n, ok := ShortestPathWithParity(g, cell{0, 0}, cell{2, 2}, true)
+	c.Assert(ok, Equals, true)
+	c.Assert(n, Equals, 4)
+
+	dg := gogl.Spec().Directed().Using(gogl.ArcList{
+		gogl.NewArc(1, 2),
+		gogl.NewArc(2, 3),
+		gogl.NewArc(3, 1),
+	}).Create(al.G)
+	path, n, ok := ShortestPathWithParity(dg, 1, 2, true)
+	c.Assert(ok, Equals, true)
+	c.Assert(n, Equals, 4)
+	c.Assert(gogl.IsValidPath(dg, path), Equals, true)
+
+	_, _, ok = ShortestPathWithParity(dg, 1, "missing", true)
+	c.Assert(ok, Equals, false)
+}
@@ -0,0 +1,55 @@
+package bfs
+
+import (
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+// Returns the breadth-first spanning tree of the given graph rooted at root: the graph
+// made up of the root, every vertex reachable from it, and for each of those vertices
+// the single edge by which a breadth-first traversal first reached it. All other edges
+// are dropped, as are unreachable vertices, so the result has exactly one fewer edge than
+// it has vertices. Every vertex's depth in the tree is its hop distance from the root.
+//
+// The result is of the same kind as the original (per gogl.SpecOf), and its edges are
+// the graph's own, so weights, labels and data carry over. If the graph is a Digraph,
+// traversal follows arc direction, and every tree arc points away from the root. If root
+// is not present in the graph, the result is empty.
+func SpanningBFSTree(g gogl.Graph, root gogl.Vertex) gogl.Graph {
+	spec := gogl.SpecOf(g)
+	if !g.HasVertex(root) {
+		return spec.Create(al.G)
+	}
+
+	_, directed := g.(gogl.Digraph)
+	arcs, edges := gogl.ArcList{}, gogl.EdgeList{}
+
+	visited := map[gogl.Vertex]struct{}{root: {}}
+	queue := []gogl.Vertex{root}
+	for len(queue) > 0 {
+		v := queue[0]
+		queue = queue[1:]
+
+		outEdgesOf(g, v, func(e gogl.Edge) (terminate bool) {
+			u, adj := e.Both()
+			if adj == v {
+				adj = u
+			}
+			if _, seen := visited[adj]; !seen {
+				visited[adj] = struct{}{}
+				queue = append(queue, adj)
+				if directed {
+					arcs = append(arcs, e.(gogl.Arc))
+				} else {
+					edges = append(edges, e)
+				}
+			}
+			return
+		})
+	}
+
+	if directed {
+		return spec.Using(gogl.WithIsolates(arcs, root)).Create(al.G)
+	}
+	return spec.Using(gogl.WithIsolates(edges, root)).Create(al.G)
+}
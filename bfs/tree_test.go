@@ -0,0 +1,82 @@
+package bfs
+
+import (
+	"math/rand"
+
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+type SpanningTreeSuite struct{}
+
+var _ = Suite(&SpanningTreeSuite{})
+
+// Asserts that the tree has one fewer edge than vertices, and that it spans exactly the
+// vertices reachable from root, each at its distance in the original graph.
+func checkBFSTree(c *C, g, tree gogl.Graph, root gogl.Vertex) {
+	dist := MultiSourceBFS(g, root)
+	c.Assert(gogl.Order(tree), Equals, len(dist))
+	c.Assert(gogl.Size(tree), Equals, len(dist)-1)
+	c.Assert(MultiSourceBFS(tree, root), DeepEquals, dist)
+
+	tree.Edges(func(e gogl.Edge) (terminate bool) {
+		c.Assert(g.HasEdge(e), Equals, true)
+		return
+	})
+}
+
+func (s *SpanningTreeSuite) TestGrid(c *C) {
+	g := grid(6, 5)
+	tree := SpanningBFSTree(g, cell{2, 2})
+	checkBFSTree(c, g, tree, cell{2, 2})
+	_, directed := tree.(gogl.Digraph)
+	c.Assert(directed, Equals, false)
+}
+
+func (s *SpanningTreeSuite) TestRandomWeightedDigraph(c *C) {
+	r := rand.New(rand.NewSource(3))
+	arcs := gogl.WeightedArcList{}
+	for i := 0; i < 150; i++ {
+		arcs = append(arcs, gogl.NewWeightedArc(r.Intn(60), r.Intn(60), float64(i)))
+	}
+	g := gogl.Spec().Directed().Weighted().Using(arcs).Create(al.G)
+
+	tree := SpanningBFSTree(g, 0)
+	checkBFSTree(c, g, tree, 0)
+
+	// Weights carry over, and every vertex but the root has exactly one parent.
+	wt := tree.(gogl.WeightedDigraph)
+	wt.Arcs(func(a gogl.Arc) (terminate bool) {
+		c.Assert(g.(gogl.WeightedDigraph).HasWeightedArc(a.(gogl.WeightedArc)), Equals, true)
+		return
+	})
+	wt.Vertices(func(v gogl.Vertex) (terminate bool) {
+		in, _ := wt.InDegreeOf(v)
+		if v == 0 {
+			c.Assert(in, Equals, 0)
+		} else {
+			c.Assert(in, Equals, 1)
+		}
+		return
+	})
+}
+
+func (s *SpanningTreeSuite) TestDisconnected(c *C) {
+	g := gogl.Spec().Using(gogl.WithIsolates(gogl.EdgeList{
+		gogl.NewEdge("a", "b"),
+		gogl.NewEdge("b", "c"),
+		gogl.NewEdge("c", "a"),
+		gogl.NewEdge("x", "y"),
+	}, "z")).Create(al.G)
+
+	tree := SpanningBFSTree(g, "a")
+	checkBFSTree(c, g, tree, "a")
+	c.Assert(tree.HasVertex("x"), Equals, false)
+
+	tree = SpanningBFSTree(g, "z")
+	c.Assert(gogl.Order(tree), Equals, 1)
+	c.Assert(gogl.Size(tree), Equals, 0)
+
+	c.Assert(gogl.Order(SpanningBFSTree(g, "missing")), Equals, 0)
+}
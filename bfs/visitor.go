@@ -0,0 +1,70 @@
+package bfs
+
+import "github.com/sdboyer/gogl"
+
+// A BFSVisitor receives callbacks from BreadthFirstTraversal as it proceeds.
+type BFSVisitor interface {
+	// Called once for each vertex as it is visited, with its hop distance from the start
+	// vertex. Returning true terminates the traversal immediately.
+	OnVertex(v gogl.Vertex, depth int) (terminate bool)
+	// Called for each edge examined while expanding a visited vertex, whether or not the
+	// vertex at its far end has already been discovered.
+	OnEdge(e gogl.Edge)
+}
+
+// Performs a breadth-first traversal of the given graph outward from the start vertex,
+// reporting each vertex and edge it encounters to the provided visitor.
+//
+// Vertices are visited in FIFO order of discovery: the start vertex first, then its
+// neighbors, then theirs, and so on, so every vertex at depth d is visited before any at
+// depth d+1. Order among vertices of equal depth follows the graph's own enumeration order.
+// After a vertex is passed to OnVertex, each of its incident edges is passed to OnEdge
+// before the next vertex is visited. In an undirected graph, every edge within the start
+// vertex's component is thus reported twice, once from each end.
+//
+// Only the start vertex's component is reached; vertices in other components are never
+// visited. If the graph is a Digraph, traversal follows arc direction only, and only
+// out-arcs are reported. If start is not present in the graph, the visitor is not called.
+func BreadthFirstTraversal(g gogl.Graph, start gogl.Vertex, visitor BFSVisitor) {
+	if !g.HasVertex(start) {
+		return
+	}
+
+	depth := map[gogl.Vertex]int{start: 0}
+	queue := []gogl.Vertex{start}
+
+	for len(queue) > 0 {
+		v := queue[0]
+		queue = queue[1:]
+
+		if visitor.OnVertex(v, depth[v]) {
+			return
+		}
+
+		outEdgesOf(g, v, func(e gogl.Edge) (terminate bool) {
+			visitor.OnEdge(e)
+
+			u, adj := e.Both()
+			if adj == v {
+				adj = u
+			}
+			if _, seen := depth[adj]; !seen {
+				depth[adj] = depth[v] + 1
+				queue = append(queue, adj)
+			}
+			return
+		})
+	}
+}
+
+// Enumerates the edges leading away from the given vertex. For digraphs that means
+// out-arcs only; for undirected graphs, all incident edges.
+func outEdgesOf(g gogl.Graph, v gogl.Vertex, f gogl.EdgeStep) {
+	if dg, ok := g.(gogl.Digraph); ok {
+		dg.ArcsFrom(v, func(a gogl.Arc) (terminate bool) {
+			return f(a)
+		})
+	} else {
+		g.IncidentTo(v, f)
+	}
+}
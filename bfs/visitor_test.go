@@ -0,0 +1,89 @@
+package bfs
+
+import (
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+	"github.com/sdboyer/gogl/spec"
+)
+
+type recorder struct {
+	vertices []gogl.Vertex
+	depths   map[gogl.Vertex]int
+	edges    int
+	stopAt   gogl.Vertex
+}
+
+func (r *recorder) OnVertex(v gogl.Vertex, depth int) (terminate bool) {
+	if r.depths == nil {
+		r.depths = make(map[gogl.Vertex]int)
+	}
+	r.vertices = append(r.vertices, v)
+	r.depths[v] = depth
+	return v == r.stopAt
+}
+
+func (r *recorder) OnEdge(e gogl.Edge) {
+	r.edges++
+}
+
+type VisitorSuite struct{}
+
+var _ = Suite(&VisitorSuite{})
+
+func (s *VisitorSuite) TestDirected(c *C) {
+	g := gogl.Spec().Directed().Using(spec.GraphFixtures["arctest"]).Create(al.G)
+
+	r := &recorder{}
+	BreadthFirstTraversal(g, "foo", r)
+	c.Assert(r.depths, DeepEquals, map[gogl.Vertex]int{"foo": 0, "bar": 1, "qux": 1, "baz": 2})
+	c.Assert(r.vertices[0], Equals, "foo")
+	c.Assert(r.vertices[3], Equals, "baz")
+	c.Assert(r.edges, Equals, 4)
+
+	// Arc direction is honored.
+	r = &recorder{}
+	BreadthFirstTraversal(g, "bar", r)
+	c.Assert(r.vertices, DeepEquals, []gogl.Vertex{"bar", "baz"})
+	c.Assert(r.edges, Equals, 1)
+}
+
+func (s *VisitorSuite) TestUndirected(c *C) {
+	g := gogl.Spec().Using(spec.GraphFixtures["3e4v"]).Create(al.G)
+
+	r := &recorder{}
+	BreadthFirstTraversal(g, "baz", r)
+	c.Assert(r.depths, DeepEquals, map[gogl.Vertex]int{"baz": 0, "bar": 1, "foo": 2, "qux": 3})
+	c.Assert(r.vertices, DeepEquals, []gogl.Vertex{"baz", "bar", "foo", "qux"})
+	// Each edge is seen once from each end.
+	c.Assert(r.edges, Equals, 6)
+}
+
+func (s *VisitorSuite) TestDisconnected(c *C) {
+	g := gogl.Spec().Using(spec.GraphFixtures["3e5v1i"]).Create(al.G)
+
+	r := &recorder{}
+	BreadthFirstTraversal(g, "foo", r)
+	c.Assert(r.vertices, HasLen, 4)
+	_, reached := r.depths["isolate"]
+	c.Assert(reached, Equals, false)
+
+	r = &recorder{}
+	BreadthFirstTraversal(g, "isolate", r)
+	c.Assert(r.vertices, DeepEquals, []gogl.Vertex{"isolate"})
+	c.Assert(r.edges, Equals, 0)
+
+	r = &recorder{}
+	BreadthFirstTraversal(g, "missing", r)
+	c.Assert(r.vertices, HasLen, 0)
+}
+
+func (s *VisitorSuite) TestTerminate(c *C) {
+	g := gogl.Spec().Directed().Using(spec.GraphFixtures["2e3v"]).Create(al.G)
+
+	r := &recorder{stopAt: "bar"}
+	BreadthFirstTraversal(g, "foo", r)
+	c.Assert(r.vertices, DeepEquals, []gogl.Vertex{"foo", "bar"})
+	// No edges are examined from the vertex that terminated.
+	c.Assert(r.edges, Equals, 1)
+}
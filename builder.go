@@ -51,6 +51,29 @@ func Spec() GraphSpec {
 	return b
 }
 
+// Create a graph spec describing the provided graph's directedness and edge type, as
+// signaled by the interfaces it implements. This is useful for creating new graphs of
+// the same kind as an existing one.
+//
+// Weighted takes precedence over labeled, and labeled over data, should the graph
+// implement more than one of those interfaces. The returned spec is mutable.
+func SpecOf(g Graph) GraphSpec {
+	b := Spec()
+	if _, ok := g.(Digraph); ok {
+		b = b.Directed()
+	}
+
+	if _, ok := g.(WeightedGraph); ok {
+		b = b.Weighted()
+	} else if _, ok := g.(LabeledGraph); ok {
+		b = b.Labeled()
+	} else if _, ok := g.(DataGraph); ok {
+		b = b.DataEdges()
+	}
+
+	return b
+}
+
 // Specify that the graph should be populated from the provided source "graph".
 //
 // The GraphSource interface is used here because this is an ideal place
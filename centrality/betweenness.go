@@ -0,0 +1,119 @@
+// Contains algos for scoring the importance of vertices by their position in a graph.
+package centrality
+
+import (
+	"math/rand"
+
+	"github.com/sdboyer/gogl"
+)
+
+// Computes the betweenness centrality of every vertex in the given graph: the sum, over
+// all pairs of other vertices s and t, of the fraction of shortest s-t paths that pass
+// through it. Uses Brandes' algorithm, which is O(VE) for unweighted graphs.
+//
+// Path lengths are hop counts; edge weights are disregarded. If the graph is a Digraph,
+// paths follow arc direction and pairs are ordered. Otherwise each unordered pair is
+// counted once. Scores are not normalized.
+func Betweenness(g gogl.Graph) map[gogl.Vertex]float64 {
+	vertices := gogl.CollectVertices(g)
+	return brandes(g, vertices, vertices, 1)
+}
+
+// Estimates the betweenness centrality of every vertex in the given graph (see
+// Betweenness) from a random sample of source vertices, after Brandes and Pich (2007).
+// Each sampled source's dependencies are accumulated exactly, and the totals are scaled
+// up by the inverse of the fraction of vertices sampled.
+//
+// samples controls the tradeoff between speed and accuracy; the cost is O(samples * E).
+// Sources are drawn without replacement, so if samples is at least the graph's order,
+// the result is exact. All randomness comes from the provided source.
+func ApproxBetweenness(g gogl.Graph, samples int, r *rand.Rand) map[gogl.Vertex]float64 {
+	vertices := make([]gogl.Vertex, 0)
+	gogl.VerticesSorted(g, func(v gogl.Vertex) (terminate bool) {
+		vertices = append(vertices, v)
+		return
+	})
+
+	if samples >= len(vertices) {
+		return brandes(g, vertices, vertices, 1)
+	}
+	if samples < 1 {
+		samples = 1
+	}
+
+	r.Shuffle(len(vertices), func(i, j int) {
+		vertices[i], vertices[j] = vertices[j], vertices[i]
+	})
+	return brandes(g, vertices, vertices[:samples], float64(len(vertices))/float64(samples))
+}
+
+// Accumulates Brandes' dependencies from each of the given sources, multiplying the
+// totals by the given scale.
+func brandes(g gogl.Graph, vertices, sources []gogl.Vertex, scale float64) map[gogl.Vertex]float64 {
+	bc := make(map[gogl.Vertex]float64, len(vertices))
+	for _, v := range vertices {
+		bc[v] = 0
+	}
+
+	dg, directed := g.(gogl.Digraph)
+	neighbors := func(v gogl.Vertex, f gogl.VertexStep) {
+		if directed {
+			dg.SuccessorsOf(v, f)
+		} else {
+			g.AdjacentTo(v, f)
+		}
+	}
+
+	for _, s := range sources {
+		// Single-source shortest paths by BFS, counting paths (sigma) and recording
+		// predecessors, with vertices stacked in order of non-decreasing distance.
+		var stack []gogl.Vertex
+		preds := make(map[gogl.Vertex][]gogl.Vertex)
+		sigma := map[gogl.Vertex]float64{s: 1}
+		dist := map[gogl.Vertex]int{s: 0}
+		queue := []gogl.Vertex{s}
+
+		for len(queue) > 0 {
+			v := queue[0]
+			queue = queue[1:]
+			stack = append(stack, v)
+
+			neighbors(v, func(w gogl.Vertex) (terminate bool) {
+				if w == v {
+					return
+				}
+				if _, seen := dist[w]; !seen {
+					dist[w] = dist[v] + 1
+					queue = append(queue, w)
+				}
+				if dist[w] == dist[v]+1 {
+					sigma[w] += sigma[v]
+					preds[w] = append(preds[w], v)
+				}
+				return
+			})
+		}
+
+		// Back-propagate dependencies in order of non-increasing distance.
+		delta := make(map[gogl.Vertex]float64)
+		for i := len(stack) - 1; i >= 0; i-- {
+			w := stack[i]
+			for _, v := range preds[w] {
+				delta[v] += sigma[v] / sigma[w] * (1 + delta[w])
+			}
+			if w != s {
+				bc[w] += delta[w]
+			}
+		}
+	}
+
+	for v := range bc {
+		bc[v] *= scale
+		if !directed {
+			// Each unordered pair was counted from both ends.
+			bc[v] /= 2
+		}
+	}
+
+	return bc
+}
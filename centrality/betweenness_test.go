@@ -0,0 +1,92 @@
+package centrality
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+// Hook gocheck into the go test runner
+func Test(t *testing.T) { TestingT(t) }
+
+type BetweennessSuite struct{}
+
+var _ = Suite(&BetweennessSuite{})
+
+func (s *BetweennessSuite) TestExact(c *C) {
+	// A path a-b-c-d: b lies on a-c and a-d, c on a-d and b-d.
+	g := gogl.Spec().Using(gogl.EdgeList{
+		gogl.NewEdge("a", "b"),
+		gogl.NewEdge("b", "c"),
+		gogl.NewEdge("c", "d"),
+	}).Create(al.G)
+	c.Assert(Betweenness(g), DeepEquals, map[gogl.Vertex]float64{"a": 0, "b": 2, "c": 2, "d": 0})
+
+	// In a 4-cycle, each vertex carries half of the one pair it sits between.
+	cyc := gogl.Spec().Using(gogl.EdgeList{
+		gogl.NewEdge(1, 2),
+		gogl.NewEdge(2, 3),
+		gogl.NewEdge(3, 4),
+		gogl.NewEdge(4, 1),
+	}).Create(al.G)
+	c.Assert(Betweenness(cyc), DeepEquals, map[gogl.Vertex]float64{1: 0.5, 2: 0.5, 3: 0.5, 4: 0.5})
+
+	// Directed: only 1->2->3 routes through 2.
+	dg := gogl.Spec().Directed().Using(gogl.ArcList{
+		gogl.NewArc(1, 2),
+		gogl.NewArc(2, 3),
+		gogl.NewArc(3, 1),
+	}).Create(al.G)
+	c.Assert(Betweenness(dg), DeepEquals, map[gogl.Vertex]float64{1: 1, 2: 1, 3: 1})
+}
+
+// Two dense clusters joined by a short bridge, plus some random noise.
+func bridged(r *rand.Rand) gogl.Graph {
+	el := gogl.EdgeList{}
+	for base := 0; base <= 100; base += 100 {
+		for i := 0; i < 40; i++ {
+			for j := i + 1; j < 40; j++ {
+				if r.Float64() < 0.2 {
+					el = append(el, gogl.NewEdge(base+i, base+j))
+				}
+			}
+		}
+	}
+	el = append(el, gogl.NewEdge(0, 1000), gogl.NewEdge(1000, 1001), gogl.NewEdge(1001, 100))
+	return gogl.Spec().Using(el).Create(al.G)
+}
+
+func (s *BetweennessSuite) TestFullSampleIsExact(c *C) {
+	g := bridged(rand.New(rand.NewSource(1)))
+	exact := Betweenness(g)
+	approx := ApproxBetweenness(g, gogl.Order(g), rand.New(rand.NewSource(2)))
+
+	c.Assert(approx, HasLen, len(exact))
+	for v, b := range exact {
+		c.Assert(math.Abs(approx[v]-b) < 1e-9, Equals, true)
+	}
+}
+
+func (s *BetweennessSuite) TestSampledRanking(c *C) {
+	g := bridged(rand.New(rand.NewSource(1)))
+	top := func(bc map[gogl.Vertex]float64, k int) []gogl.Vertex {
+		vs := make([]gogl.Vertex, 0, len(bc))
+		for v := range bc {
+			vs = append(vs, v)
+		}
+		sort.Slice(vs, func(i, j int) bool { return bc[vs[i]] > bc[vs[j]] })
+		vs = vs[:k]
+		sort.Slice(vs, func(i, j int) bool { return gogl.VertexLess(vs[i], vs[j]) })
+		return vs
+	}
+
+	// The bridge and its two attachment points dominate.
+	want := top(Betweenness(g), 4)
+	c.Assert(want, DeepEquals, []gogl.Vertex{0, 100, 1000, 1001})
+	c.Assert(top(ApproxBetweenness(g, 20, rand.New(rand.NewSource(3))), 4), DeepEquals, want)
+}
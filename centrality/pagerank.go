@@ -0,0 +1,114 @@
+package centrality
+
+import (
+	"math"
+
+	"github.com/sdboyer/gogl"
+)
+
+// Computes the PageRank of every vertex in the given graph by power iteration. Each
+// vertex's rank is split evenly among its out-links; with probability 1-damping, the
+// random surfer instead jumps to a vertex chosen uniformly at random.
+//
+// Dangling vertices, which have no out-links, distribute their rank uniformly across
+// all vertices. In undirected graphs, every edge is a link in both directions.
+//
+// Iteration stops once the total (L1) change in rank between iterations falls below tol,
+// or after maxIter iterations, whichever comes first. Ranks sum to 1.
+func PageRank(g gogl.Graph, damping, tol float64, maxIter int) map[gogl.Vertex]float64 {
+	return pagerank(g, damping, tol, maxIter, func(gogl.Edge) float64 { return 1 })
+}
+
+// Computes the PageRank of every vertex in the given graph, as PageRank does, except that
+// each vertex's rank is split among its out-links in proportion to their weights, rather
+// than evenly. Weights must be non-negative.
+//
+// A vertex whose out-links all have a weight of 0 is treated as dangling.
+func WeightedPageRank(g gogl.WeightedGraph, damping, tol float64, maxIter int) map[gogl.Vertex]float64 {
+	return pagerank(g, damping, tol, maxIter, func(e gogl.Edge) float64 {
+		return e.(gogl.WeightedEdge).Weight()
+	})
+}
+
+func pagerank(g gogl.Graph, damping, tol float64, maxIter int, weight func(gogl.Edge) float64) map[gogl.Vertex]float64 {
+	type link struct {
+		to int
+		w  float64
+	}
+
+	var vertices []gogl.Vertex
+	index := make(map[gogl.Vertex]int)
+	gogl.VerticesSorted(g, func(v gogl.Vertex) (terminate bool) {
+		index[v] = len(vertices)
+		vertices = append(vertices, v)
+		return
+	})
+	n := len(vertices)
+	rank := make(map[gogl.Vertex]float64, n)
+	if n == 0 {
+		return rank
+	}
+
+	out := make([][]link, n)
+	total := make([]float64, n)
+	addLink := func(u, v gogl.Vertex, w float64) {
+		i := index[u]
+		out[i] = append(out[i], link{index[v], w})
+		total[i] += w
+	}
+	if dg, ok := g.(gogl.Digraph); ok {
+		dg.Arcs(func(a gogl.Arc) (terminate bool) {
+			addLink(a.Source(), a.Target(), weight(a))
+			return
+		})
+	} else {
+		g.Edges(func(e gogl.Edge) (terminate bool) {
+			u, v := e.Both()
+			w := weight(e)
+			addLink(u, v, w)
+			if u != v {
+				addLink(v, u, w)
+			}
+			return
+		})
+	}
+
+	pr := make([]float64, n)
+	for i := range pr {
+		pr[i] = 1 / float64(n)
+	}
+
+	next := make([]float64, n)
+	for iter := 0; iter < maxIter; iter++ {
+		var dangling float64
+		for i := range next {
+			next[i] = 0
+		}
+		for i, links := range out {
+			if total[i] == 0 {
+				dangling += pr[i]
+				continue
+			}
+			for _, l := range links {
+				next[l.to] += pr[i] * l.w / total[i]
+			}
+		}
+
+		base := (1-damping)/float64(n) + damping*dangling/float64(n)
+		var delta float64
+		for i := range next {
+			next[i] = base + damping*next[i]
+			delta += math.Abs(next[i] - pr[i])
+		}
+
+		pr, next = next, pr
+		if delta < tol {
+			break
+		}
+	}
+
+	for i, v := range vertices {
+		rank[v] = pr[i]
+	}
+	return rank
+}
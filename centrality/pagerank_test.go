@@ -0,0 +1,84 @@
+package centrality
+
+import (
+	"math"
+
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+type PageRankSuite struct{}
+
+var _ = Suite(&PageRankSuite{})
+
+func rankSum(rank map[gogl.Vertex]float64) (sum float64) {
+	for _, r := range rank {
+		sum += r
+	}
+	return
+}
+
+func (s *PageRankSuite) TestSymmetric(c *C) {
+	// Every vertex of a cycle is alike.
+	g := gogl.Spec().Directed().Using(gogl.ArcList{
+		gogl.NewArc("a", "b"),
+		gogl.NewArc("b", "c"),
+		gogl.NewArc("c", "a"),
+	}).Create(al.G)
+
+	for _, r := range PageRank(g, 0.85, 1e-12, 100) {
+		c.Assert(math.Abs(r-1.0/3) < 1e-9, Equals, true)
+	}
+}
+
+func (s *PageRankSuite) TestDangling(c *C) {
+	// b is dangling, so its rank spreads back out to everyone.
+	g := gogl.Spec().Directed().Using(gogl.ArcList{
+		gogl.NewArc("a", "b"),
+	}).Create(al.G)
+
+	rank := PageRank(g, 0.5, 1e-12, 200)
+	c.Assert(math.Abs(rankSum(rank)-1) < 1e-9, Equals, true)
+	// Stationary: a = 1/4 + b/4 and a + b = 1, so a = 2/5, b = 3/5.
+	c.Assert(math.Abs(rank["a"]-0.4) < 1e-9, Equals, true)
+	c.Assert(math.Abs(rank["b"]-0.6) < 1e-9, Equals, true)
+}
+
+func (s *PageRankSuite) TestWeightedFavorsHeavierLinks(c *C) {
+	arcs := gogl.WeightedArcList{
+		gogl.NewWeightedArc("hub", "heavy", 9),
+		gogl.NewWeightedArc("hub", "light", 1),
+		gogl.NewWeightedArc("heavy", "hub", 1),
+		gogl.NewWeightedArc("light", "hub", 1),
+	}
+	g := gogl.Spec().Directed().Weighted().Using(arcs).Create(al.G).(gogl.WeightedGraph)
+
+	uniform := PageRank(g, 0.85, 1e-12, 200)
+	weighted := WeightedPageRank(g, 0.85, 1e-12, 200)
+
+	c.Assert(math.Abs(uniform["heavy"]-uniform["light"]) < 1e-9, Equals, true)
+	c.Assert(weighted["heavy"] > uniform["heavy"], Equals, true)
+	c.Assert(weighted["light"] < uniform["light"], Equals, true)
+	c.Assert(math.Abs(rankSum(weighted)-1) < 1e-9, Equals, true)
+
+	// What reaches the leaves from the hub is split 9:1; the teleport share is not.
+	base := 0.15 / 3
+	ratio := (weighted["heavy"] - base) / (weighted["light"] - base)
+	c.Assert(math.Abs(ratio-9) < 1e-6, Equals, true)
+}
+
+func (s *PageRankSuite) TestUnitWeightsMatchUniform(c *C) {
+	g := gogl.Spec().Weighted().Using(gogl.WeightedEdgeList{
+		gogl.NewWeightedEdge(1, 2, 1),
+		gogl.NewWeightedEdge(2, 3, 1),
+		gogl.NewWeightedEdge(3, 1, 1),
+		gogl.NewWeightedEdge(3, 4, 1),
+	}).Create(al.G).(gogl.WeightedGraph)
+
+	uniform, weighted := PageRank(g, 0.85, 1e-12, 200), WeightedPageRank(g, 0.85, 1e-12, 200)
+	for v, r := range uniform {
+		c.Assert(math.Abs(r-weighted[v]) < 1e-12, Equals, true)
+	}
+	c.Assert(uniform[3] > uniform[4], Equals, true)
+}
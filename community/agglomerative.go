@@ -0,0 +1,200 @@
+package community
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/sdboyer/gogl"
+)
+
+// A Dendrogram records the sequence of merges made by hierarchical clustering.
+//
+// Clusters are numbered as in SciPy's linkage matrices: the leaves are clusters 0 through
+// len(Leaves)-1, and the cluster formed by the i'th merge is numbered len(Leaves)+i.
+type Dendrogram struct {
+	// The clustered vertices, in natural order (per gogl.VertexLess).
+	Leaves []gogl.Vertex
+	// The merges, in the order they were made. Similarities are non-increasing.
+	Merges []Merge
+}
+
+// A Merge joins two clusters of a Dendrogram at the given similarity.
+type Merge struct {
+	Left, Right int
+	Similarity  float64
+}
+
+// Cuts the dendrogram at the given similarity threshold, returning the clusters formed by
+// applying only those merges with a similarity at least that high. Each cluster is in
+// natural vertex order, and clusters are ordered by their first vertex.
+func (d *Dendrogram) Cut(threshold float64) [][]gogl.Vertex {
+	n := len(d.Leaves)
+	rep := make([]int, n+len(d.Merges))
+	for i := range rep {
+		rep[i] = i
+	}
+	var find func(i int) int
+	find = func(i int) int {
+		if rep[i] != i {
+			rep[i] = find(rep[i])
+		}
+		return rep[i]
+	}
+
+	for i, m := range d.Merges {
+		if m.Similarity >= threshold {
+			rep[find(m.Left)] = n + i
+			rep[find(m.Right)] = n + i
+		}
+	}
+
+	// Leaves are already sorted, so clusters come out in order of their first vertex.
+	index := make(map[int]int)
+	var clusters [][]gogl.Vertex
+	for i, v := range d.Leaves {
+		root := find(i)
+		if _, exists := index[root]; !exists {
+			index[root] = len(clusters)
+			clusters = append(clusters, nil)
+		}
+		clusters[index[root]] = append(clusters[index[root]], v)
+	}
+	return clusters
+}
+
+// Aggregate edge statistics between a pair of clusters.
+type linkStats struct {
+	sum, min, max float64
+	count         int
+}
+
+func (s linkStats) combine(o linkStats) linkStats {
+	if o.min < s.min {
+		s.min = o.min
+	}
+	if o.max > s.max {
+		s.max = o.max
+	}
+	s.sum += o.sum
+	s.count += o.count
+	return s
+}
+
+// Hierarchically clusters the graph's vertices bottom-up, treating edge weights as
+// similarities, and returns the resulting Dendrogram. At each step, the two most similar
+// clusters are merged, per the linkage:
+//
+//	"single"    the greatest similarity between any of their vertices
+//	"complete"  the least similarity between any of their vertices
+//	"average"   the mean similarity over all pairs of their vertices
+//
+// Vertex pairs not joined by an edge have a similarity of 0, but only clusters joined by
+// at least one edge are ever merged, so each connected component is clustered
+// separately. Ties are broken in favor of the lowest-numbered clusters. Weights are
+// assumed to be positive.
+//
+// Arc direction is disregarded; where arcs run in both directions between two vertices,
+// the greater weight is used. Loops are ignored. An error is returned if the linkage is
+// not recognized.
+func AgglomerativeClustering(g gogl.WeightedGraph, linkage string) (*Dendrogram, error) {
+	d := &Dendrogram{}
+	index := make(map[gogl.Vertex]int)
+	gogl.VerticesSorted(g, func(v gogl.Vertex) (terminate bool) {
+		index[v] = len(d.Leaves)
+		d.Leaves = append(d.Leaves, v)
+		return
+	})
+	n := len(d.Leaves)
+
+	size := make(map[int]int, n)
+	for i := 0; i < n; i++ {
+		size[i] = 1
+	}
+
+	var score func(a, b int, s linkStats) float64
+	switch linkage {
+	case "single":
+		score = func(a, b int, s linkStats) float64 { return s.max }
+	case "complete":
+		score = func(a, b int, s linkStats) float64 {
+			if s.count < size[a]*size[b] && s.min > 0 {
+				return 0
+			}
+			return s.min
+		}
+	case "average":
+		score = func(a, b int, s linkStats) float64 { return s.sum / float64(size[a]*size[b]) }
+	default:
+		return nil, fmt.Errorf("Unrecognized linkage %q; must be one of single, complete, or average.", linkage)
+	}
+
+	// links[a][b] holds the stats between active clusters a and b, stored both ways.
+	links := make(map[int]map[int]linkStats, n)
+	for i := 0; i < n; i++ {
+		links[i] = make(map[int]linkStats)
+	}
+	g.Edges(func(e gogl.Edge) (terminate bool) {
+		u, v := e.Both()
+		i, j := index[u], index[v]
+		if i == j {
+			return
+		}
+
+		w := e.(gogl.WeightedEdge).Weight()
+		if s, exists := links[i][j]; exists && s.max >= w {
+			return
+		}
+		links[i][j] = linkStats{w, w, w, 1}
+		links[j][i] = links[i][j]
+		return
+	})
+
+	for next := n; ; next++ {
+		active := make([]int, 0, len(links))
+		for a := range links {
+			active = append(active, a)
+		}
+		sort.Ints(active)
+
+		found := false
+		var best Merge
+		for _, a := range active {
+			for b, s := range links[a] {
+				if b <= a {
+					continue
+				}
+				sim := score(a, b, s)
+				if !found || sim > best.Similarity || (sim == best.Similarity && (a < best.Left || (a == best.Left && b < best.Right))) {
+					found, best = true, Merge{a, b, sim}
+				}
+			}
+		}
+		if !found {
+			break
+		}
+
+		d.Merges = append(d.Merges, best)
+		merged := make(map[int]linkStats)
+		for _, old := range []int{best.Left, best.Right} {
+			for x, s := range links[old] {
+				if x == best.Left || x == best.Right {
+					continue
+				}
+				if prior, exists := merged[x]; exists {
+					s = prior.combine(s)
+				}
+				merged[x] = s
+				delete(links[x], old)
+			}
+			delete(links, old)
+		}
+
+		links[next] = merged
+		for x, s := range merged {
+			links[x][next] = s
+		}
+		size[next] = size[best.Left] + size[best.Right]
+	}
+
+	return d, nil
+}
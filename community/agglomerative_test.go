@@ -0,0 +1,78 @@
+package community
+
+import (
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+type AgglomerativeSuite struct{}
+
+var _ = Suite(&AgglomerativeSuite{})
+
+// Two 4-cliques of strong similarities, joined by a single weak bridge.
+func bridgedClusters() gogl.WeightedGraph {
+	el := gogl.WeightedEdgeList{}
+	for _, base := range []int{0, 4} {
+		for a := 0; a < 4; a++ {
+			for b := a + 1; b < 4; b++ {
+				el = append(el, gogl.NewWeightedEdge(base+a, base+b, float64(5+a+b)))
+			}
+		}
+	}
+	el = append(el, gogl.NewWeightedEdge(3, 4, 1))
+	return gogl.Spec().Weighted().Using(el).Create(al.G).(gogl.WeightedGraph)
+}
+
+func (s *AgglomerativeSuite) TestSingleLinkage(c *C) {
+	d, err := AgglomerativeClustering(bridgedClusters(), "single")
+	c.Assert(err, IsNil)
+	c.Assert(d.Merges, HasLen, 7)
+
+	c.Assert(d.Cut(2), DeepEquals, [][]gogl.Vertex{{0, 1, 2, 3}, {4, 5, 6, 7}})
+	c.Assert(d.Cut(1), DeepEquals, [][]gogl.Vertex{{0, 1, 2, 3, 4, 5, 6, 7}})
+	c.Assert(d.Cut(100), HasLen, 8)
+
+	// The bridge is the last, and weakest, merge.
+	last := d.Merges[len(d.Merges)-1]
+	c.Assert(last.Similarity, Equals, 1.0)
+	for i := 1; i < len(d.Merges); i++ {
+		c.Assert(d.Merges[i].Similarity <= d.Merges[i-1].Similarity, Equals, true)
+	}
+}
+
+func (s *AgglomerativeSuite) TestLinkages(c *C) {
+	g := bridgedClusters()
+	for _, linkage := range []string{"complete", "average"} {
+		d, err := AgglomerativeClustering(g, linkage)
+		c.Assert(err, IsNil)
+		c.Assert(d.Merges, HasLen, 7)
+
+		// Within each clique, every pair is connected, so the clusters hold up under any
+		// linkage; the final merge across the bridge is much weaker.
+		last := d.Merges[len(d.Merges)-1]
+		c.Assert(d.Cut(last.Similarity+1e-9), DeepEquals, [][]gogl.Vertex{{0, 1, 2, 3}, {4, 5, 6, 7}})
+	}
+
+	d, _ := AgglomerativeClustering(g, "complete")
+	c.Assert(d.Merges[6].Similarity, Equals, 0.0)
+	d, _ = AgglomerativeClustering(g, "average")
+	c.Assert(d.Merges[6].Similarity, Equals, 1.0/16)
+}
+
+func (s *AgglomerativeSuite) TestDisconnected(c *C) {
+	g := gogl.Spec().Weighted().Using(gogl.WithIsolates(gogl.WeightedEdgeList{
+		gogl.NewWeightedEdge("a", "b", 2),
+		gogl.NewWeightedEdge("c", "d", 3),
+	}, "e")).Create(al.G).(gogl.WeightedGraph)
+
+	d, err := AgglomerativeClustering(g, "average")
+	c.Assert(err, IsNil)
+	c.Assert(d.Merges, DeepEquals, []Merge{{2, 3, 3}, {0, 1, 2}})
+	c.Assert(d.Cut(0), DeepEquals, [][]gogl.Vertex{{"a", "b"}, {"c", "d"}, {"e"}})
+}
+
+func (s *AgglomerativeSuite) TestBadLinkage(c *C) {
+	_, err := AgglomerativeClustering(bridgedClusters(), "ward")
+	c.Assert(err, ErrorMatches, ".*linkage.*")
+}
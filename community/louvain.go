@@ -0,0 +1,171 @@
+package community
+
+import (
+	"github.com/sdboyer/gogl"
+)
+
+// A weighted, undirected graph over int-numbered nodes, as worked on by Louvain. adj
+// holds the symmetric adjacency matrix, with a loop's weight doubled on the diagonal.
+type lgraph struct {
+	adj []map[int]float64
+	k   []float64
+	m2  float64
+}
+
+// Partitions the graph's vertices into communities using the Louvain method (Blondel et
+// al., 2008), greedily maximizing modularity at the given resolution (see Modularity).
+// Returns each vertex's community, numbered from 0 in the natural order (per
+// gogl.VertexLess) of each community's first vertex.
+//
+// The method alternates between two phases until modularity stops improving: each node
+// is repeatedly moved to whichever neighboring community most increases modularity, then
+// each community is collapsed into a single node. Nodes are visited in a fixed order, so
+// results are reproducible. As with Modularity, edge weights are used if present, and
+// arc direction is disregarded.
+func Louvain(g gogl.Graph, resolution float64) map[gogl.Vertex]int {
+	index := make(map[gogl.Vertex]int)
+	var vertices []gogl.Vertex
+	gogl.VerticesSorted(g, func(v gogl.Vertex) (terminate bool) {
+		index[v] = len(vertices)
+		vertices = append(vertices, v)
+		return
+	})
+
+	lg := &lgraph{adj: make([]map[int]float64, len(vertices))}
+	for i := range lg.adj {
+		lg.adj[i] = make(map[int]float64)
+	}
+	g.Edges(func(e gogl.Edge) (terminate bool) {
+		u, v := e.Both()
+		i, j := index[u], index[v]
+		w := edgeWeight(e)
+		lg.adj[i][j] += w
+		lg.adj[j][i] += w
+		return
+	})
+	lg.tally()
+
+	// membership[v] is the current community of original vertex v.
+	membership := make([]int, len(vertices))
+	for i := range membership {
+		membership[i] = i
+	}
+
+	for {
+		comm, moved := lg.moveNodes(resolution)
+		if !moved {
+			break
+		}
+
+		comm = renumber(comm)
+		for i := range membership {
+			membership[i] = comm[membership[i]]
+		}
+		lg = lg.aggregate(comm)
+	}
+
+	result := make(map[gogl.Vertex]int, len(vertices))
+	final := renumber(membership)
+	for i, v := range vertices {
+		result[v] = final[i]
+	}
+	return result
+}
+
+// Computes weighted degrees and the total.
+func (lg *lgraph) tally() {
+	lg.k = make([]float64, len(lg.adj))
+	lg.m2 = 0
+	for i, adj := range lg.adj {
+		for _, w := range adj {
+			lg.k[i] += w
+		}
+		lg.m2 += lg.k[i]
+	}
+}
+
+// Runs the local moving phase, returning each node's community and whether any node
+// changed community.
+func (lg *lgraph) moveNodes(resolution float64) ([]int, bool) {
+	n := len(lg.adj)
+	comm := make([]int, n)
+	tot := make([]float64, n)
+	for i := range comm {
+		comm[i] = i
+		tot[i] = lg.k[i]
+	}
+
+	if lg.m2 == 0 {
+		return comm, false
+	}
+
+	var movedAny bool
+	for improved := true; improved; {
+		improved = false
+		for i := 0; i < n; i++ {
+			// Weight from i to each neighboring community, excluding i's own loop.
+			links := make(map[int]float64)
+			for j, w := range lg.adj[i] {
+				if j != i {
+					links[comm[j]] += w
+				}
+			}
+
+			// Take i out of its community, then find the best one to place it in.
+			// Ties favor staying put, then the lowest community id.
+			old := comm[i]
+			tot[old] -= lg.k[i]
+
+			best, bestGain := old, links[old]-resolution*tot[old]*lg.k[i]/lg.m2
+			for c, l := range links {
+				gain := l - resolution*tot[c]*lg.k[i]/lg.m2
+				if gain > bestGain || (gain == bestGain && best != old && c < best) {
+					best, bestGain = c, gain
+				}
+			}
+
+			comm[i] = best
+			tot[best] += lg.k[i]
+			if best != old {
+				improved, movedAny = true, true
+			}
+		}
+	}
+
+	return comm, movedAny
+}
+
+// Collapses each community into a single node, numbered by the community.
+func (lg *lgraph) aggregate(comm []int) *lgraph {
+	var n int
+	for _, c := range comm {
+		if c+1 > n {
+			n = c + 1
+		}
+	}
+
+	agg := &lgraph{adj: make([]map[int]float64, n)}
+	for c := range agg.adj {
+		agg.adj[c] = make(map[int]float64)
+	}
+	for i, adj := range lg.adj {
+		for j, w := range adj {
+			agg.adj[comm[i]][comm[j]] += w
+		}
+	}
+	agg.tally()
+	return agg
+}
+
+// Renumbers community ids densely from 0, in order of first appearance.
+func renumber(comm []int) []int {
+	ids := make(map[int]int)
+	out := make([]int, len(comm))
+	for i, c := range comm {
+		if _, exists := ids[c]; !exists {
+			ids[c] = len(ids)
+		}
+		out[i] = ids[c]
+	}
+	return out
+}
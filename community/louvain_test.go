@@ -0,0 +1,73 @@
+package community
+
+import (
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+type LouvainSuite struct{}
+
+var _ = Suite(&LouvainSuite{})
+
+// A ring of n cliques of size k, each joined to the next by a single edge.
+func ringOfCliques(n, k int) gogl.Graph {
+	el := gogl.EdgeList{}
+	for i := 0; i < n; i++ {
+		for a := 0; a < k; a++ {
+			for b := a + 1; b < k; b++ {
+				el = append(el, gogl.NewEdge(i*k+a, i*k+b))
+			}
+		}
+		el = append(el, gogl.NewEdge(i*k, ((i+1)%n)*k+1))
+	}
+	return gogl.Spec().Using(el).Create(al.G)
+}
+
+func countCommunities(communities map[gogl.Vertex]int) int {
+	seen := make(map[int]bool)
+	for _, c := range communities {
+		seen[c] = true
+	}
+	return len(seen)
+}
+
+func (s *LouvainSuite) TestFindsCliques(c *C) {
+	g := ringOfCliques(6, 5)
+	communities := Louvain(g, 1)
+
+	c.Assert(communities, HasLen, 30)
+	c.Assert(countCommunities(communities), Equals, 6)
+	for v, comm := range communities {
+		c.Assert(comm, Equals, communities[v.(int)/5*5])
+	}
+	c.Assert(communities[0], Equals, 0)
+
+	// Louvain should do at least as well as the obvious partition.
+	c.Assert(Modularity(g, communities, 1) > 0.6, Equals, true)
+}
+
+func (s *LouvainSuite) TestResolutionSplits(c *C) {
+	g := ringOfCliques(12, 4)
+
+	var last int
+	for _, res := range []float64{0.05, 0.5, 1, 4, 20} {
+		n := countCommunities(Louvain(g, res))
+		c.Assert(n >= last, Equals, true, Commentf("resolution %v gave %v communities, after %v", res, n, last))
+		last = n
+	}
+
+	c.Assert(countCommunities(Louvain(g, 0.05)) < 12, Equals, true)
+	c.Assert(countCommunities(Louvain(g, 1)), Equals, 12)
+	c.Assert(countCommunities(Louvain(g, 20)) > 12, Equals, true)
+}
+
+func (s *LouvainSuite) TestDeterministic(c *C) {
+	g := ringOfCliques(8, 4)
+	c.Assert(Louvain(g, 1), DeepEquals, Louvain(g, 1))
+}
+
+func (s *LouvainSuite) TestNoEdges(c *C) {
+	g := gogl.Spec().Using(gogl.WithIsolates(gogl.EdgeList{}, "a", "b", "c")).Create(al.G)
+	c.Assert(Louvain(g, 1), DeepEquals, map[gogl.Vertex]int{"a": 0, "b": 1, "c": 2})
+}
@@ -0,0 +1,111 @@
+package community
+
+import (
+	"math"
+	"testing"
+
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+func Test(t *testing.T) { TestingT(t) }
+
+type ModularitySuite struct{}
+
+var _ = Suite(&ModularitySuite{})
+
+// Two triangles, joined by a single bridge.
+func barbell() gogl.Graph {
+	return gogl.Spec().Using(gogl.EdgeList{
+		gogl.NewEdge(0, 1), gogl.NewEdge(1, 2), gogl.NewEdge(2, 0),
+		gogl.NewEdge(3, 4), gogl.NewEdge(4, 5), gogl.NewEdge(5, 3),
+		gogl.NewEdge(2, 3),
+	}).Create(al.G)
+}
+
+// Computes standard modularity straight from its matrix definition.
+func newmanGirvan(g gogl.Graph, communities map[gogl.Vertex]int) float64 {
+	vertices := gogl.CollectVertices(g)
+	a := func(u, v gogl.Vertex) float64 {
+		if !g.HasEdge(gogl.NewEdge(u, v)) {
+			return 0
+		}
+		if u == v {
+			return 2 * edgeWeight(gogl.NewEdge(u, v))
+		}
+		var w float64
+		g.IncidentTo(u, func(e gogl.Edge) (terminate bool) {
+			if x, y := e.Both(); (x == u && y == v) || (x == v && y == u) {
+				w = edgeWeight(e)
+			}
+			return
+		})
+		return w
+	}
+
+	k := make(map[gogl.Vertex]float64)
+	var m2 float64
+	for _, u := range vertices {
+		for _, v := range vertices {
+			k[u] += a(u, v)
+		}
+		m2 += k[u]
+	}
+
+	var q float64
+	for _, u := range vertices {
+		for _, v := range vertices {
+			if communities[u] == communities[v] {
+				q += a(u, v) - k[u]*k[v]/m2
+			}
+		}
+	}
+	return q / m2
+}
+
+func (s *ModularitySuite) TestStandard(c *C) {
+	g := barbell()
+	split := map[gogl.Vertex]int{0: 0, 1: 0, 2: 0, 3: 1, 4: 1, 5: 1}
+
+	q := Modularity(g, split, 1)
+	c.Assert(math.Abs(q-5.0/14) < 1e-12, Equals, true)
+	c.Assert(math.Abs(q-newmanGirvan(g, split)) < 1e-12, Equals, true)
+
+	whole := map[gogl.Vertex]int{0: 0, 1: 0, 2: 0, 3: 0, 4: 0, 5: 0}
+	c.Assert(math.Abs(Modularity(g, whole, 1)) < 1e-12, Equals, true)
+}
+
+func (s *ModularitySuite) TestWeighted(c *C) {
+	g := gogl.Spec().Weighted().Using(gogl.WeightedEdgeList{
+		gogl.NewWeightedEdge("a", "b", 3),
+		gogl.NewWeightedEdge("b", "c", 0.5),
+		gogl.NewWeightedEdge("c", "d", 2),
+		gogl.NewWeightedEdge("d", "d", 1),
+	}).Create(al.G)
+	split := map[gogl.Vertex]int{"a": 0, "b": 0, "c": 1, "d": 1}
+
+	q := Modularity(g, split, 1)
+	c.Assert(math.Abs(q-newmanGirvan(g, split)) < 1e-12, Equals, true)
+}
+
+func (s *ModularitySuite) TestResolution(c *C) {
+	g := barbell()
+	split := map[gogl.Vertex]int{0: 0, 1: 0, 2: 0, 3: 1, 4: 1, 5: 1}
+
+	// Only the null-model term scales with the resolution.
+	q0, q1, q2 := Modularity(g, split, 0), Modularity(g, split, 1), Modularity(g, split, 2)
+	c.Assert(math.Abs(q0-6.0/7) < 1e-12, Equals, true)
+	c.Assert(math.Abs((q0-q1)-(q1-q2)) < 1e-12, Equals, true)
+	c.Assert(q2 < q1, Equals, true)
+}
+
+func (s *ModularitySuite) TestUnassignedAndEmpty(c *C) {
+	g := barbell()
+	partial := map[gogl.Vertex]int{0: 0, 1: 0, 2: 0}
+	singletons := map[gogl.Vertex]int{0: 0, 1: 0, 2: 0, 3: 1, 4: 2, 5: 3}
+	c.Assert(Modularity(g, partial, 1), Equals, Modularity(g, singletons, 1))
+
+	empty := gogl.Spec().Using(gogl.WithIsolates(gogl.EdgeList{}, 1, 2)).Create(al.G)
+	c.Assert(Modularity(empty, map[gogl.Vertex]int{1: 0, 2: 0}, 1), Equals, float64(0))
+}
@@ -0,0 +1,73 @@
+package community
+
+import (
+	"github.com/sdboyer/gogl"
+)
+
+// Computes the conductance of the given cut: the total weight of edges crossing it,
+// divided by the lesser of the volumes of its two sides. The cut is the set of vertices
+// mapped to true; all others, including those absent from the map, form the other side.
+// A side's volume is the total weighted degree of its vertices.
+//
+// Conductance ranges from 0, for a side with no edges leaving it, to 1; lower is better.
+// As with Modularity, edge weights are used for a WeightedGraph, otherwise each edge
+// counts for 1, and arc direction is disregarded. If either side has no volume - as for
+// an empty cut, or a side made up of isolates - conductance is undefined, and 1 is
+// returned, as the cut separates nothing of substance.
+func Conductance(g gogl.Graph, cut map[gogl.Vertex]bool) float64 {
+	var crossing, inside, outside float64
+	g.Edges(func(e gogl.Edge) (terminate bool) {
+		u, v := e.Both()
+		w := edgeWeight(e)
+
+		for _, x := range [2]gogl.Vertex{u, v} {
+			if cut[x] {
+				inside += w
+			} else {
+				outside += w
+			}
+		}
+		if cut[u] != cut[v] {
+			crossing += w
+		}
+		return
+	})
+
+	volume := inside
+	if outside < volume {
+		volume = outside
+	}
+	if volume == 0 {
+		return 1
+	}
+	return crossing / volume
+}
+
+// Computes the coverage of the given partition of the graph's vertices into communities:
+// the fraction of the total edge weight that falls on edges within a community. Coverage
+// ranges from 0 to 1; higher is better, though note that placing every vertex in one
+// community trivially achieves 1.
+//
+// As with Modularity, edge weights are used for a WeightedGraph, otherwise each edge
+// counts for 1; vertices absent from the communities map are each treated as a community
+// of their own, and a graph with no edges has a coverage of 0.
+func Coverage(g gogl.Graph, communities map[gogl.Vertex]int) float64 {
+	var total, internal float64
+	g.Edges(func(e gogl.Edge) (terminate bool) {
+		u, v := e.Both()
+		w := edgeWeight(e)
+		total += w
+
+		cu, uok := communities[u]
+		cv, vok := communities[v]
+		if u == v || (uok && vok && cu == cv) {
+			internal += w
+		}
+		return
+	})
+
+	if total == 0 {
+		return 0
+	}
+	return internal / total
+}
@@ -0,0 +1,69 @@
+package community
+
+import (
+	"math/rand"
+
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+type QualitySuite struct{}
+
+var _ = Suite(&QualitySuite{})
+
+// Two cliques of n vertices each, 0..n-1 and n..2n-1, joined by a single bridge.
+func cliquePair(n int) gogl.Graph {
+	el := gogl.EdgeList{gogl.NewEdge(0, n)}
+	for _, offset := range []int{0, n} {
+		for u := 0; u < n; u++ {
+			for v := u + 1; v < n; v++ {
+				el = append(el, gogl.NewEdge(offset+u, offset+v))
+			}
+		}
+	}
+	return gogl.Spec().Using(el).Create(al.G)
+}
+
+func (s *QualitySuite) TestConductance(c *C) {
+	g := cliquePair(5)
+
+	bisection := make(map[gogl.Vertex]bool)
+	for v := 0; v < 5; v++ {
+		bisection[v] = true
+	}
+	// One crossing edge; each side has volume 2*10 + 1.
+	c.Assert(Conductance(g, bisection), Equals, 1.0/21)
+
+	r := rand.New(rand.NewSource(1))
+	random := make(map[gogl.Vertex]bool)
+	for _, v := range r.Perm(10)[:5] {
+		random[v] = true
+	}
+	c.Assert(Conductance(g, random) > 0.3, Equals, true)
+
+	c.Assert(Conductance(g, nil), Equals, 1.0)
+	c.Assert(Conductance(gogl.Spec().Weighted().Using(gogl.WeightedEdgeList{
+		gogl.NewWeightedEdge("a", "b", 3),
+		gogl.NewWeightedEdge("b", "c", 1),
+	}).Create(al.G), map[gogl.Vertex]bool{"c": true}), Equals, 1.0)
+}
+
+func (s *QualitySuite) TestCoverage(c *C) {
+	assign := func(n int) map[gogl.Vertex]int {
+		communities := make(map[gogl.Vertex]int)
+		for v := 0; v < 2*n; v++ {
+			communities[v] = v / n
+		}
+		return communities
+	}
+
+	// Only the bridge falls outside the communities, so coverage approaches 1 as the
+	// cliques grow.
+	c.Assert(Coverage(cliquePair(5), assign(5)), Equals, 20.0/21)
+	c.Assert(Coverage(cliquePair(10), assign(10)), Equals, 90.0/91)
+	c.Assert(Coverage(cliquePair(10), assign(10)) > Coverage(cliquePair(5), assign(5)), Equals, true)
+
+	c.Assert(Coverage(cliquePair(5), map[gogl.Vertex]int{}), Equals, 0.0)
+	c.Assert(Coverage(gogl.Spec().Create(al.G), nil), Equals, 0.0)
+}
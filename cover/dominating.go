@@ -0,0 +1,114 @@
+// Contains approximation algos for covering problems, such as finding small sets of
+// vertices that dominate a graph.
+package cover
+
+import (
+	"container/heap"
+
+	"github.com/sdboyer/gogl"
+)
+
+// Computes a small dominating set of the given graph: a set of vertices D such that every
+// vertex is either in D or adjacent to a member of D. This is useful for facility
+// placement, where every site must be served by a facility at or next to it.
+//
+// Finding a minimum dominating set is NP-hard; this uses the greedy algorithm, which
+// repeatedly picks the vertex that dominates the most not-yet-dominated vertices, and
+// is within a factor of ln(n)+1 of optimal. Ties are broken in favor of the lesser
+// vertex, per gogl.VertexLess. Vertices are returned in the order they were picked.
+//
+// Arc direction is disregarded, as neighbors are taken from AdjacentTo(). Isolated
+// vertices can only dominate themselves, so each is always included.
+func ApproxDominatingSet(g gogl.Graph) []gogl.Vertex {
+	var vertices []gogl.Vertex
+	index := make(map[gogl.Vertex]int)
+	gogl.VerticesSorted(g, func(v gogl.Vertex) (terminate bool) {
+		index[v] = len(vertices)
+		vertices = append(vertices, v)
+		return
+	})
+
+	// The closed neighborhood of each vertex. A digraph's AdjacentTo() reports a neighbor
+	// once per arc, so each is recorded only the first time it is seen (added[j] holds the
+	// last vertex whose neighborhood took j).
+	closed := make([][]int, len(vertices))
+	added := make([]int, len(vertices))
+	for i := range added {
+		added[i] = -1
+	}
+	for i, v := range vertices {
+		closed[i] = append(closed[i], i)
+		added[i] = i
+		g.AdjacentTo(v, func(adj gogl.Vertex) (terminate bool) {
+			if j := index[adj]; added[j] != i {
+				added[j] = i
+				closed[i] = append(closed[i], j)
+			}
+			return
+		})
+	}
+
+	// Gains only ever shrink as vertices become dominated, so stale heap entries are
+	// upper bounds, and need only be refreshed when they reach the top (lazy greedy).
+	pq := make(gainQueue, len(vertices))
+	for i := range vertices {
+		pq[i] = gainItem{i, len(closed[i])}
+	}
+	heap.Init(&pq)
+
+	dominated := make([]bool, len(vertices))
+	remaining := len(vertices)
+	var set []gogl.Vertex
+	for remaining > 0 {
+		top := heap.Pop(&pq).(gainItem)
+
+		var gain int
+		for _, j := range closed[top.v] {
+			if !dominated[j] {
+				gain++
+			}
+		}
+
+		if gain < top.gain {
+			top.gain = gain
+			heap.Push(&pq, top)
+			continue
+		}
+
+		set = append(set, vertices[top.v])
+		for _, j := range closed[top.v] {
+			if !dominated[j] {
+				dominated[j] = true
+				remaining--
+			}
+		}
+	}
+
+	return set
+}
+
+// A max-priority queue of vertex indices, keyed on gain, for use with container/heap.
+// Equal gains are ordered by index.
+type gainItem struct {
+	v, gain int
+}
+
+type gainQueue []gainItem
+
+func (q gainQueue) Len() int { return len(q) }
+func (q gainQueue) Less(i, j int) bool {
+	if q[i].gain != q[j].gain {
+		return q[i].gain > q[j].gain
+	}
+	return q[i].v < q[j].v
+}
+func (q gainQueue) Swap(i, j int)       { q[i], q[j] = q[j], q[i] }
+func (q *gainQueue) Push(x interface{}) { *q = append(*q, x.(gainItem)) }
+
+func (q *gainQueue) Pop() interface{} {
+	old := *q
+	n := len(old)
+	item := old[n-1]
+	*q = old[:n-1]
+	return item
+}
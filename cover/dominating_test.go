@@ -0,0 +1,93 @@
+package cover
+
+import (
+	"math/rand"
+	"testing"
+
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+func Test(t *testing.T) { TestingT(t) }
+
+type DominatingSetSuite struct{}
+
+var _ = Suite(&DominatingSetSuite{})
+
+func dominates(g gogl.Graph, set []gogl.Vertex) bool {
+	dominated := make(map[gogl.Vertex]bool)
+	for _, v := range set {
+		dominated[v] = true
+		g.AdjacentTo(v, func(adj gogl.Vertex) (terminate bool) {
+			dominated[adj] = true
+			return
+		})
+	}
+	return len(dominated) == gogl.Order(g)
+}
+
+func (s *DominatingSetSuite) TestStar(c *C) {
+	el := gogl.EdgeList{}
+	for i := 1; i <= 10; i++ {
+		el = append(el, gogl.NewEdge(i, 0))
+	}
+	g := gogl.Spec().Using(el).Create(al.G)
+
+	c.Assert(ApproxDominatingSet(g), DeepEquals, []gogl.Vertex{0})
+}
+
+func (s *DominatingSetSuite) TestPath(c *C) {
+	// 0-1-2-3-4-5-6: greedy takes 1 first (lowest of the gain-3 ties), then 4, then
+	// 5 to cover the end.
+	el := gogl.EdgeList{}
+	for i := 0; i < 6; i++ {
+		el = append(el, gogl.NewEdge(i, i+1))
+	}
+	g := gogl.Spec().Using(el).Create(al.G)
+
+	set := ApproxDominatingSet(g)
+	c.Assert(set, DeepEquals, []gogl.Vertex{1, 4, 5})
+	c.Assert(dominates(g, set), Equals, true)
+}
+
+func (s *DominatingSetSuite) TestReciprocalArcs(c *C) {
+	// z's reciprocal arcs must not count a and b twice; y's true gain of 4 beats z's 3.
+	g := gogl.Spec().Directed().Using(gogl.ArcList{
+		gogl.NewArc("z", "a"),
+		gogl.NewArc("a", "z"),
+		gogl.NewArc("z", "b"),
+		gogl.NewArc("b", "z"),
+		gogl.NewArc("y", "c"),
+		gogl.NewArc("y", "d"),
+		gogl.NewArc("y", "e"),
+	}).Create(al.G)
+
+	c.Assert(ApproxDominatingSet(g), DeepEquals, []gogl.Vertex{"y", "z"})
+}
+
+func (s *DominatingSetSuite) TestRandomDominates(c *C) {
+	r := rand.New(rand.NewSource(13))
+	for trial := 0; trial < 20; trial++ {
+		arcs := gogl.ArcList{}
+		for i := 0; i < 60; i++ {
+			arcs = append(arcs, gogl.NewArc(r.Intn(50), r.Intn(50)))
+		}
+		g := gogl.Spec().Directed().Using(gogl.WithIsolates(arcs, 100, 101)).Create(al.G)
+
+		set := ApproxDominatingSet(g)
+		c.Assert(dominates(g, set), Equals, true)
+
+		seen := make(map[gogl.Vertex]bool)
+		for _, v := range set {
+			c.Assert(seen[v], Equals, false)
+			seen[v] = true
+		}
+		c.Assert(seen[100] && seen[101], Equals, true)
+	}
+}
+
+func (s *DominatingSetSuite) TestEmpty(c *C) {
+	g := gogl.Spec().Create(al.G)
+	c.Assert(ApproxDominatingSet(g), HasLen, 0)
+}
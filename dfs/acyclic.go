@@ -0,0 +1,86 @@
+package dfs
+
+import (
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+// Indicates whether or not the given digraph is acyclic (a DAG).
+//
+// This performs a depth-first traversal from every vertex, coloring vertices grey while
+// they are on the recursion stack and black once finished. The traversal halts at the
+// first back edge (an arc to a grey vertex) it encounters, so the check is O(V+E) in the
+// worst case. Loops count as cycles.
+func IsAcyclic(g gogl.Digraph) bool {
+	colors := make(map[gogl.Vertex]uint)
+	acyclic := true
+
+	var visit func(v gogl.Vertex)
+	visit = func(v gogl.Vertex) {
+		colors[v] = grey
+		g.SuccessorsOf(v, func(s gogl.Vertex) (terminate bool) {
+			switch colors[s] {
+			case grey:
+				acyclic = false
+			case white:
+				visit(s)
+			}
+			return !acyclic
+		})
+		colors[v] = black
+	}
+
+	g.Vertices(func(v gogl.Vertex) (terminate bool) {
+		if colors[v] == white {
+			visit(v)
+		}
+		return !acyclic
+	})
+
+	return acyclic
+}
+
+// Orients every edge of the given graph to produce a DAG, by directing each edge from
+// whichever endpoint comes first in the natural vertex order (see gogl.VertexLess) to
+// the other. Since every arc runs forward in a single total order, no cycle can arise;
+// this is useful for deriving a dependency order from a symmetric relation.
+//
+// The result has the same vertices as the original, and is of the same kind (per
+// gogl.SpecOf): weights, labels, and data carry over to the oriented arcs. Loops cannot
+// be oriented acyclically, and are dropped. If the graph is already a Digraph, its arcs
+// are reoriented in the same way, and any pair of opposing arcs collapses into one.
+func AcyclicOrientation(g gogl.Graph) gogl.Digraph {
+	arcs := gogl.ArcList{}
+	orient := func(e gogl.Edge) (terminate bool) {
+		u, v := e.Both()
+		if u == v {
+			return
+		}
+		if gogl.VertexLess(v, u) {
+			u, v = v, u
+		}
+
+		switch te := e.(type) {
+		case gogl.WeightedEdge:
+			arcs = append(arcs, gogl.NewWeightedArc(u, v, te.Weight()))
+		case gogl.LabeledEdge:
+			arcs = append(arcs, gogl.NewLabeledArc(u, v, te.Label()))
+		case gogl.DataEdge:
+			arcs = append(arcs, gogl.NewDataArc(u, v, te.Data()))
+		default:
+			arcs = append(arcs, gogl.NewArc(u, v))
+		}
+		return
+	}
+
+	if dg, ok := g.(gogl.Digraph); ok {
+		dg.Arcs(func(a gogl.Arc) (terminate bool) {
+			return orient(a)
+		})
+	} else {
+		g.Edges(orient)
+	}
+
+	vertices := gogl.CollectVertices(g)
+	return gogl.SpecOf(g).Directed().Using(gogl.WithIsolates(arcs, vertices...)).Create(al.G).(gogl.Digraph)
+}
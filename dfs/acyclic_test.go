@@ -0,0 +1,78 @@
+package dfs
+
+import (
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+	"github.com/sdboyer/gogl/spec"
+)
+
+type AcyclicSuite struct{}
+
+var _ = Suite(&AcyclicSuite{})
+
+func (s *AcyclicSuite) TestIsAcyclic(c *C) {
+	dag := gogl.Spec().Directed().Mutable().Using(spec.GraphFixtures["arctest"]).
+		Create(al.G).(gogl.MutableDigraph)
+	c.Assert(IsAcyclic(dag.(gogl.Digraph)), Equals, true)
+
+	// Adding a single back edge introduces one cycle
+	dag.AddArcs(gogl.NewArc("baz", "foo"))
+	c.Assert(IsAcyclic(dag.(gogl.Digraph)), Equals, false)
+}
+
+func (s *AcyclicSuite) TestLoop(c *C) {
+	g := gogl.Spec().Directed().Mutable().Create(al.G).(gogl.MutableDigraph)
+	g.AddArcs(gogl.NewArc("foo", "foo"))
+	c.Assert(IsAcyclic(g.(gogl.Digraph)), Equals, false)
+
+	c.Assert(IsAcyclic(gogl.NullGraph), Equals, true)
+}
+
+func (s *AcyclicSuite) TestAcyclicOrientation(c *C) {
+	// A 4-cycle with a chord, a loop, and an isolate.
+	g := gogl.Spec().Weighted().Using(gogl.WithIsolates(gogl.WeightedEdgeList{
+		gogl.NewWeightedEdge("d", "a", 1),
+		gogl.NewWeightedEdge("a", "b", 2),
+		gogl.NewWeightedEdge("b", "c", 3),
+		gogl.NewWeightedEdge("c", "d", 4),
+		gogl.NewWeightedEdge("c", "a", 5),
+		gogl.NewWeightedEdge("b", "b", 6),
+	}, "e")).Create(al.G)
+
+	dag := AcyclicOrientation(g)
+	c.Assert(IsAcyclic(dag), Equals, true)
+	c.Assert(gogl.Order(dag), Equals, 5)
+	c.Assert(gogl.Size(dag), Equals, 5)
+
+	// Same underlying structure, weights and all, minus the loop.
+	wdag := dag.(gogl.WeightedDigraph)
+	g.Edges(func(e gogl.Edge) (terminate bool) {
+		u, v := e.Both()
+		if u == v {
+			return
+		}
+		w := e.(gogl.WeightedEdge).Weight()
+		c.Assert(wdag.HasWeightedArc(gogl.NewWeightedArc(u, v, w)) != wdag.HasWeightedArc(gogl.NewWeightedArc(v, u, w)), Equals, true)
+		return
+	})
+	c.Assert(wdag.HasArc(gogl.NewArc("a", "d")), Equals, true)
+	c.Assert(wdag.HasArc(gogl.NewArc("b", "b")), Equals, false)
+}
+
+func (s *AcyclicSuite) TestAcyclicOrientationOfDigraph(c *C) {
+	g := gogl.Spec().Directed().Using(gogl.ArcList{
+		gogl.NewArc(3, 1),
+		gogl.NewArc(1, 2),
+		gogl.NewArc(2, 3),
+		gogl.NewArc(2, 1),
+	}).Create(al.G).(gogl.Digraph)
+	c.Assert(IsAcyclic(g), Equals, false)
+
+	dag := AcyclicOrientation(g)
+	c.Assert(IsAcyclic(dag), Equals, true)
+	c.Assert(gogl.Size(dag), Equals, 3)
+	for _, a := range []gogl.Arc{gogl.NewArc(1, 2), gogl.NewArc(1, 3), gogl.NewArc(2, 3)} {
+		c.Assert(dag.HasArc(a), Equals, true)
+	}
+}
@@ -0,0 +1,174 @@
+package dfs
+
+import (
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+// Finds the biconnected components (blocks) of the given graph, using the Hopcroft-Tarjan
+// algorithm. Each block is a maximal set of edges in which every pair of edges lies on a
+// common simple cycle, or else a single bridge. Blocks meet only at articulation points.
+//
+// Arc direction is disregarded. Loops have no bearing on biconnectivity, and are omitted
+// from the results, as are isolated vertices, which have no edges to form a block from.
+func BiconnectedComponents(g gogl.Graph) [][]gogl.Edge {
+	blocks, _ := biconnected(g)
+	return blocks
+}
+
+// Returns the articulation points (cut vertices) of the given graph: those whose removal
+// would increase its number of connected components. Arc direction is disregarded.
+func ArticulationPoints(g gogl.Graph) []gogl.Vertex {
+	_, cuts := biconnected(g)
+	return cuts
+}
+
+// Decomposes the given graph into its biconnected components, returning each as a
+// separate, standalone graph of the same kind as the original (per gogl.SpecOf), and
+// containing the original graph's own edges. Articulation points appear in every block
+// that they join.
+//
+// Isolated vertices are returned as single-vertex graphs, so that every vertex of the
+// original graph appears in at least one of the results. Loops are omitted, per
+// BiconnectedComponents.
+func SplitAtArticulationPoints(g gogl.Graph) []gogl.Graph {
+	blocks, _ := biconnected(g)
+	spec := gogl.SpecOf(g)
+	_, directed := g.(gogl.Digraph)
+
+	covered := make(map[gogl.Vertex]bool)
+	graphs := make([]gogl.Graph, 0, len(blocks))
+	for _, block := range blocks {
+		var src gogl.GraphSource
+		if directed {
+			arcs := make(gogl.ArcList, 0, len(block))
+			for _, e := range block {
+				arcs = append(arcs, e.(gogl.Arc))
+			}
+			src = arcs
+		} else {
+			src = gogl.EdgeList(block)
+		}
+
+		for _, e := range block {
+			u, v := e.Both()
+			covered[u], covered[v] = true, true
+		}
+		graphs = append(graphs, spec.Using(src).Create(al.G))
+	}
+
+	gogl.VerticesSorted(g, func(v gogl.Vertex) (terminate bool) {
+		if !covered[v] {
+			var src gogl.GraphSource = gogl.WithIsolates(gogl.EdgeList{}, v)
+			if directed {
+				src = gogl.WithIsolates(gogl.ArcList{}, v)
+			}
+			graphs = append(graphs, spec.Using(src).Create(al.G))
+		}
+		return
+	})
+
+	return graphs
+}
+
+// Runs Hopcroft-Tarjan over the given graph, returning its blocks and articulation
+// points. Roots are taken in natural vertex order, so results are deterministic for a
+// given graph.
+func biconnected(g gogl.Graph) ([][]gogl.Edge, []gogl.Vertex) {
+	type incidence struct {
+		e     gogl.Edge
+		other gogl.Vertex
+		id    int
+	}
+
+	// Edges are numbered so that the edge to a vertex's parent can be told apart from
+	// any others to the same vertex.
+	adj := make(map[gogl.Vertex][]incidence)
+	var id int
+	collect := func(e gogl.Edge) (terminate bool) {
+		u, v := e.Both()
+		if u != v {
+			adj[u] = append(adj[u], incidence{e, v, id})
+			adj[v] = append(adj[v], incidence{e, u, id})
+			id++
+		}
+		return
+	}
+	if dg, ok := g.(gogl.Digraph); ok {
+		dg.Arcs(func(a gogl.Arc) (terminate bool) {
+			return collect(a)
+		})
+	} else {
+		g.Edges(collect)
+	}
+
+	var counter int
+	disc := make(map[gogl.Vertex]int)
+	low := make(map[gogl.Vertex]int)
+	var stack []incidence
+	var blocks [][]gogl.Edge
+	var cuts []gogl.Vertex
+	isCut := make(map[gogl.Vertex]bool)
+
+	var visit func(v gogl.Vertex, via int) int
+	visit = func(v gogl.Vertex, via int) (children int) {
+		disc[v], low[v] = counter, counter
+		counter++
+
+		for _, inc := range adj[v] {
+			if inc.id == via {
+				continue
+			}
+
+			w := inc.other
+			if _, visited := disc[w]; !visited {
+				stack = append(stack, inc)
+				children++
+				visit(w, inc.id)
+				if low[w] < low[v] {
+					low[v] = low[w]
+				}
+
+				if low[w] >= disc[v] {
+					// v separates w's subtree from the rest; pop off the block.
+					var block []gogl.Edge
+					for {
+						top := stack[len(stack)-1]
+						stack = stack[:len(stack)-1]
+						block = append(block, top.e)
+						if top.id == inc.id {
+							break
+						}
+					}
+					blocks = append(blocks, block)
+
+					// A root is only a cut vertex if it has multiple children; that is
+					// checked once its search completes.
+					if via >= 0 && !isCut[v] {
+						isCut[v] = true
+						cuts = append(cuts, v)
+					}
+				}
+			} else if disc[w] < disc[v] {
+				// A back edge; each is pushed once, from its deeper end.
+				stack = append(stack, inc)
+				if disc[w] < low[v] {
+					low[v] = disc[w]
+				}
+			}
+		}
+		return
+	}
+
+	gogl.VerticesSorted(g, func(v gogl.Vertex) (terminate bool) {
+		if _, visited := disc[v]; !visited {
+			if visit(v, -1) > 1 {
+				isCut[v] = true
+				cuts = append(cuts, v)
+			}
+		}
+		return
+	})
+
+	return blocks, cuts
+}
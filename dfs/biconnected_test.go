@@ -0,0 +1,117 @@
+package dfs
+
+import (
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+type BiconnectedSuite struct{}
+
+var _ = Suite(&BiconnectedSuite{})
+
+// Two triangles sharing the cut vertex "c".
+var bowtie = gogl.EdgeList{
+	gogl.NewEdge("a", "b"),
+	gogl.NewEdge("b", "c"),
+	gogl.NewEdge("c", "a"),
+	gogl.NewEdge("c", "d"),
+	gogl.NewEdge("d", "e"),
+	gogl.NewEdge("e", "c"),
+}
+
+func (s *BiconnectedSuite) TestSplitBowtie(c *C) {
+	g := gogl.Spec().Using(bowtie).Create(al.G)
+
+	blocks := SplitAtArticulationPoints(g)
+	c.Assert(blocks, HasLen, 2)
+
+	var seen []string
+	for _, b := range blocks {
+		c.Assert(gogl.Order(b), Equals, 3)
+		c.Assert(gogl.Size(b), Equals, 3)
+		c.Assert(b.HasVertex("c"), Equals, true)
+		b.Edges(func(e gogl.Edge) (terminate bool) {
+			c.Assert(g.HasEdge(e), Equals, true)
+			return
+		})
+		b.Vertices(func(v gogl.Vertex) (terminate bool) {
+			if v != "c" {
+				seen = append(seen, v.(string))
+			}
+			return
+		})
+	}
+	c.Assert(seen, HasLen, 4)
+
+	c.Assert(ArticulationPoints(g), DeepEquals, []gogl.Vertex{"c"})
+}
+
+func (s *BiconnectedSuite) TestBlocks(c *C) {
+	// A 4-cycle with a chord, a pendant path off it, and a separate bridge and isolate.
+	g := gogl.Spec().Using(gogl.WithIsolates(gogl.EdgeList{
+		gogl.NewEdge(1, 2),
+		gogl.NewEdge(2, 3),
+		gogl.NewEdge(3, 4),
+		gogl.NewEdge(4, 1),
+		gogl.NewEdge(1, 3),
+		gogl.NewEdge(4, 5),
+		gogl.NewEdge(5, 6),
+		gogl.NewEdge(7, 8),
+		gogl.NewEdge(8, 8),
+	}, 9)).Create(al.G)
+
+	sizes := make(map[int]int)
+	for _, block := range BiconnectedComponents(g) {
+		sizes[len(block)]++
+	}
+	c.Assert(sizes, DeepEquals, map[int]int{5: 1, 1: 3})
+
+	cuts := make(map[gogl.Vertex]bool)
+	for _, v := range ArticulationPoints(g) {
+		cuts[v] = true
+	}
+	c.Assert(cuts, DeepEquals, map[gogl.Vertex]bool{4: true, 5: true})
+
+	// Every vertex shows up somewhere, with the isolate alone in its own graph.
+	graphs := SplitAtArticulationPoints(g)
+	c.Assert(graphs, HasLen, 5)
+	last := graphs[len(graphs)-1]
+	c.Assert(gogl.Order(last), Equals, 1)
+	c.Assert(last.HasVertex(9), Equals, true)
+}
+
+func (s *BiconnectedSuite) TestKeepsEdgeKind(c *C) {
+	g := gogl.Spec().Weighted().Using(gogl.WeightedEdgeList{
+		gogl.NewWeightedEdge("a", "b", 2),
+		gogl.NewWeightedEdge("b", "c", 3),
+	}).Create(al.G)
+
+	blocks := SplitAtArticulationPoints(g)
+	c.Assert(blocks, HasLen, 2)
+	for _, b := range blocks {
+		wg, ok := b.(gogl.WeightedGraph)
+		c.Assert(ok, Equals, true)
+		c.Assert(wg.HasWeightedEdge(gogl.NewWeightedEdge("a", "b", 2)) || wg.HasWeightedEdge(gogl.NewWeightedEdge("b", "c", 3)), Equals, true)
+	}
+}
+
+func (s *BiconnectedSuite) TestDigraph(c *C) {
+	g := gogl.Spec().Directed().Using(gogl.ArcList{
+		gogl.NewArc("a", "b"),
+		gogl.NewArc("b", "c"),
+		gogl.NewArc("a", "c"),
+		gogl.NewArc("c", "d"),
+	}).Create(al.G)
+
+	blocks := SplitAtArticulationPoints(g)
+	c.Assert(blocks, HasLen, 2)
+	for _, b := range blocks {
+		dg, ok := b.(gogl.Digraph)
+		c.Assert(ok, Equals, true)
+		dg.Arcs(func(a gogl.Arc) (terminate bool) {
+			c.Assert(g.(gogl.Digraph).HasArc(a), Equals, true)
+			return
+		})
+	}
+}
@@ -0,0 +1,166 @@
+package dfs
+
+import (
+	"github.com/sdboyer/gogl"
+)
+
+// Enumerates all elementary circuits (cycles in which no vertex repeats) of the given
+// digraph, using Johnson's algorithm.
+//
+// Each circuit is returned as an ordered vertex slice, where each vertex has an arc to
+// the next and the last has an arc back to the first. The first vertex is not repeated
+// at the end. Loops are reported as circuits of length 1.
+//
+// Johnson's algorithm runs in O((V+E)(C+1)) time for C circuits - but C can be
+// exponential in the size of the graph. If all that is needed is to know whether a
+// graph has any cycles, use IsAcyclic() instead.
+func Cycles(g gogl.Digraph) [][]gogl.Vertex {
+	vertices := gogl.CollectVertices(g)
+	n := len(vertices)
+
+	index := make(map[gogl.Vertex]int, n)
+	for i, v := range vertices {
+		index[v] = i
+	}
+
+	adj := make([][]int, n)
+	for i, v := range vertices {
+		g.SuccessorsOf(v, func(s gogl.Vertex) (terminate bool) {
+			adj[i] = append(adj[i], index[s])
+			return
+		})
+	}
+
+	var cycles [][]gogl.Vertex
+	blocked := make([]bool, n)
+	b := make([]map[int]struct{}, n)
+	var stack []int
+
+	var unblock func(u int)
+	unblock = func(u int) {
+		blocked[u] = false
+		for w := range b[u] {
+			delete(b[u], w)
+			if blocked[w] {
+				unblock(w)
+			}
+		}
+	}
+
+	for s := 0; s < n; s++ {
+		// Restrict the search to the strongly connected component containing s, in the
+		// subgraph induced by vertices s through n-1.
+		comp := sccOf(adj, s)
+		if comp == nil {
+			continue
+		}
+
+		for v := range comp {
+			blocked[v] = false
+			b[v] = make(map[int]struct{})
+		}
+
+		var circuit func(v int) bool
+		circuit = func(v int) bool {
+			found := false
+			stack = append(stack, v)
+			blocked[v] = true
+
+			for _, w := range adj[v] {
+				if !comp[w] {
+					continue
+				}
+
+				if w == s {
+					cycle := make([]gogl.Vertex, len(stack))
+					for i, x := range stack {
+						cycle[i] = vertices[x]
+					}
+					cycles = append(cycles, cycle)
+					found = true
+				} else if !blocked[w] && circuit(w) {
+					found = true
+				}
+			}
+
+			if found {
+				unblock(v)
+			} else {
+				for _, w := range adj[v] {
+					if comp[w] {
+						b[w][v] = struct{}{}
+					}
+				}
+			}
+
+			stack = stack[:len(stack)-1]
+			return found
+		}
+
+		circuit(s)
+	}
+
+	return cycles
+}
+
+// Finds the strongly connected component containing s within the subgraph induced by
+// vertices with index >= s, using Tarjan's algorithm. Returns nil if that component
+// can contain no circuits - that is, if it is s alone, and s has no loop.
+func sccOf(adj [][]int, s int) map[int]bool {
+	var counter int
+	idx := make(map[int]int)
+	low := make(map[int]int)
+	onStack := make(map[int]bool)
+	var stack []int
+	var comp map[int]bool
+
+	var strongconnect func(v int)
+	strongconnect = func(v int) {
+		idx[v], low[v] = counter, counter
+		counter++
+		stack = append(stack, v)
+		onStack[v] = true
+
+		for _, w := range adj[v] {
+			if w < s {
+				continue
+			}
+			if _, visited := idx[w]; !visited {
+				strongconnect(w)
+				if low[w] < low[v] {
+					low[v] = low[w]
+				}
+			} else if onStack[w] && idx[w] < low[v] {
+				low[v] = idx[w]
+			}
+		}
+
+		if low[v] == idx[v] {
+			members := make(map[int]bool)
+			for {
+				w := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				onStack[w] = false
+				members[w] = true
+				if w == v {
+					break
+				}
+			}
+			if members[s] {
+				comp = members
+			}
+		}
+	}
+
+	strongconnect(s)
+
+	if len(comp) == 1 {
+		for _, w := range adj[s] {
+			if w == s {
+				return comp
+			}
+		}
+		return nil
+	}
+	return comp
+}
@@ -0,0 +1,82 @@
+package dfs
+
+import (
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+type CyclesSuite struct{}
+
+var _ = Suite(&CyclesSuite{})
+
+// Verifies that every returned cycle is a genuine elementary circuit in the graph.
+func assertCircuits(c *C, g gogl.Digraph, cycles [][]gogl.Vertex) {
+	for _, cycle := range cycles {
+		seen := make(map[gogl.Vertex]bool)
+		for i, v := range cycle {
+			c.Assert(seen[v], Equals, false)
+			seen[v] = true
+			c.Assert(g.HasArc(gogl.NewArc(v, cycle[(i+1)%len(cycle)])), Equals, true)
+		}
+	}
+}
+
+func complete(n int) gogl.Digraph {
+	arcs := gogl.ArcList{}
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if i != j {
+				arcs = append(arcs, gogl.NewArc(i, j))
+			}
+		}
+	}
+	return gogl.Spec().Directed().Using(arcs).Create(al.G).(gogl.Digraph)
+}
+
+func (s *CyclesSuite) TestOverlappingCycles(c *C) {
+	// a->b->c->a and b->c->d->b share the b->c arc.
+	g := gogl.Spec().Directed().Using(gogl.ArcList{
+		gogl.NewArc("a", "b"),
+		gogl.NewArc("b", "c"),
+		gogl.NewArc("c", "a"),
+		gogl.NewArc("c", "d"),
+		gogl.NewArc("d", "b"),
+		gogl.NewArc("d", "e"),
+	}).Create(al.G).(gogl.Digraph)
+
+	cycles := Cycles(g)
+	c.Assert(len(cycles), Equals, 2)
+	assertCircuits(c, g, cycles)
+
+	lens := map[int]int{}
+	for _, cycle := range cycles {
+		lens[len(cycle)]++
+	}
+	c.Assert(lens, DeepEquals, map[int]int{3: 2})
+}
+
+func (s *CyclesSuite) TestCompleteDigraphs(c *C) {
+	// K3 has three 2-cycles and two 3-cycles; K4 has 6 + 8 + 6.
+	g := complete(3)
+	cycles := Cycles(g)
+	c.Assert(len(cycles), Equals, 5)
+	assertCircuits(c, g, cycles)
+
+	g = complete(4)
+	cycles = Cycles(g)
+	c.Assert(len(cycles), Equals, 20)
+	assertCircuits(c, g, cycles)
+}
+
+func (s *CyclesSuite) TestLoopsAndDAGs(c *C) {
+	g := gogl.Spec().Directed().Mutable().Using(gogl.ArcList{
+		gogl.NewArc("foo", "bar"),
+		gogl.NewArc("bar", "baz"),
+	}).Create(al.G).(gogl.MutableDigraph)
+	c.Assert(len(Cycles(g.(gogl.Digraph))), Equals, 0)
+
+	g.AddArcs(gogl.NewArc("bar", "bar"))
+	cycles := Cycles(g.(gogl.Digraph))
+	c.Assert(cycles, DeepEquals, [][]gogl.Vertex{{"bar"}})
+}
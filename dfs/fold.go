@@ -0,0 +1,49 @@
+package dfs
+
+import (
+	"github.com/sdboyer/gogl"
+)
+
+// Folds over the vertices reachable from the given start vertex in depth-first preorder,
+// threading an accumulator through the given step func: each visited vertex, the start
+// included, is passed to step along with the current accumulator, and the result becomes
+// the accumulator for the next vertex. Returns the final accumulator; if start is not
+// present in the graph, that is init.
+//
+// If the graph is a Digraph, traversal follows arc direction only.
+func Fold[A any](g gogl.Graph, start gogl.Vertex, init A, step func(acc A, v gogl.Vertex) A) A {
+	acc := init
+	if !g.HasVertex(start) {
+		return acc
+	}
+
+	dg, directed := g.(gogl.Digraph)
+	visited := make(map[gogl.Vertex]struct{})
+	stack := []gogl.Vertex{start}
+
+	for len(stack) > 0 {
+		v := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if _, seen := visited[v]; seen {
+			continue
+		}
+
+		visited[v] = struct{}{}
+		acc = step(acc, v)
+
+		push := func(adj gogl.Vertex) (terminate bool) {
+			if _, seen := visited[adj]; !seen {
+				stack = append(stack, adj)
+			}
+			return
+		}
+
+		if directed {
+			dg.SuccessorsOf(v, push)
+		} else {
+			g.AdjacentTo(v, push)
+		}
+	}
+
+	return acc
+}
@@ -0,0 +1,55 @@
+package dfs
+
+import (
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+type FoldSuite struct{}
+
+var _ = Suite(&FoldSuite{})
+
+var foldArcs = gogl.ArcList{
+	gogl.NewArc(1, 2),
+	gogl.NewArc(1, 3),
+	gogl.NewArc(2, 4),
+	gogl.NewArc(3, 4),
+	gogl.NewArc(4, 1),
+	gogl.NewArc(5, 1),
+	gogl.NewArc(6, 7),
+}
+
+func (s *FoldSuite) TestSum(c *C) {
+	g := gogl.Spec().Directed().Using(foldArcs).Create(al.G).(gogl.Digraph)
+
+	sum := Fold(g, 1, 0, func(acc int, v gogl.Vertex) int {
+		return acc + v.(int)
+	})
+	c.Assert(sum, Equals, 1+2+3+4)
+
+	c.Assert(Fold(g, 5, 0, func(acc int, v gogl.Vertex) int { return acc + v.(int) }), Equals, 15)
+	c.Assert(Fold(g, 99, -1, func(acc int, v gogl.Vertex) int { return acc + v.(int) }), Equals, -1)
+}
+
+func (s *FoldSuite) TestPreorder(c *C) {
+	g := gogl.Spec().Using(gogl.EdgeList{
+		gogl.NewEdge("a", "b"),
+		gogl.NewEdge("b", "c"),
+		gogl.NewEdge("a", "d"),
+	}).Create(al.G)
+
+	order := Fold(g, "a", []gogl.Vertex{}, func(acc []gogl.Vertex, v gogl.Vertex) []gogl.Vertex {
+		return append(acc, v)
+	})
+	c.Assert(order, HasLen, 4)
+	c.Assert(order[0], Equals, "a")
+
+	// Each vertex after the first is adjacent to some earlier vertex, and once a branch is
+	// entered it is finished before the other: c directly follows b.
+	for i, v := range order {
+		if v == "b" {
+			c.Assert(order[i+1], Equals, "c")
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package dfs
+
+import (
+	"fmt"
+	"iter"
+	"sort"
+
+	"github.com/sdboyer/gogl"
+)
+
+// Returns an iterator over the topological generations of the given digraph. The first
+// generation is the digraph's sources; each subsequent generation holds the vertices
+// whose predecessors all lie in earlier generations. Vertices within a generation have
+// no arcs among them, so they may be processed in any order, or in parallel.
+//
+// Generations are computed lazily, one per iteration, so breaking out of the loop early
+// skips the work for the rest. Each yielded slice is the caller's own, and may be
+// modified or retained without affecting later generations. Each generation's vertices
+// are in their natural order (see gogl.VertexLess), and concatenating the generations
+// yields a topological order.
+//
+// If the digraph contains a cycle, iteration simply stops once no further generation can
+// be formed, leaving the vertices on or downstream of the cycle unvisited. Use
+// TopologicalGenerationsE to have that reported as an error.
+func TopologicalGenerations(g gogl.Digraph) iter.Seq[[]gogl.Vertex] {
+	return func(yield func([]gogl.Vertex) bool) {
+		for gen, err := range TopologicalGenerationsE(g) {
+			if err != nil || !yield(gen) {
+				return
+			}
+		}
+	}
+}
+
+// Returns an iterator over the topological generations of the given digraph, as with
+// TopologicalGenerations, but paired with an error. The error is nil for every genuine
+// generation; if the digraph contains a cycle (including a loop), a final pair with a nil
+// generation and a non-nil error is yielded once no further generation can be formed.
+func TopologicalGenerationsE(g gogl.Digraph) iter.Seq2[[]gogl.Vertex, error] {
+	return func(yield func([]gogl.Vertex, error) bool) {
+		indegree := make(map[gogl.Vertex]int)
+		var current []gogl.Vertex
+		gogl.VerticesSorted(g, func(v gogl.Vertex) (terminate bool) {
+			indegree[v] = 0
+			return
+		})
+		g.Arcs(func(a gogl.Arc) (terminate bool) {
+			indegree[a.Target()]++
+			return
+		})
+		gogl.VerticesSorted(g, func(v gogl.Vertex) (terminate bool) {
+			if indegree[v] == 0 {
+				current = append(current, v)
+			}
+			return
+		})
+
+		remaining := len(indegree)
+		for len(current) > 0 {
+			remaining -= len(current)
+			if !yield(append([]gogl.Vertex(nil), current...), nil) {
+				return
+			}
+
+			var next []gogl.Vertex
+			for _, v := range current {
+				g.ArcsFrom(v, func(a gogl.Arc) (terminate bool) {
+					t := a.Target()
+					if indegree[t]--; indegree[t] == 0 {
+						next = append(next, t)
+					}
+					return
+				})
+			}
+			sort.Slice(next, func(i, j int) bool { return gogl.VertexLess(next[i], next[j]) })
+			current = next
+		}
+
+		if remaining > 0 {
+			yield(nil, fmt.Errorf("Cycle detected in graph; %d vertices could not be placed in a generation.", remaining))
+		}
+	}
+}
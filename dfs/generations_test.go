@@ -0,0 +1,111 @@
+package dfs
+
+import (
+	"math/rand"
+
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+type GenerationsSuite struct{}
+
+var _ = Suite(&GenerationsSuite{})
+
+func (s *GenerationsSuite) TestDiamond(c *C) {
+	g := gogl.Spec().Directed().Using(gogl.WithIsolates(gogl.ArcList{
+		gogl.NewArc("a", "b"),
+		gogl.NewArc("a", "c"),
+		gogl.NewArc("b", "d"),
+		gogl.NewArc("c", "d"),
+		gogl.NewArc("a", "d"),
+	}, "z")).Create(al.G).(gogl.Digraph)
+
+	var gens [][]gogl.Vertex
+	for gen := range TopologicalGenerations(g) {
+		gens = append(gens, gen)
+	}
+	c.Assert(gens, DeepEquals, [][]gogl.Vertex{{"a", "z"}, {"b", "c"}, {"d"}})
+
+	// Breaking early is fine.
+	var count int
+	for range TopologicalGenerations(g) {
+		count++
+		break
+	}
+	c.Assert(count, Equals, 1)
+}
+
+func (s *GenerationsSuite) TestCallerOwnsGenerations(c *C) {
+	g := gogl.Spec().Directed().Using(gogl.ArcList{
+		gogl.NewArc("a", "c"),
+		gogl.NewArc("b", "d"),
+	}).Create(al.G).(gogl.Digraph)
+
+	// Clobbering each generation as it arrives must not change the ones that follow.
+	var gens [][]gogl.Vertex
+	for gen, err := range TopologicalGenerationsE(g) {
+		c.Assert(err, IsNil)
+		gens = append(gens, append([]gogl.Vertex(nil), gen...))
+		for i := range gen {
+			gen[i] = "clobbered"
+		}
+	}
+	c.Assert(gens, DeepEquals, [][]gogl.Vertex{{"a", "b"}, {"c", "d"}})
+}
+
+func (s *GenerationsSuite) TestValidOrder(c *C) {
+	r := rand.New(rand.NewSource(4))
+	arcs := gogl.ArcList{}
+	for i := 0; i < 300; i++ {
+		u, v := r.Intn(80), r.Intn(80)
+		if u < v {
+			arcs = append(arcs, gogl.NewArc(u, v))
+		}
+	}
+	g := gogl.Spec().Directed().Using(arcs).Create(al.G).(gogl.Digraph)
+
+	pos := make(map[gogl.Vertex]int)
+	for gen, err := range TopologicalGenerationsE(g) {
+		c.Assert(err, IsNil)
+		for _, v := range gen {
+			pos[v] = len(pos)
+		}
+	}
+	c.Assert(pos, HasLen, gogl.Order(g))
+
+	g.Arcs(func(a gogl.Arc) (terminate bool) {
+		c.Assert(pos[a.Source()] < pos[a.Target()], Equals, true)
+		return
+	})
+}
+
+func (s *GenerationsSuite) TestCycle(c *C) {
+	g := gogl.Spec().Directed().Using(gogl.ArcList{
+		gogl.NewArc("a", "b"),
+		gogl.NewArc("b", "c"),
+		gogl.NewArc("c", "b"),
+		gogl.NewArc("c", "d"),
+	}).Create(al.G).(gogl.Digraph)
+
+	var gens [][]gogl.Vertex
+	var errs []error
+	for gen, err := range TopologicalGenerationsE(g) {
+		if err != nil {
+			errs = append(errs, err)
+			c.Assert(gen, IsNil)
+			continue
+		}
+		gens = append(gens, gen)
+	}
+	c.Assert(gens, DeepEquals, [][]gogl.Vertex{{"a"}})
+	c.Assert(errs, HasLen, 1)
+	c.Assert(errs[0], ErrorMatches, "Cycle detected.*3 vertices.*")
+
+	// The plain iterator just stops.
+	var n int
+	for range TopologicalGenerations(g) {
+		n++
+	}
+	c.Assert(n, Equals, 1)
+}
@@ -0,0 +1,154 @@
+package dfs
+
+import (
+	"github.com/sdboyer/gogl"
+)
+
+// An LCAIndex answers lowest common ancestor queries on a rooted tree in O(log n) time,
+// using binary lifting over a precomputed table of each vertex's 2^k'th ancestors.
+//
+// The tree's edges are taken from AdjacentTo(), so arc direction is disregarded. If the
+// graph is not a tree, the depth-first spanning tree from the root is used. The index is
+// a snapshot; it does not reflect changes made to the graph after its creation.
+type LCAIndex struct {
+	index map[gogl.Vertex]int
+	verts []gogl.Vertex
+	depth []int
+	// up[k][i] is the 2^k'th ancestor of vertex i, or the root if there is none.
+	up [][]int
+}
+
+// Builds an LCAIndex for the tree rooted at the given vertex. Only vertices reachable
+// from the root are indexed.
+func NewLCAIndex(tree gogl.Graph, root gogl.Vertex) *LCAIndex {
+	li := &LCAIndex{index: make(map[gogl.Vertex]int)}
+	if !tree.HasVertex(root) {
+		return li
+	}
+
+	var parent []int
+	var visit func(v gogl.Vertex, p, d int)
+	visit = func(v gogl.Vertex, p, d int) {
+		i := len(li.verts)
+		li.index[v] = i
+		li.verts = append(li.verts, v)
+		li.depth = append(li.depth, d)
+		parent = append(parent, p)
+
+		tree.AdjacentTo(v, func(adj gogl.Vertex) (terminate bool) {
+			if _, visited := li.index[adj]; !visited {
+				visit(adj, i, d+1)
+			}
+			return
+		})
+	}
+	visit(root, 0, 0)
+
+	li.up = [][]int{parent}
+	for k := 1; 1<<uint(k) < len(li.verts); k++ {
+		prev := li.up[k-1]
+		next := make([]int, len(prev))
+		for i := range prev {
+			next[i] = prev[prev[i]]
+		}
+		li.up = append(li.up, next)
+	}
+
+	return li
+}
+
+// Returns the lowest common ancestor of u and v: the deepest vertex that is an ancestor
+// of both, where every vertex is considered an ancestor of itself. The second return
+// value is false if either vertex is not in the tree.
+func (li *LCAIndex) LCA(u, v gogl.Vertex) (gogl.Vertex, bool) {
+	i, ok := li.index[u]
+	if !ok {
+		return nil, false
+	}
+	j, ok := li.index[v]
+	if !ok {
+		return nil, false
+	}
+
+	if li.depth[i] < li.depth[j] {
+		i, j = j, i
+	}
+	for k := len(li.up) - 1; k >= 0; k-- {
+		if li.depth[i]-1<<uint(k) >= li.depth[j] {
+			i = li.up[k][i]
+		}
+	}
+	if i == j {
+		return li.verts[i], true
+	}
+
+	for k := len(li.up) - 1; k >= 0; k-- {
+		if li.up[k][i] != li.up[k][j] {
+			i, j = li.up[k][i], li.up[k][j]
+		}
+	}
+	return li.verts[li.up[0][i]], true
+}
+
+// Answers a batch of lowest common ancestor queries on the tree rooted at the given
+// vertex, using Tarjan's offline algorithm. A single depth-first traversal, with a
+// union-find structure tracking the ancestor of each finished subtree, answers all
+// queries in near-linear total time; this beats an LCAIndex when all queries are known
+// upfront.
+//
+// The returned slice is positionally aligned with the queries. Queries involving a
+// vertex that is not in the tree are answered with nil. As with LCAIndex, arc direction
+// is disregarded.
+func OfflineLCA(tree gogl.Graph, root gogl.Vertex, queries [][2]gogl.Vertex) []gogl.Vertex {
+	answers := make([]gogl.Vertex, len(queries))
+	if !tree.HasVertex(root) {
+		return answers
+	}
+
+	// The queries involving each vertex, by position.
+	pending := make(map[gogl.Vertex][]int)
+	for q, pair := range queries {
+		pending[pair[0]] = append(pending[pair[0]], q)
+		if pair[1] != pair[0] {
+			pending[pair[1]] = append(pending[pair[1]], q)
+		}
+	}
+
+	rep := make(map[gogl.Vertex]gogl.Vertex)
+	ancestor := make(map[gogl.Vertex]gogl.Vertex)
+	done := make(map[gogl.Vertex]bool)
+	var find func(v gogl.Vertex) gogl.Vertex
+	find = func(v gogl.Vertex) gogl.Vertex {
+		if rep[v] != v {
+			rep[v] = find(rep[v])
+		}
+		return rep[v]
+	}
+
+	var visit func(v gogl.Vertex)
+	visit = func(v gogl.Vertex) {
+		rep[v], ancestor[v] = v, v
+		tree.AdjacentTo(v, func(adj gogl.Vertex) (terminate bool) {
+			if _, visited := rep[adj]; !visited {
+				visit(adj)
+				rep[find(adj)] = find(v)
+				ancestor[find(v)] = v
+			}
+			return
+		})
+
+		done[v] = true
+		for _, q := range pending[v] {
+			other := queries[q][0]
+			if other == v {
+				other = queries[q][1]
+			}
+			if done[other] {
+				answers[q] = ancestor[find(other)]
+			}
+		}
+	}
+	visit(root)
+
+	return answers
+}
@@ -0,0 +1,84 @@
+package dfs
+
+import (
+	"math/rand"
+
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+type LCASuite struct{}
+
+var _ = Suite(&LCASuite{})
+
+// A small tree rooted at a:
+//
+//	    a
+//	   / \
+//	  b   c
+//	 / \   \
+//	d   e   f
+//	    |
+//	    g
+var lcaTree = gogl.EdgeList{
+	gogl.NewEdge("a", "b"),
+	gogl.NewEdge("a", "c"),
+	gogl.NewEdge("b", "d"),
+	gogl.NewEdge("b", "e"),
+	gogl.NewEdge("c", "f"),
+	gogl.NewEdge("e", "g"),
+}
+
+func (s *LCASuite) TestSmallTree(c *C) {
+	g := gogl.Spec().Using(gogl.WithIsolates(lcaTree, "z")).Create(al.G)
+	queries := [][2]gogl.Vertex{
+		{"d", "g"}, {"g", "d"}, {"g", "f"}, {"b", "g"}, {"e", "e"}, {"a", "f"}, {"d", "z"}, {"q", "a"},
+	}
+	want := []gogl.Vertex{"b", "b", "a", "b", "e", "a", nil, nil}
+
+	c.Assert(OfflineLCA(g, "a", queries), DeepEquals, want)
+
+	li := NewLCAIndex(g, "a")
+	for i, q := range queries {
+		lca, ok := li.LCA(q[0], q[1])
+		c.Assert(ok, Equals, want[i] != nil)
+		c.Assert(lca, Equals, want[i])
+	}
+
+	// Rooting elsewhere changes the answers.
+	c.Assert(OfflineLCA(g, "g", [][2]gogl.Vertex{{"d", "f"}, {"a", "d"}}), DeepEquals, []gogl.Vertex{"b", "b"})
+}
+
+func (s *LCASuite) TestMatchesBinaryLifting(c *C) {
+	r := rand.New(rand.NewSource(21))
+	for trial := 0; trial < 5; trial++ {
+		// A random recursive tree, given as arcs from parent to child.
+		arcs := gogl.ArcList{}
+		for i := 1; i < 500; i++ {
+			arcs = append(arcs, gogl.NewArc(r.Intn(i), i))
+		}
+		g := gogl.Spec().Directed().Using(arcs).Create(al.G)
+
+		queries := make([][2]gogl.Vertex, 2000)
+		for i := range queries {
+			queries[i] = [2]gogl.Vertex{r.Intn(500), r.Intn(500)}
+		}
+
+		root := r.Intn(500)
+		li := NewLCAIndex(g, root)
+		for i, lca := range OfflineLCA(g, root, queries) {
+			want, ok := li.LCA(queries[i][0], queries[i][1])
+			c.Assert(ok, Equals, true)
+			c.Assert(lca, Equals, want)
+		}
+	}
+}
+
+func (s *LCASuite) TestMissingRoot(c *C) {
+	g := gogl.Spec().Using(lcaTree).Create(al.G)
+	c.Assert(OfflineLCA(g, "x", [][2]gogl.Vertex{{"a", "b"}}), DeepEquals, []gogl.Vertex{nil})
+
+	_, ok := NewLCAIndex(g, "x").LCA("a", "b")
+	c.Assert(ok, Equals, false)
+}
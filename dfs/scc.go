@@ -0,0 +1,267 @@
+package dfs
+
+import (
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+// Finds the strongly connected components of the given digraph, using Tarjan's algorithm.
+//
+// Components are returned in topological order: if any arc runs from a vertex in one
+// component to a vertex in another, the former component precedes the latter.
+func StronglyConnectedComponents(g gogl.Digraph) [][]gogl.Vertex {
+	var counter int
+	idx := make(map[gogl.Vertex]int)
+	low := make(map[gogl.Vertex]int)
+	onStack := make(map[gogl.Vertex]bool)
+	var stack []gogl.Vertex
+	var comps [][]gogl.Vertex
+
+	var strongconnect func(v gogl.Vertex)
+	strongconnect = func(v gogl.Vertex) {
+		idx[v], low[v] = counter, counter
+		counter++
+		stack = append(stack, v)
+		onStack[v] = true
+
+		g.SuccessorsOf(v, func(w gogl.Vertex) (terminate bool) {
+			if _, visited := idx[w]; !visited {
+				strongconnect(w)
+				if low[w] < low[v] {
+					low[v] = low[w]
+				}
+			} else if onStack[w] && idx[w] < low[v] {
+				low[v] = idx[w]
+			}
+			return
+		})
+
+		if low[v] == idx[v] {
+			var comp []gogl.Vertex
+			for {
+				w := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				onStack[w] = false
+				comp = append(comp, w)
+				if w == v {
+					break
+				}
+			}
+			comps = append(comps, comp)
+		}
+	}
+
+	g.Vertices(func(v gogl.Vertex) (terminate bool) {
+		if _, visited := idx[v]; !visited {
+			strongconnect(v)
+		}
+		return
+	})
+
+	// Tarjan's algorithm emits components in reverse topological order
+	for i, j := 0, len(comps)-1; i < j; i, j = i+1, j-1 {
+		comps[i], comps[j] = comps[j], comps[i]
+	}
+
+	return comps
+}
+
+// Computes the condensation of the given digraph: the DAG obtained by contracting each
+// of its strongly connected components into a single vertex.
+//
+// The vertices of the returned digraph are the ints 0..n-1, numbering the components
+// in topological order (as from StronglyConnectedComponents). The returned map gives
+// the component number of each vertex in the original graph.
+func Condensation(g gogl.Digraph) (gogl.Digraph, map[gogl.Vertex]int) {
+	comps := StronglyConnectedComponents(g)
+	compOf := make(map[gogl.Vertex]int, gogl.Order(g))
+	for i, comp := range comps {
+		for _, v := range comp {
+			compOf[v] = i
+		}
+	}
+
+	vertices := make([]gogl.Vertex, len(comps))
+	for i := range comps {
+		vertices[i] = i
+	}
+
+	arcs := gogl.ArcList{}
+	g.Arcs(func(a gogl.Arc) (terminate bool) {
+		u, v := a.Both()
+		if cu, cv := compOf[u], compOf[v]; cu != cv {
+			arcs = append(arcs, gogl.NewArc(cu, cv))
+		}
+		return
+	})
+
+	cg := gogl.Spec().Directed().Using(gogl.WithIsolates(arcs, vertices...)).Create(al.G)
+	return cg.(gogl.Digraph), compOf
+}
+
+// A ReachabilityIndex answers reachability queries on a digraph in constant time,
+// from a structure precomputed over the digraph's condensation.
+//
+// All vertices within a strongly connected component are mutually reachable, so
+// reachability need only be computed between components. On graphs with large
+// components, this is a substantial reduction. The index is a snapshot; it does not
+// reflect changes made to the graph after its creation.
+type ReachabilityIndex struct {
+	compOf map[gogl.Vertex]int
+	// reach[i] is a bitset of the components reachable from component i
+	reach [][]uint64
+}
+
+// Builds a ReachabilityIndex for the given digraph.
+func NewReachabilityIndex(g gogl.Digraph) *ReachabilityIndex {
+	cg, compOf := Condensation(g)
+	n := gogl.Order(cg)
+	words := (n + 63) / 64
+
+	reach := make([][]uint64, n)
+	// Components are numbered in topological order, so walking them in reverse ensures
+	// each one's successors are complete before it is itself processed.
+	for i := n - 1; i >= 0; i-- {
+		reach[i] = make([]uint64, words)
+		reach[i][i/64] |= 1 << uint(i%64)
+		cg.SuccessorsOf(i, func(s gogl.Vertex) (terminate bool) {
+			for w, bits := range reach[s.(int)] {
+				reach[i][w] |= bits
+			}
+			return
+		})
+	}
+
+	return &ReachabilityIndex{compOf: compOf, reach: reach}
+}
+
+// Indicates whether there is a path from u to v. Every vertex is considered to reach
+// itself. Returns false if either vertex was not present in the graph.
+func (ri *ReachabilityIndex) Reachable(u, v gogl.Vertex) bool {
+	cu, ok := ri.compOf[u]
+	if !ok {
+		return false
+	}
+	cv, ok := ri.compOf[v]
+	if !ok {
+		return false
+	}
+
+	return ri.reach[cu][cv/64]&(1<<uint(cv%64)) != 0
+}
+
+// Indicates whether u and v lie in the same strongly connected component. Returns false
+// if either vertex was not present in the graph.
+func (ri *ReachabilityIndex) StronglyConnected(u, v gogl.Vertex) bool {
+	cu, ok := ri.compOf[u]
+	if !ok {
+		return false
+	}
+	cv, ok := ri.compOf[v]
+	return ok && cu == cv
+}
+
+// A CondensedPaths answers shortest-distance queries on a digraph by running DAG
+// shortest paths over its condensation, treating travel within a strongly connected
+// component as free.
+//
+// Each pair of components is joined in the condensation by the lightest arc between
+// them. A query relaxes the condensation's arcs in topological order, starting from the
+// source's component, which takes time linear in the size of the condensation rather
+// than in that of the original graph, and needs no priority queue. On graphs with large
+// cycles, the condensation is far smaller.
+//
+// The distances assume that every arc within a component has weight zero, and are exact
+// when that holds. Otherwise they are the distances in the graph with those arcs'
+// weights taken as zero, which, for non-negative weights, is a lower bound on the true
+// distance. Arcs between components may have any weight, including negative, as the
+// condensation has no cycles. Arcs are weighted if they implement gogl.WeightedArc;
+// other arcs count for 1. Like ReachabilityIndex, the structure is a snapshot.
+type CondensedPaths struct {
+	compOf map[gogl.Vertex]int
+	// out[i] holds the lightest arc weight from component i to each successor component
+	out []map[int]float64
+}
+
+// Builds a CondensedPaths for the given digraph.
+func NewCondensedPaths(g gogl.Digraph) *CondensedPaths {
+	cg, compOf := Condensation(g)
+
+	// The condensation gives the components and which are joined, but not by what
+	// weight, so the original arcs are revisited for that.
+	out := make([]map[int]float64, gogl.Order(cg))
+	for i := range out {
+		out[i] = make(map[int]float64)
+	}
+	g.Arcs(func(a gogl.Arc) (terminate bool) {
+		cu, cv := compOf[a.Source()], compOf[a.Target()]
+		if cu == cv {
+			return
+		}
+
+		w := 1.0
+		if wa, ok := a.(gogl.WeightedArc); ok {
+			w = wa.Weight()
+		}
+		if d, exists := out[cu][cv]; !exists || w < d {
+			out[cu][cv] = w
+		}
+		return
+	})
+
+	return &CondensedPaths{compOf: compOf, out: out}
+}
+
+// Returns the shortest distance from u to v, as described on CondensedPaths. Returns
+// false if v is not reachable from u, or if either vertex was not present in the graph.
+func (cp *CondensedPaths) Distance(u, v gogl.Vertex) (float64, bool) {
+	cu, ok := cp.compOf[u]
+	if !ok {
+		return 0, false
+	}
+	cv, ok := cp.compOf[v]
+	if !ok {
+		return 0, false
+	}
+
+	dist := cp.from(cu, cv)
+	d, reached := dist[cv]
+	return d, reached
+}
+
+// Returns the shortest distance from u to every vertex reachable from it, as described on
+// CondensedPaths. Returns nil if u was not present in the graph.
+func (cp *CondensedPaths) DistancesFrom(u gogl.Vertex) map[gogl.Vertex]float64 {
+	cu, ok := cp.compOf[u]
+	if !ok {
+		return nil
+	}
+
+	dist := cp.from(cu, len(cp.out)-1)
+	result := make(map[gogl.Vertex]float64)
+	for v, c := range cp.compOf {
+		if d, reached := dist[c]; reached {
+			result[v] = d
+		}
+	}
+	return result
+}
+
+// Relaxes the condensation's arcs in topological order, from the source component up to
+// and including the last one of interest. Components are numbered in topological order,
+// so none before the source can be reached, and none after last can lead back to it.
+func (cp *CondensedPaths) from(source, last int) map[int]float64 {
+	dist := map[int]float64{source: 0}
+	for i := source; i <= last; i++ {
+		di, reached := dist[i]
+		if !reached {
+			continue
+		}
+		for j, w := range cp.out[i] {
+			if d, exists := dist[j]; !exists || di+w < d {
+				dist[j] = di + w
+			}
+		}
+	}
+	return dist
+}
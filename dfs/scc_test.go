@@ -0,0 +1,148 @@
+package dfs
+
+import (
+	"math/rand"
+
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/bfs"
+	"github.com/sdboyer/gogl/graph/al"
+	"github.com/sdboyer/gogl/sp"
+)
+
+type SCCSuite struct{}
+
+var _ = Suite(&SCCSuite{})
+
+// Two 3-cycles joined by a single arc, plus an isolate and a tail.
+var sccFixture = gogl.ArcList{
+	gogl.NewArc("a", "b"),
+	gogl.NewArc("b", "c"),
+	gogl.NewArc("c", "a"),
+	gogl.NewArc("c", "d"),
+	gogl.NewArc("d", "e"),
+	gogl.NewArc("e", "f"),
+	gogl.NewArc("f", "d"),
+	gogl.NewArc("f", "g"),
+}
+
+func (s *SCCSuite) TestStronglyConnectedComponents(c *C) {
+	g := gogl.Spec().Directed().Using(gogl.WithIsolates(sccFixture, "h")).Create(al.G).(gogl.Digraph)
+
+	comps := StronglyConnectedComponents(g)
+	c.Assert(comps, HasLen, 4)
+
+	pos := make(map[gogl.Vertex]int)
+	for i, comp := range comps {
+		for _, v := range comp {
+			pos[v] = i
+		}
+	}
+
+	c.Assert(pos["a"] == pos["b"] && pos["b"] == pos["c"], Equals, true)
+	c.Assert(pos["d"] == pos["e"] && pos["e"] == pos["f"], Equals, true)
+	c.Assert(pos["a"] != pos["d"], Equals, true)
+
+	// topological order
+	c.Assert(pos["a"] < pos["d"], Equals, true)
+	c.Assert(pos["d"] < pos["g"], Equals, true)
+}
+
+func (s *SCCSuite) TestCondensation(c *C) {
+	g := gogl.Spec().Directed().Using(sccFixture).Create(al.G).(gogl.Digraph)
+
+	cg, compOf := Condensation(g)
+	c.Assert(gogl.Order(cg), Equals, 3)
+	c.Assert(gogl.Size(cg), Equals, 2)
+	c.Assert(IsAcyclic(cg), Equals, true)
+	c.Assert(cg.HasArc(gogl.NewArc(compOf["a"], compOf["d"])), Equals, true)
+	c.Assert(cg.HasArc(gogl.NewArc(compOf["d"], compOf["g"])), Equals, true)
+}
+
+func (s *SCCSuite) TestReachabilityMatchesBFS(c *C) {
+	r := rand.New(rand.NewSource(42))
+	arcs := gogl.ArcList{}
+	for i := 0; i < 120; i++ {
+		arcs = append(arcs, gogl.NewArc(r.Intn(60), r.Intn(60)))
+	}
+	g := gogl.Spec().Directed().Using(arcs).Create(al.G).(gogl.Digraph)
+
+	ri := NewReachabilityIndex(g)
+	g.Vertices(func(u gogl.Vertex) (terminate bool) {
+		dist := bfs.MultiSourceBFS(g, u)
+		g.Vertices(func(v gogl.Vertex) (terminate bool) {
+			_, reached := dist[v]
+			c.Assert(ri.Reachable(u, v), Equals, reached)
+			return
+		})
+		return
+	})
+
+	c.Assert(ri.Reachable("nope", 1), Equals, false)
+}
+
+func (s *SCCSuite) TestStronglyConnected(c *C) {
+	ri := NewReachabilityIndex(gogl.Spec().Directed().Using(sccFixture).Create(al.G).(gogl.Digraph))
+
+	c.Assert(ri.StronglyConnected("a", "c"), Equals, true)
+	c.Assert(ri.StronglyConnected("a", "d"), Equals, false)
+	c.Assert(ri.Reachable("a", "g"), Equals, true)
+	c.Assert(ri.Reachable("g", "a"), Equals, false)
+}
+
+// With zero weights inside components, condensed distances match Bellman-Ford on the
+// original graph exactly, negative weights between components included.
+func (s *SCCSuite) TestCondensedPathsMatchBellmanFord(c *C) {
+	r := rand.New(rand.NewSource(7))
+	arcs := gogl.ArcList{}
+	for i := 0; i < 150; i++ {
+		arcs = append(arcs, gogl.NewArc(r.Intn(60), r.Intn(60)))
+	}
+	_, compOf := Condensation(gogl.Spec().Directed().Using(arcs).Create(al.G).(gogl.Digraph))
+
+	weighted := gogl.WeightedArcList{}
+	for _, a := range arcs {
+		u, v := a.Both()
+		w := 0.0
+		if compOf[u] != compOf[v] {
+			w = float64(r.Intn(20) - 5)
+		}
+		weighted = append(weighted, gogl.NewWeightedArc(u, v, w))
+	}
+	g := gogl.Spec().Directed().Weighted().Using(weighted).Create(al.G).(gogl.WeightedDigraph)
+
+	cp := NewCondensedPaths(g)
+	g.Vertices(func(u gogl.Vertex) (terminate bool) {
+		want, _, err := sp.BellmanFordShortestPath(g, u)
+		c.Assert(err, IsNil)
+		c.Assert(cp.DistancesFrom(u), DeepEquals, want)
+
+		g.Vertices(func(v gogl.Vertex) (terminate bool) {
+			d, reached := cp.Distance(u, v)
+			wd, wreached := want[v]
+			c.Assert(reached, Equals, wreached)
+			c.Assert(d, Equals, wd)
+			return
+		})
+		return
+	})
+}
+
+func (s *SCCSuite) TestCondensedPathsUnweighted(c *C) {
+	cp := NewCondensedPaths(gogl.Spec().Directed().Using(gogl.WithIsolates(sccFixture, "h")).Create(al.G).(gogl.Digraph))
+
+	// Each arc between components counts for 1; those within are free.
+	d, reached := cp.Distance("a", "g")
+	c.Assert(reached, Equals, true)
+	c.Assert(d, Equals, 2.0)
+	d, _ = cp.Distance("b", "a")
+	c.Assert(d, Equals, 0.0)
+
+	_, reached = cp.Distance("g", "a")
+	c.Assert(reached, Equals, false)
+	_, reached = cp.Distance("a", "h")
+	c.Assert(reached, Equals, false)
+	_, reached = cp.Distance("nope", "a")
+	c.Assert(reached, Equals, false)
+	c.Assert(cp.DistancesFrom("nope"), IsNil)
+}
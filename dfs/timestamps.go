@@ -0,0 +1,127 @@
+package dfs
+
+import "github.com/sdboyer/gogl"
+
+// An EdgeClass identifies the role an arc plays in a depth-first traversal.
+type EdgeClass int
+
+const (
+	// An arc to a newly discovered vertex; these form the depth-first tree.
+	TreeEdge EdgeClass = iota
+	// An arc to an ancestor in the depth-first tree (including a loop). Any back edge
+	// implies a cycle.
+	BackEdge
+	// A non-tree arc to an already finished descendant.
+	ForwardEdge
+	// An arc to an already finished vertex that is neither ancestor nor descendant.
+	CrossEdge
+)
+
+// A DFSVisitor receives timestamped callbacks from DepthFirstTraversal.
+type DFSVisitor interface {
+	// Called when a vertex is first discovered. Returning true terminates the traversal
+	// immediately, without finishing any vertex still in progress.
+	OnDiscover(v gogl.Vertex, t int) (terminate bool)
+	// Called when all of a vertex's descendants have been finished.
+	OnFinish(v gogl.Vertex, t int)
+}
+
+// A DFSArcVisitor is a DFSVisitor that additionally wants each arc examined during a
+// traversal of a Digraph, along with its classification.
+type DFSArcVisitor interface {
+	DFSVisitor
+	OnArc(a gogl.Arc, class EdgeClass)
+}
+
+// Performs a depth-first traversal of the given graph from the start vertex, reporting
+// the discovery and finish time of each vertex reached to the provided visitor.
+//
+// Times come from a single counter that starts at 1 and advances by one at each
+// discovery and each finish, so they are strictly increasing over the course of the
+// traversal and every vertex's [discovery, finish] interval nests properly within those
+// of its ancestors. Back edges do not disturb this; a grey vertex is never rediscovered.
+//
+// If the graph is a Digraph, traversal follows arc direction, and if the visitor also
+// implements DFSArcVisitor, each examined arc is passed to OnArc with its classification
+// as a tree, back, forward or cross edge. Only vertices reachable from start are
+// visited; if start is not present in the graph, the visitor is not called.
+//
+// The traversal is iterative, maintaining an explicit stack, so its depth is not bounded
+// by the goroutine stack.
+func DepthFirstTraversal(g gogl.Graph, start gogl.Vertex, visitor DFSVisitor) {
+	if !g.HasVertex(start) {
+		return
+	}
+
+	dg, directed := g.(gogl.Digraph)
+	av, classify := visitor.(DFSArcVisitor)
+	classify = classify && directed
+
+	type frame struct {
+		v    gogl.Vertex
+		arcs []gogl.Arc
+		adj  []gogl.Vertex
+		next int
+	}
+
+	colors := make(map[gogl.Vertex]uint)
+	discovered := make(map[gogl.Vertex]int)
+	var t int
+	var stack []*frame
+
+	discover := func(v gogl.Vertex) (terminate bool) {
+		t++
+		colors[v] = grey
+		discovered[v] = t
+		f := &frame{v: v}
+		if directed {
+			f.arcs = gogl.CollectArcsFrom(v, dg)
+		} else {
+			f.adj = gogl.CollectVerticesAdjacentTo(v, g)
+		}
+		stack = append(stack, f)
+		return visitor.OnDiscover(v, t)
+	}
+
+	if discover(start) {
+		return
+	}
+
+	for len(stack) > 0 {
+		f := stack[len(stack)-1]
+
+		var to gogl.Vertex
+		switch {
+		case f.next < len(f.arcs):
+			a := f.arcs[f.next]
+			to = a.Target()
+			if classify {
+				switch colors[to] {
+				case white:
+					av.OnArc(a, TreeEdge)
+				case grey:
+					av.OnArc(a, BackEdge)
+				default:
+					if discovered[f.v] < discovered[to] {
+						av.OnArc(a, ForwardEdge)
+					} else {
+						av.OnArc(a, CrossEdge)
+					}
+				}
+			}
+		case f.next < len(f.adj):
+			to = f.adj[f.next]
+		default:
+			t++
+			colors[f.v] = black
+			stack = stack[:len(stack)-1]
+			visitor.OnFinish(f.v, t)
+			continue
+		}
+
+		f.next++
+		if colors[to] == white && discover(to) {
+			return
+		}
+	}
+}
@@ -0,0 +1,155 @@
+package dfs
+
+import (
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+type stamper struct {
+	discover, finish map[gogl.Vertex]int
+	classes          map[gogl.Arc]EdgeClass
+	stopAt           gogl.Vertex
+	last             int
+	monotonic        bool
+}
+
+func newStamper() *stamper {
+	return &stamper{
+		discover:  make(map[gogl.Vertex]int),
+		finish:    make(map[gogl.Vertex]int),
+		classes:   make(map[gogl.Arc]EdgeClass),
+		monotonic: true,
+	}
+}
+
+func (s *stamper) tick(t int) {
+	if t <= s.last {
+		s.monotonic = false
+	}
+	s.last = t
+}
+
+func (s *stamper) OnDiscover(v gogl.Vertex, t int) (terminate bool) {
+	s.tick(t)
+	s.discover[v] = t
+	return v == s.stopAt
+}
+
+func (s *stamper) OnFinish(v gogl.Vertex, t int) {
+	s.tick(t)
+	s.finish[v] = t
+}
+
+func (s *stamper) OnArc(a gogl.Arc, class EdgeClass) {
+	s.classes[a] = class
+}
+
+type TimestampSuite struct{}
+
+var _ = Suite(&TimestampSuite{})
+
+func (s *TimestampSuite) TestClassification(c *C) {
+	// a -> b -> c -> a is a cycle; a -> c is forward once c is finished; d -> c is cross.
+	arcs := gogl.ArcList{
+		gogl.NewArc("a", "b"),
+		gogl.NewArc("b", "c"),
+		gogl.NewArc("c", "a"),
+		gogl.NewArc("a", "c"),
+		gogl.NewArc("a", "d"),
+		gogl.NewArc("d", "c"),
+		gogl.NewArc("d", "d"),
+	}
+	g := gogl.Spec().Directed().Using(arcs).Create(al.G)
+
+	st := newStamper()
+	DepthFirstTraversal(g, "a", st)
+	c.Assert(st.monotonic, Equals, true)
+	c.Assert(st.discover, HasLen, 4)
+	c.Assert(st.finish, HasLen, 4)
+	c.Assert(st.classes, HasLen, len(arcs))
+
+	for a, class := range st.classes {
+		u, v := a.Both()
+		du, dv := st.discover[u], st.discover[v]
+		fu, fv := st.finish[u], st.finish[v]
+		switch class {
+		case TreeEdge, ForwardEdge:
+			c.Assert(du < dv && fv < fu, Equals, true, Commentf("%v", a))
+		case BackEdge:
+			c.Assert(dv <= du && fu <= fv, Equals, true, Commentf("%v", a))
+		case CrossEdge:
+			c.Assert(fv < du, Equals, true, Commentf("%v", a))
+		}
+	}
+
+	var counts [4]int
+	for _, class := range st.classes {
+		counts[class]++
+	}
+	// Tree edges span the three non-root vertices; c -> a and the loop are back edges.
+	c.Assert(counts[TreeEdge], Equals, 3)
+	c.Assert(counts[BackEdge], Equals, 2)
+	c.Assert(counts[ForwardEdge]+counts[CrossEdge], Equals, 2)
+}
+
+func (s *TimestampSuite) TestNesting(c *C) {
+	g := gogl.Spec().Using(gogl.EdgeList{
+		gogl.NewEdge(1, 2),
+		gogl.NewEdge(2, 3),
+		gogl.NewEdge(3, 1),
+		gogl.NewEdge(3, 4),
+		gogl.NewEdge(5, 6),
+	}).Create(al.G)
+
+	st := newStamper()
+	DepthFirstTraversal(g, 1, st)
+	c.Assert(st.monotonic, Equals, true)
+	// Only the start's component is reached, and no arcs are classified.
+	c.Assert(st.discover, HasLen, 4)
+	c.Assert(st.classes, HasLen, 0)
+	c.Assert(st.discover[1], Equals, 1)
+	c.Assert(st.finish[1], Equals, 8)
+
+	for u := range st.discover {
+		for v := range st.discover {
+			du, fu, dv, fv := st.discover[u], st.finish[u], st.discover[v], st.finish[v]
+			disjoint := fu < dv || fv < du
+			nested := (du < dv && fv < fu) || (dv < du && fu < fv)
+			c.Assert(u == v || disjoint || nested, Equals, true)
+		}
+	}
+}
+
+func (s *TimestampSuite) TestTerminate(c *C) {
+	g := gogl.Spec().Directed().Using(gogl.ArcList{
+		gogl.NewArc(1, 2),
+		gogl.NewArc(2, 3),
+		gogl.NewArc(3, 4),
+	}).Create(al.G)
+
+	st := newStamper()
+	st.stopAt = 3
+	DepthFirstTraversal(g, 1, st)
+	c.Assert(st.discover, DeepEquals, map[gogl.Vertex]int{1: 1, 2: 2, 3: 3})
+	c.Assert(st.finish, HasLen, 0)
+
+	st = newStamper()
+	DepthFirstTraversal(g, 99, st)
+	c.Assert(st.discover, HasLen, 0)
+}
+
+func (s *TimestampSuite) TestDeep(c *C) {
+	const n = 50000
+	arcs := make(gogl.ArcList, 0, n)
+	for i := 0; i < n; i++ {
+		arcs = append(arcs, gogl.NewArc(i, i+1))
+	}
+	g := gogl.Spec().Directed().Using(arcs).Create(al.G)
+
+	st := newStamper()
+	DepthFirstTraversal(g, 0, st)
+	c.Assert(st.discover, HasLen, n+1)
+	c.Assert(st.finish[0], Equals, 2*(n+1))
+	c.Assert(st.finish[n], Equals, n+2)
+}
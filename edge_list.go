@@ -28,7 +28,8 @@ func elVertices(el interface{}, fn VertexStep) {
 // correctly, as vertices are only described in the context of their edges. One can be a
 // little hacky, though, and represent one with a loop. As gogl expects graph implementations
 // to simply discard loops if they are disallowed by the graph's constraints (i.e., in simple
-// and multigraphs), they *should* be interpreted as vertex isolates.
+// and multigraphs), they *should* be interpreted as vertex isolates. Wrapping the list with
+// WithIsolates is the cleaner option.
 type EdgeList []Edge
 
 func (el EdgeList) Vertices(fn VertexStep) {
@@ -185,3 +186,57 @@ func (el DataArcList) Arcs(fn ArcStep) {
 		}
 	}
 }
+
+// WithIsolates wraps the provided GraphSource such that its vertex enumeration also
+// includes the given vertices. This is the straightforward way to seed vertex isolates
+// into a graph built from one of the edge lists, which cannot otherwise represent them:
+//
+//	g := Spec().Weighted().Using(WithIsolates(WeightedEdgeList{...}, "qux")).Create(al.G)
+//
+// If the source is a DigraphSource, so is the returned value.
+func WithIsolates(src GraphSource, vertices ...Vertex) GraphSource {
+	is := isolateSource{src, vertices}
+	if ds, ok := src.(DigraphSource); ok {
+		return isolateDigraphSource{is, ds}
+	}
+	return is
+}
+
+type isolateSource struct {
+	GraphSource
+	isolates []Vertex
+}
+
+func (s isolateSource) Vertices(fn VertexStep) {
+	seen := set.NewNonTS()
+
+	var terminated bool
+	s.GraphSource.Vertices(func(v Vertex) (terminate bool) {
+		seen.Add(v)
+		terminated = fn(v)
+		return terminated
+	})
+
+	if terminated {
+		return
+	}
+
+	for _, v := range s.isolates {
+		if v == nil || seen.Has(v) {
+			continue
+		}
+		seen.Add(v)
+		if fn(v) {
+			return
+		}
+	}
+}
+
+type isolateDigraphSource struct {
+	isolateSource
+	ds DigraphSource
+}
+
+func (s isolateDigraphSource) Arcs(fn ArcStep) {
+	s.ds.Arcs(fn)
+}
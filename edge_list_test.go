@@ -0,0 +1,55 @@
+package gogl_test
+
+import (
+	. "github.com/sdboyer/gocheck"
+	. "github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+type IsolatesSuite struct{}
+
+var _ = Suite(&IsolatesSuite{})
+
+func (s *IsolatesSuite) TestUndirected(c *C) {
+	src := WithIsolates(WeightedEdgeList{NewWeightedEdge(1, 2, 5.23)}, 3, 1, nil)
+	_, ok := src.(DigraphSource)
+	c.Assert(ok, Equals, false)
+
+	g := Spec().Weighted().Using(src).Create(al.G)
+	c.Assert(Order(g), Equals, 3)
+	c.Assert(Size(g), Equals, 1)
+	c.Assert(g.HasVertex(3), Equals, true)
+
+	var found bool
+	g.Vertices(func(v Vertex) (terminate bool) {
+		found = found || v == 3
+		return
+	})
+	c.Assert(found, Equals, true)
+
+	deg, exists := g.DegreeOf(3)
+	c.Assert(exists, Equals, true)
+	c.Assert(deg, Equals, 0)
+}
+
+func (s *IsolatesSuite) TestDirected(c *C) {
+	src := WithIsolates(WeightedArcList{NewWeightedArc(1, 2, 5.23)}, "foo")
+	_, ok := src.(DigraphSource)
+	c.Assert(ok, Equals, true)
+
+	g := Spec().Directed().Weighted().Using(src).Create(al.G).(WeightedDigraph)
+	c.Assert(Order(g), Equals, 3)
+	c.Assert(g.HasVertex("foo"), Equals, true)
+	c.Assert(g.HasWeightedArc(NewWeightedArc(1, 2, 5.23)), Equals, true)
+}
+
+func (s *IsolatesSuite) TestTermination(c *C) {
+	src := WithIsolates(EdgeList{NewEdge(1, 2)}, 3, 4)
+
+	var n int
+	src.Vertices(func(v Vertex) (terminate bool) {
+		n++
+		return n == 3
+	})
+	c.Assert(n, Equals, 3)
+}
@@ -0,0 +1,316 @@
+package encoding
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"io/ioutil"
+	"math"
+	"sort"
+
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+// The version of the checkpoint format written by WriteCheckpoint.
+const CheckpointVersion = 1
+
+// Identifies a gogl checkpoint; every checkpoint begins with these bytes.
+var checkpointMagic = []byte("GOGLCKPT")
+
+const (
+	ckptDirected = 1 << iota
+	ckptWeighted
+	ckptLabeled
+)
+
+// Tags identifying the type of each encoded vertex.
+const (
+	ckptString = iota
+	ckptInt
+	ckptFloat
+	ckptBool
+)
+
+// Writes the given graph to the writer in gogl's versioned binary checkpoint format.
+//
+// A checkpoint is laid out as follows, with all fixed-width integers big-endian:
+//
+//	magic     8 bytes, "GOGLCKPT"
+//	version   uint16
+//	flags     1 byte: directed (1), weighted (2), labeled (4)
+//	vertices  uvarint count, then each as a type tag byte and value
+//	edges     uvarint count, then each as the uvarint indices of its endpoints, plus
+//	          its float64 weight or length-prefixed label, if any
+//	checksum  uint32, the CRC-32 (IEEE) of everything preceding it
+//
+// Vertices must be strings, ints, float64s, or bools, and are written in their natural
+// order (see gogl.VertexLess), and edges in order of their endpoints' positions, so a
+// given graph always produces the same bytes. Data graphs cannot be checkpointed, as
+// their edge data is opaque; an error is returned, and nothing is written, for them or
+// for any other vertex type.
+func WriteCheckpoint(g gogl.Graph, w io.Writer) error {
+	if _, ok := g.(gogl.DataGraph); ok {
+		return errors.New("Data graphs cannot be checkpointed.")
+	}
+
+	buf := new(bytes.Buffer)
+	buf.Write(checkpointMagic)
+	binary.Write(buf, binary.BigEndian, uint16(CheckpointVersion))
+
+	var flags byte
+	_, weighted := g.(gogl.WeightedGraph)
+	_, labeled := g.(gogl.LabeledGraph)
+	if g.IsDirected() {
+		flags |= ckptDirected
+	}
+	if weighted {
+		flags |= ckptWeighted
+	}
+	if labeled {
+		flags |= ckptLabeled
+	}
+	buf.WriteByte(flags)
+
+	var vertices []gogl.Vertex
+	gogl.VerticesSorted(g, func(v gogl.Vertex) (terminate bool) {
+		vertices = append(vertices, v)
+		return
+	})
+
+	index := make(map[gogl.Vertex]uint64, len(vertices))
+	writeUvarint(buf, uint64(len(vertices)))
+	for k, v := range vertices {
+		if err := writeCheckpointVertex(buf, v); err != nil {
+			return err
+		}
+		index[v] = uint64(k)
+	}
+
+	type ckptEdge struct {
+		u, v uint64
+		e    gogl.Edge
+	}
+
+	var edges []ckptEdge
+	add := func(e gogl.Edge, directed bool) {
+		u, v := e.Both()
+		ce := ckptEdge{index[u], index[v], e}
+		if !directed && ce.v < ce.u {
+			ce.u, ce.v = ce.v, ce.u
+		}
+		edges = append(edges, ce)
+	}
+
+	if dg, ok := g.(gogl.Digraph); ok {
+		dg.Arcs(func(a gogl.Arc) (terminate bool) {
+			add(a, true)
+			return
+		})
+	} else {
+		g.Edges(func(e gogl.Edge) (terminate bool) {
+			add(e, false)
+			return
+		})
+	}
+
+	sort.Slice(edges, func(i, j int) bool {
+		if edges[i].u != edges[j].u {
+			return edges[i].u < edges[j].u
+		}
+		return edges[i].v < edges[j].v
+	})
+
+	writeUvarint(buf, uint64(len(edges)))
+	for _, ce := range edges {
+		writeUvarint(buf, ce.u)
+		writeUvarint(buf, ce.v)
+		if weighted {
+			binary.Write(buf, binary.BigEndian, math.Float64bits(ce.e.(gogl.WeightedEdge).Weight()))
+		} else if labeled {
+			writeString(buf, ce.e.(gogl.LabeledEdge).Label())
+		}
+	}
+
+	binary.Write(buf, binary.BigEndian, crc32.ChecksumIEEE(buf.Bytes()))
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+// Reads a graph from a checkpoint written by WriteCheckpoint.
+//
+// The entire checkpoint is read and verified before any graph is built. An error is
+// returned if the magic header is missing, if the format version is not one this
+// library knows how to read, or if the checksum does not match the contents.
+func ReadCheckpoint(r io.Reader) (MutableGraph, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) < len(checkpointMagic)+2+4 || !bytes.Equal(data[:len(checkpointMagic)], checkpointMagic) {
+		return nil, errors.New("Not a gogl checkpoint: magic header is missing.")
+	}
+
+	if version := binary.BigEndian.Uint16(data[len(checkpointMagic):]); version != CheckpointVersion {
+		return nil, fmt.Errorf("Unsupported checkpoint format version %d; this library reads version %d.", version, CheckpointVersion)
+	}
+
+	body, sum := data[:len(data)-4], binary.BigEndian.Uint32(data[len(data)-4:])
+	if crc32.ChecksumIEEE(body) != sum {
+		return nil, errors.New("Checkpoint is corrupt: checksum does not match contents.")
+	}
+
+	return readCheckpointV1(bytes.NewReader(body[len(checkpointMagic)+2:]))
+}
+
+// Decodes the body of a version 1 checkpoint, following the version number.
+func readCheckpointV1(r *bytes.Reader) (g MutableGraph, err error) {
+	// Every read error past the checksum means a malformed, rather than corrupted, file.
+	malformed := errors.New("Checkpoint is malformed.")
+
+	flags, err := r.ReadByte()
+	if err != nil {
+		return nil, malformed
+	}
+
+	n, err := binary.ReadUvarint(r)
+	if err != nil || n > uint64(r.Len()) {
+		return nil, malformed
+	}
+	vertices := make([]gogl.Vertex, n)
+	for k := range vertices {
+		if vertices[k], err = readCheckpointVertex(r); err != nil {
+			return nil, malformed
+		}
+	}
+
+	m, err := binary.ReadUvarint(r)
+	if err != nil || m > uint64(r.Len()) {
+		return nil, malformed
+	}
+
+	arcs := make(gogl.ArcList, 0, m)
+	for k := uint64(0); k < m; k++ {
+		ui, err1 := binary.ReadUvarint(r)
+		vi, err2 := binary.ReadUvarint(r)
+		if err1 != nil || err2 != nil || ui >= n || vi >= n {
+			return nil, malformed
+		}
+		u, v := vertices[ui], vertices[vi]
+
+		switch {
+		case flags&ckptWeighted != 0:
+			var bits uint64
+			if err := binary.Read(r, binary.BigEndian, &bits); err != nil {
+				return nil, malformed
+			}
+			arcs = append(arcs, gogl.NewWeightedArc(u, v, math.Float64frombits(bits)))
+		case flags&ckptLabeled != 0:
+			label, err := readString(r)
+			if err != nil {
+				return nil, malformed
+			}
+			arcs = append(arcs, gogl.NewLabeledArc(u, v, label))
+		default:
+			arcs = append(arcs, gogl.NewArc(u, v))
+		}
+	}
+
+	if r.Len() != 0 {
+		return nil, malformed
+	}
+
+	spec := gogl.Spec()
+	switch {
+	case flags&ckptWeighted != 0:
+		spec = spec.Weighted()
+	case flags&ckptLabeled != 0:
+		spec = spec.Labeled()
+	}
+
+	if flags&ckptDirected != 0 {
+		return spec.Directed().Using(gogl.WithIsolates(arcs, vertices...)).Create(al.G).(MutableGraph), nil
+	}
+
+	el := make(gogl.EdgeList, len(arcs))
+	for k, a := range arcs {
+		el[k] = a
+	}
+	return spec.Using(gogl.WithIsolates(el, vertices...)).Create(al.G).(MutableGraph), nil
+}
+
+func writeCheckpointVertex(buf *bytes.Buffer, v gogl.Vertex) error {
+	switch x := v.(type) {
+	case string:
+		buf.WriteByte(ckptString)
+		writeString(buf, x)
+	case int:
+		buf.WriteByte(ckptInt)
+		b := make([]byte, binary.MaxVarintLen64)
+		buf.Write(b[:binary.PutVarint(b, int64(x))])
+	case float64:
+		buf.WriteByte(ckptFloat)
+		binary.Write(buf, binary.BigEndian, math.Float64bits(x))
+	case bool:
+		buf.WriteByte(ckptBool)
+		if x {
+			buf.WriteByte(1)
+		} else {
+			buf.WriteByte(0)
+		}
+	default:
+		return fmt.Errorf("Cannot checkpoint vertex %v of type %T; only strings, ints, float64s, and bools are supported.", v, v)
+	}
+	return nil
+}
+
+func readCheckpointVertex(r *bytes.Reader) (gogl.Vertex, error) {
+	tag, err := r.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+
+	switch tag {
+	case ckptString:
+		return readString(r)
+	case ckptInt:
+		i, err := binary.ReadVarint(r)
+		return int(i), err
+	case ckptFloat:
+		var bits uint64
+		err := binary.Read(r, binary.BigEndian, &bits)
+		return math.Float64frombits(bits), err
+	case ckptBool:
+		b, err := r.ReadByte()
+		return b != 0, err
+	}
+	return nil, fmt.Errorf("Unknown vertex type tag %d.", tag)
+}
+
+func writeUvarint(buf *bytes.Buffer, x uint64) {
+	b := make([]byte, binary.MaxVarintLen64)
+	buf.Write(b[:binary.PutUvarint(b, x)])
+}
+
+func writeString(buf *bytes.Buffer, s string) {
+	writeUvarint(buf, uint64(len(s)))
+	buf.WriteString(s)
+}
+
+func readString(r *bytes.Reader) (string, error) {
+	n, err := binary.ReadUvarint(r)
+	if err != nil {
+		return "", err
+	}
+	if n > uint64(r.Len()) {
+		return "", io.ErrUnexpectedEOF
+	}
+
+	b := make([]byte, n)
+	_, err = io.ReadFull(r, b)
+	return string(b), err
+}
@@ -0,0 +1,96 @@
+package encoding
+
+import (
+	"bytes"
+
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+type CheckpointSuite struct{}
+
+var _ = Suite(&CheckpointSuite{})
+
+func roundTrip(c *C, g gogl.Graph) gogl.Graph {
+	var buf bytes.Buffer
+	c.Assert(WriteCheckpoint(g, &buf), IsNil)
+
+	h, err := ReadCheckpoint(&buf)
+	c.Assert(err, IsNil)
+	return h
+}
+
+func (s *CheckpointSuite) TestRoundTrip(c *C) {
+	weighted := gogl.Spec().Directed().Weighted().Using(gogl.WithIsolates(gogl.WeightedArcList{
+		gogl.NewWeightedArc("a", "b", 1.5),
+		gogl.NewWeightedArc("b", "a", -2),
+		gogl.NewWeightedArc("b", "c", 0),
+	}, "iso")).Create(al.G)
+
+	h := roundTrip(c, weighted)
+	c.Assert(gogl.Equal(h, weighted), Equals, true)
+	c.Assert(h.(gogl.WeightedDigraph).HasWeightedArc(gogl.NewWeightedArc("b", "a", -2)), Equals, true)
+
+	labeled := gogl.Spec().Labeled().Using(gogl.LabeledEdgeList{
+		gogl.NewLabeledEdge(1, 2, "x"),
+		gogl.NewLabeledEdge(2, 3.5, ""),
+		gogl.NewLabeledEdge(true, 1, "ünïcode"),
+	}).Create(al.G)
+
+	h = roundTrip(c, labeled)
+	c.Assert(gogl.Equal(h, labeled), Equals, true)
+	c.Assert(h.(gogl.LabeledGraph).HasLabeledEdge(gogl.NewLabeledEdge(true, 1, "ünïcode")), Equals, true)
+
+	plain := gogl.Spec().Using(gogl.EdgeList{gogl.NewEdge("x", "y")}).Create(al.G)
+	c.Assert(gogl.Equal(roundTrip(c, plain), plain), Equals, true)
+}
+
+func (s *CheckpointSuite) TestDeterministic(c *C) {
+	g := gogl.Spec().Using(gogl.EdgeList{
+		gogl.NewEdge(3, 1),
+		gogl.NewEdge(2, 1),
+		gogl.NewEdge(3, 4),
+	}).Create(al.G)
+
+	var a, b bytes.Buffer
+	WriteCheckpoint(g, &a)
+	WriteCheckpoint(gogl.Spec().Using(g).Create(al.G), &b)
+	c.Assert(a.Bytes(), DeepEquals, b.Bytes())
+}
+
+func (s *CheckpointSuite) TestRejected(c *C) {
+	g := gogl.Spec().Using(gogl.EdgeList{gogl.NewEdge("x", "y")}).Create(al.G)
+	var buf bytes.Buffer
+	c.Assert(WriteCheckpoint(g, &buf), IsNil)
+	good := buf.Bytes()
+
+	tamper := func(at int) []byte {
+		b := append([]byte{}, good...)
+		b[at] ^= 0xff
+		return b
+	}
+
+	_, err := ReadCheckpoint(bytes.NewReader(tamper(0)))
+	c.Assert(err, ErrorMatches, ".*magic header.*")
+
+	// version lives just after the magic
+	_, err = ReadCheckpoint(bytes.NewReader(tamper(9)))
+	c.Assert(err, ErrorMatches, "Unsupported checkpoint format version .*")
+
+	_, err = ReadCheckpoint(bytes.NewReader(tamper(len(good) - 6)))
+	c.Assert(err, ErrorMatches, ".*checksum.*")
+
+	_, err = ReadCheckpoint(bytes.NewReader(good[:5]))
+	c.Assert(err, NotNil)
+}
+
+func (s *CheckpointSuite) TestUnsupported(c *C) {
+	var buf bytes.Buffer
+	g := gogl.Spec().Using(gogl.EdgeList{gogl.NewEdge([2]int{1, 2}, "y")}).Create(al.G)
+	c.Assert(WriteCheckpoint(g, &buf), ErrorMatches, "Cannot checkpoint vertex .*")
+
+	d := gogl.Spec().DataEdges().Using(gogl.DataEdgeList{gogl.NewDataEdge(1, 2, nil)}).Create(al.G)
+	c.Assert(WriteCheckpoint(d, &buf), ErrorMatches, "Data graphs .*")
+	c.Assert(buf.Len(), Equals, 0)
+}
@@ -0,0 +1,501 @@
+package encoding
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+// DOT IDs that may be written bare: identifiers and numerals.
+var dotBareID = regexp.MustCompile(`^([a-zA-Z_][a-zA-Z0-9_]*|-?(\.[0-9]+|[0-9]+(\.[0-9]*)?))$`)
+
+// Writes the provided graph to the writer in Graphviz's DOT language, suitable for piping
+// straight into dot or its siblings.
+//
+// The graph is written as a "digraph" if it is directed, and as a "graph" otherwise. Every
+// vertex is declared first, so isolated vertices are included, followed by the edges.
+// Vertices are rendered via %v, and quoted and escaped as needed to form valid DOT IDs.
+// Edges of weighted graphs carry a weight attribute, and those of labeled graphs a label
+// attribute. Vertices and edges are written in sorted order (see gogl.VerticesSorted and
+// gogl.EdgesSorted), with the endpoints of undirected edges lowest first, so the output
+// is reproducible.
+func WriteDOT(g gogl.Graph, w io.Writer) error {
+	bw := bufio.NewWriter(w)
+
+	directed := g.IsDirected()
+	kind, op := "graph", "--"
+	if directed {
+		kind, op = "digraph", "->"
+	}
+	fmt.Fprintf(bw, "%s {\n", kind)
+
+	gogl.VerticesSorted(g, func(v gogl.Vertex) (terminate bool) {
+		fmt.Fprintf(bw, "\t%s;\n", dotID(v))
+		return
+	})
+
+	_, weighted := g.(gogl.WeightedGraph)
+	_, labeled := g.(gogl.LabeledGraph)
+	gogl.EdgesSorted(g, func(e gogl.Edge) (terminate bool) {
+		u, v := e.Both()
+		if !directed && gogl.VertexLess(v, u) {
+			u, v = v, u
+		}
+		fmt.Fprintf(bw, "\t%s %s %s", dotID(u), op, dotID(v))
+		if weighted {
+			fmt.Fprintf(bw, " [weight=%s]", strconv.FormatFloat(e.(gogl.WeightedEdge).Weight(), 'g', -1, 64))
+		} else if labeled {
+			fmt.Fprintf(bw, " [label=%s]", dotQuote(e.(gogl.LabeledEdge).Label()))
+		}
+		bw.WriteString(";\n")
+		return
+	})
+
+	bw.WriteString("}\n")
+	return bw.Flush()
+}
+
+// Renders a vertex as a DOT ID, quoting it unless it can safely be written bare.
+func dotID(v gogl.Vertex) string {
+	s := fmt.Sprintf("%v", v)
+	if dotBareID.MatchString(s) {
+		switch strings.ToLower(s) {
+		case "node", "edge", "graph", "digraph", "subgraph", "strict":
+			// Keywords must be quoted to be used as IDs.
+		default:
+			return s
+		}
+	}
+	return dotQuote(s)
+}
+
+// Renders a string as a quoted DOT string.
+func dotQuote(s string) string {
+	s = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(s)
+	return `"` + s + `"`
+}
+
+// Reads a graph from a subset of Graphviz's DOT language, as written by WriteDOT.
+//
+// The graph is directed if declared as a "digraph", and undirected if a "graph"; edge
+// operators must agree ("->" and "--", respectively). Node statements declare vertices,
+// and edge statements, which may be chained (a -> b -> c), declare edges between them.
+// Vertices are read as strings. If any edge carries a weight attribute, a weighted graph
+// is produced, with absent weights taken as 1, Graphviz's default; failing that, if any
+// carries a label, a labeled graph is produced. All other attributes, along with graph,
+// node and edge attribute statements, are ignored. "strict" is accepted, as gogl's graphs
+// are simple in any case. Comments (//, /* */ and lines starting with #) are skipped.
+//
+// Subgraphs, ports, and HTML strings are not supported. Unsupported or malformed input
+// produces an error identifying the line at which it occurred.
+func ReadDOT(r io.Reader) (MutableGraph, error) {
+	src, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &dotParser{lex: &dotLexer{src: string(src), line: 1}}
+	if err := p.parse(); err != nil {
+		return nil, err
+	}
+
+	var weighted, labeled bool
+	for _, e := range p.edges {
+		weighted = weighted || e.weight != nil
+		labeled = labeled || e.label != nil
+	}
+
+	spec := gogl.Spec()
+	if p.directed {
+		spec = spec.Directed()
+	}
+	switch {
+	case weighted:
+		spec = spec.Weighted()
+	case labeled:
+		spec = spec.Labeled()
+	}
+
+	var el gogl.EdgeList
+	var arcs gogl.ArcList
+	for _, de := range p.edges {
+		var e gogl.Arc
+		switch {
+		case weighted:
+			w := 1.0
+			if de.weight != nil {
+				w = *de.weight
+			}
+			e = gogl.NewWeightedArc(de.u, de.v, w)
+		case labeled:
+			var label string
+			if de.label != nil {
+				label = *de.label
+			}
+			e = gogl.NewLabeledArc(de.u, de.v, label)
+		default:
+			e = gogl.NewArc(de.u, de.v)
+		}
+
+		if p.directed {
+			arcs = append(arcs, e)
+		} else {
+			el = append(el, e)
+		}
+	}
+
+	var gs gogl.GraphSource = el
+	if p.directed {
+		gs = arcs
+	}
+	return spec.Using(gogl.WithIsolates(gs, p.vertices...)).Create(al.G).(MutableGraph), nil
+}
+
+const (
+	dotEOF = iota
+	dotIdent
+	dotPunct
+)
+
+type dotToken struct {
+	kind   int
+	text   string
+	quoted bool
+	line   int
+}
+
+// Indicates whether the token is the given (case-insensitive) keyword. Quoted strings are
+// never keywords.
+func (t dotToken) is(keyword string) bool {
+	return t.kind == dotIdent && !t.quoted && strings.EqualFold(t.text, keyword)
+}
+
+// Splits DOT source into tokens, skipping whitespace and comments.
+type dotLexer struct {
+	src  string
+	pos  int
+	line int
+}
+
+func (l *dotLexer) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("Malformed DOT on line %d: %s.", l.line, fmt.Sprintf(format, args...))
+}
+
+func (l *dotLexer) next() (dotToken, error) {
+	if err := l.skip(); err != nil {
+		return dotToken{}, err
+	}
+	if l.pos >= len(l.src) {
+		return dotToken{kind: dotEOF, line: l.line}, nil
+	}
+
+	tok := dotToken{line: l.line}
+	rest := l.src[l.pos:]
+	switch {
+	case strings.HasPrefix(rest, "->"), strings.HasPrefix(rest, "--"):
+		tok.kind, tok.text = dotPunct, rest[:2]
+		l.pos += 2
+	case strings.ContainsRune("{}[];,=", rune(rest[0])):
+		tok.kind, tok.text = dotPunct, rest[:1]
+		l.pos++
+	case rest[0] == '"':
+		var b strings.Builder
+		l.pos++
+		for {
+			if l.pos >= len(l.src) {
+				return tok, l.errorf("unterminated string")
+			}
+			c := l.src[l.pos]
+			l.pos++
+			if c == '"' {
+				break
+			}
+			if c == '\n' {
+				l.line++
+			}
+			if c == '\\' && l.pos < len(l.src) {
+				switch esc := l.src[l.pos]; esc {
+				case '"', '\\':
+					c = esc
+					l.pos++
+				case 'n':
+					c = '\n'
+					l.pos++
+				}
+			}
+			b.WriteByte(c)
+		}
+		tok.kind, tok.text, tok.quoted = dotIdent, b.String(), true
+	case rest[0] == '<':
+		return tok, l.errorf("HTML strings are not supported")
+	default:
+		end := strings.IndexFunc(rest, func(r rune) bool {
+			return !(r == '_' || r == '.' || r == '-' || r >= 0x80 ||
+				(r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9'))
+		})
+		if end == -1 {
+			end = len(rest)
+		}
+		// A "-" may only lead a numeral, and never begins an edge operator here.
+		if end == 0 || (rest[0] == '-' && (end == 1 || rest[1] == '-' || rest[1] == '>')) {
+			return tok, l.errorf("unexpected character %q", rest[0])
+		}
+		if i := strings.Index(rest[:end], "--"); i > 0 {
+			end = i
+		}
+		if i := strings.Index(rest[:end], "->"); i > 0 {
+			end = i
+		}
+		tok.kind, tok.text = dotIdent, rest[:end]
+		l.pos += end
+	}
+
+	return tok, nil
+}
+
+// Advances past whitespace and comments.
+func (l *dotLexer) skip() error {
+	atLineStart := l.pos == 0 || l.src[l.pos-1] == '\n'
+	for l.pos < len(l.src) {
+		rest := l.src[l.pos:]
+		switch {
+		case rest[0] == '\n':
+			l.line++
+			l.pos++
+			atLineStart = true
+			continue
+		case rest[0] == ' ' || rest[0] == '\t' || rest[0] == '\r':
+			l.pos++
+			continue
+		case strings.HasPrefix(rest, "//"), atLineStart && rest[0] == '#':
+			if i := strings.IndexByte(rest, '\n'); i >= 0 {
+				l.pos += i
+			} else {
+				l.pos = len(l.src)
+			}
+		case strings.HasPrefix(rest, "/*"):
+			i := strings.Index(rest[2:], "*/")
+			if i < 0 {
+				return l.errorf("unterminated comment")
+			}
+			l.line += strings.Count(rest[:i+4], "\n")
+			l.pos += i + 4
+		default:
+			return nil
+		}
+		atLineStart = false
+	}
+	return nil
+}
+
+// An edge as read, before the kind of graph is known.
+type dotEdge struct {
+	u, v   gogl.Vertex
+	weight *float64
+	label  *string
+}
+
+// A recursive-descent parser over the supported subset of DOT.
+type dotParser struct {
+	lex      *dotLexer
+	tok      dotToken
+	directed bool
+	vertices []gogl.Vertex
+	edges    []dotEdge
+}
+
+func (p *dotParser) advance() (err error) {
+	p.tok, err = p.lex.next()
+	return
+}
+
+func (p *dotParser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("Malformed DOT on line %d: %s.", p.tok.line, fmt.Sprintf(format, args...))
+}
+
+// Consumes the given punctuation, or fails.
+func (p *dotParser) expect(punct string) error {
+	if p.tok.kind != dotPunct || p.tok.text != punct {
+		return p.errorf("expected %q, found %q", punct, p.tok.text)
+	}
+	return p.advance()
+}
+
+func (p *dotParser) parse() error {
+	if err := p.advance(); err != nil {
+		return err
+	}
+	if p.tok.is("strict") {
+		if err := p.advance(); err != nil {
+			return err
+		}
+	}
+
+	switch {
+	case p.tok.is("digraph"):
+		p.directed = true
+	case p.tok.is("graph"):
+	default:
+		return p.errorf("expected \"graph\" or \"digraph\", found %q", p.tok.text)
+	}
+	if err := p.advance(); err != nil {
+		return err
+	}
+
+	// An optional graph name.
+	if p.tok.kind == dotIdent {
+		if err := p.advance(); err != nil {
+			return err
+		}
+	}
+	if err := p.expect("{"); err != nil {
+		return err
+	}
+
+	for !(p.tok.kind == dotPunct && p.tok.text == "}") {
+		if p.tok.kind == dotEOF {
+			return p.errorf("unexpected end of input; expected \"}\"")
+		}
+		if err := p.statement(); err != nil {
+			return err
+		}
+	}
+	if err := p.advance(); err != nil {
+		return err
+	}
+
+	if p.tok.kind != dotEOF {
+		return p.errorf("unexpected %q after closing \"}\"", p.tok.text)
+	}
+	return nil
+}
+
+func (p *dotParser) statement() error {
+	if p.tok.kind == dotPunct && p.tok.text == ";" {
+		return p.advance()
+	}
+	if p.tok.kind != dotIdent {
+		return p.errorf("unexpected %q", p.tok.text)
+	}
+	if p.tok.is("subgraph") {
+		return p.errorf("subgraphs are not supported")
+	}
+
+	// Attribute statements are ignored.
+	if p.tok.is("graph") || p.tok.is("node") || p.tok.is("edge") {
+		if err := p.advance(); err != nil {
+			return err
+		}
+		_, err := p.attributes()
+		return err
+	}
+
+	first := p.tok
+	if err := p.advance(); err != nil {
+		return err
+	}
+
+	// A graph attribute assignment, also ignored.
+	if p.tok.kind == dotPunct && p.tok.text == "=" {
+		if err := p.advance(); err != nil {
+			return err
+		}
+		if p.tok.kind != dotIdent {
+			return p.errorf("expected a value for %q, found %q", first.text, p.tok.text)
+		}
+		return p.advance()
+	}
+
+	chain := []gogl.Vertex{first.text}
+	for p.tok.kind == dotPunct && (p.tok.text == "->" || p.tok.text == "--") {
+		if want := map[bool]string{true: "->", false: "--"}[p.directed]; p.tok.text != want {
+			return p.errorf("edge operator %q used in a %s; expected %q", p.tok.text,
+				map[bool]string{true: "digraph", false: "graph"}[p.directed], want)
+		}
+		if err := p.advance(); err != nil {
+			return err
+		}
+		if p.tok.kind != dotIdent {
+			if p.tok.kind == dotPunct && p.tok.text == "{" {
+				return p.errorf("subgraphs are not supported")
+			}
+			return p.errorf("expected a vertex, found %q", p.tok.text)
+		}
+		chain = append(chain, p.tok.text)
+		if err := p.advance(); err != nil {
+			return err
+		}
+	}
+
+	attrs, err := p.attributes()
+	if err != nil {
+		return err
+	}
+
+	p.vertices = append(p.vertices, chain...)
+	if len(chain) == 1 {
+		return nil
+	}
+
+	var weight *float64
+	if s, exists := attrs["weight"]; exists {
+		w, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return fmt.Errorf("Malformed DOT on line %d: invalid weight %q.", first.line, s)
+		}
+		weight = &w
+	}
+	var label *string
+	if s, exists := attrs["label"]; exists {
+		label = &s
+	}
+
+	for i := 1; i < len(chain); i++ {
+		p.edges = append(p.edges, dotEdge{chain[i-1], chain[i], weight, label})
+	}
+	return nil
+}
+
+// Parses zero or more bracketed attribute lists, returning the attributes they set.
+func (p *dotParser) attributes() (map[string]string, error) {
+	attrs := make(map[string]string)
+	for p.tok.kind == dotPunct && p.tok.text == "[" {
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		for !(p.tok.kind == dotPunct && p.tok.text == "]") {
+			if p.tok.kind != dotIdent {
+				return nil, p.errorf("expected an attribute name, found %q", p.tok.text)
+			}
+			name := p.tok.text
+			if err := p.advance(); err != nil {
+				return nil, err
+			}
+			if err := p.expect("="); err != nil {
+				return nil, err
+			}
+			if p.tok.kind != dotIdent {
+				return nil, p.errorf("expected a value for %q, found %q", name, p.tok.text)
+			}
+			attrs[name] = p.tok.text
+			if err := p.advance(); err != nil {
+				return nil, err
+			}
+			if p.tok.kind == dotPunct && (p.tok.text == "," || p.tok.text == ";") {
+				if err := p.advance(); err != nil {
+					return nil, err
+				}
+			}
+		}
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+	}
+	return attrs, nil
+}
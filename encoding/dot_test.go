@@ -0,0 +1,141 @@
+package encoding
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+	"github.com/sdboyer/gogl/spec"
+)
+
+type DOTSuite struct{}
+
+var _ = Suite(&DOTSuite{})
+
+func (s *DOTSuite) TestFixture(c *C) {
+	var buf bytes.Buffer
+	g := gogl.Spec().Directed().Using(spec.GraphFixtures["2e3v"]).Create(al.G)
+	c.Assert(WriteDOT(g, &buf), IsNil)
+	c.Assert(buf.String(), Equals, `digraph {
+	bar;
+	baz;
+	foo;
+	bar -> baz;
+	foo -> bar;
+}
+`)
+
+	buf.Reset()
+	g = gogl.Spec().Using(spec.GraphFixtures["2e3v"]).Create(al.G)
+	c.Assert(WriteDOT(g, &buf), IsNil)
+	c.Assert(buf.String(), Equals, `graph {
+	bar;
+	baz;
+	foo;
+	bar -- baz;
+	bar -- foo;
+}
+`)
+}
+
+func (s *DOTSuite) TestWeightsAndQuoting(c *C) {
+	var buf bytes.Buffer
+	g := gogl.Spec().Weighted().Using(gogl.WithIsolates(gogl.WeightedEdgeList{
+		gogl.NewWeightedEdge("new york", `say "hi"`, 1.5),
+		gogl.NewWeightedEdge(1, 2, 3),
+	}, "node", `back\slash`)).Create(al.G)
+	c.Assert(WriteDOT(g, &buf), IsNil)
+	c.Assert(buf.String(), Equals, `graph {
+	1;
+	2;
+	"back\\slash";
+	"new york";
+	"node";
+	"say \"hi\"";
+	1 -- 2 [weight=3];
+	"new york" -- "say \"hi\"" [weight=1.5];
+}
+`)
+
+	buf.Reset()
+	lg := gogl.Spec().Directed().Labeled().Using(gogl.LabeledArcList{
+		gogl.NewLabeledArc("a", "b", "a\nb"),
+	}).Create(al.G)
+	c.Assert(WriteDOT(lg, &buf), IsNil)
+	c.Assert(buf.String(), Equals, "digraph {\n\ta;\n\tb;\n\ta -> b [label=\"a\\nb\"];\n}\n")
+}
+
+// Reads DOT source, then writes it back out.
+func roundTripDOT(c *C, src string) string {
+	g, err := ReadDOT(strings.NewReader(src))
+	c.Assert(err, IsNil)
+
+	var buf bytes.Buffer
+	c.Assert(WriteDOT(g, &buf), IsNil)
+	return buf.String()
+}
+
+func (s *DOTSuite) TestReadRoundTrip(c *C) {
+	for _, g := range []gogl.Graph{
+		gogl.Spec().Directed().Using(spec.GraphFixtures["2e3v"]).Create(al.G),
+		gogl.Spec().Using(spec.GraphFixtures["2e3v"]).Create(al.G),
+		gogl.Spec().Weighted().Using(gogl.WithIsolates(gogl.WeightedEdgeList{
+			gogl.NewWeightedEdge("new york", `say "hi"`, 1.5),
+			gogl.NewWeightedEdge("1", "2", -3),
+		}, "node", `back\slash`)).Create(al.G),
+		gogl.Spec().Directed().Labeled().Using(gogl.LabeledArcList{
+			gogl.NewLabeledArc("a", "b", "a\nb"),
+		}).Create(al.G),
+	} {
+		var buf bytes.Buffer
+		c.Assert(WriteDOT(g, &buf), IsNil)
+		c.Assert(roundTripDOT(c, buf.String()), Equals, buf.String())
+	}
+}
+
+func (s *DOTSuite) TestReadSyntax(c *C) {
+	g, err := ReadDOT(strings.NewReader(`# preprocessor-style line
+strict DiGraph deps {
+	// graph, node and edge attributes are skipped
+	rankdir = LR; node [shape=box]
+	edge [color=red];
+	a -> b -> c [weight=2, color="blue"]; /* a chain,
+	spanning lines */ d
+	c -> a
+}`))
+	c.Assert(err, IsNil)
+	c.Assert(g.IsDirected(), Equals, true)
+
+	wg, ok := g.(gogl.WeightedGraph)
+	c.Assert(ok, Equals, true)
+	c.Assert(gogl.Order(g), Equals, 4)
+	c.Assert(wg.HasWeightedEdge(gogl.NewWeightedArc("a", "b", 2)), Equals, true)
+	c.Assert(wg.HasWeightedEdge(gogl.NewWeightedArc("b", "c", 2)), Equals, true)
+	// Absent weights take Graphviz's default of 1.
+	c.Assert(wg.HasWeightedEdge(gogl.NewWeightedArc("c", "a", 1)), Equals, true)
+	c.Assert(g.HasVertex("d"), Equals, true)
+
+	g.EnsureVertex("e")
+	_, ok = g.(gogl.MutableWeightedDigraph)
+	c.Assert(ok, Equals, true)
+}
+
+func (s *DOTSuite) TestReadErrors(c *C) {
+	for src, msg := range map[string]string{
+		"graph {\n\ta -> b\n}":               "Malformed DOT on line 2: edge operator \"->\" used in a graph; expected \"--\".",
+		"digraph {\n\ta -> b [weight=x]\n}":  "Malformed DOT on line 2: invalid weight \"x\".",
+		"digraph {\n\n\tsubgraph s { a }\n}": "Malformed DOT on line 3: subgraphs are not supported.",
+		"digraph {\n\ta -> b\n":              "Malformed DOT on line 3: unexpected end of input; expected \"}\".",
+		"digraph {\n\t\"a -> b\n}":           "Malformed DOT on line 3: unterminated string.",
+		"/*\n*/ tree {}":                     "Malformed DOT on line 2: expected \"graph\" or \"digraph\", found \"tree\".",
+		"graph {\n\ta -- b [weight]\n}":      "Malformed DOT on line 2: expected \"=\", found \"]\".",
+		"graph {\n\ta -- b\n}\ngraph {\n}":   "Malformed DOT on line 4: unexpected \"graph\" after closing \"}\".",
+		"graph {\n\ta -- <b>\n}":             "Malformed DOT on line 2: HTML strings are not supported.",
+	} {
+		_, err := ReadDOT(strings.NewReader(src))
+		c.Assert(err, ErrorMatches, regexp.QuoteMeta(msg), Commentf("%q", src))
+	}
+}
@@ -0,0 +1,166 @@
+package encoding
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+// The document structure written and read by GraphJSON.
+type graphJSONDoc struct {
+	Directed bool            `json:"directed"`
+	Weighted bool            `json:"weighted"`
+	Vertices []interface{}   `json:"vertices"`
+	Edges    []graphJSONEdge `json:"edges"`
+}
+
+type graphJSONEdge struct {
+	U      interface{} `json:"u"`
+	V      interface{} `json:"v"`
+	Weight *float64    `json:"weight,omitempty"`
+}
+
+// Wraps a graph so that it can be passed to, or embedded in values passed to, the
+// standard encoding/json package. The graph is encoded as
+//
+//	{"directed":bool,"weighted":bool,"vertices":[...],"edges":[{"u":...,"v":...,"weight":...}]}
+//
+// with vertices and edges in their natural order (see gogl.VerticesSorted), so the same
+// graph always produces the same document. "weight" is present only on the edges of
+// weighted graphs, and is required on them when decoding.
+//
+// Vertices are written directly, so they must be JSON-encodable, and only strings,
+// numbers and bools survive a round trip: integral numbers decode as int, others as
+// float64. Structs and other vertex types encode, but cannot be decoded back into
+// vertices. Labeled and data graphs are rejected, rather than silently losing their
+// labels or data.
+type GraphJSON struct {
+	gogl.Graph
+}
+
+// Implements json.Marshaler.
+func (gj GraphJSON) MarshalJSON() ([]byte, error) {
+	g := gj.Graph
+	switch g.(type) {
+	case gogl.LabeledGraph, gogl.DataGraph:
+		return nil, errors.New("Labeled and data graphs cannot be encoded as graph JSON.")
+	}
+
+	_, weighted := g.(gogl.WeightedGraph)
+	doc := graphJSONDoc{
+		Directed: g.IsDirected(),
+		Weighted: weighted,
+		Vertices: make([]interface{}, 0),
+		Edges:    make([]graphJSONEdge, 0),
+	}
+
+	gogl.VerticesSorted(g, func(v gogl.Vertex) (terminate bool) {
+		doc.Vertices = append(doc.Vertices, v)
+		return
+	})
+
+	gogl.EdgesSorted(g, func(e gogl.Edge) (terminate bool) {
+		u, v := e.Both()
+		edge := graphJSONEdge{U: u, V: v}
+		if weighted {
+			w := e.(gogl.WeightedEdge).Weight()
+			edge.Weight = &w
+		}
+		doc.Edges = append(doc.Edges, edge)
+		return
+	})
+
+	return json.Marshal(doc)
+}
+
+// Implements json.Unmarshaler, replacing the wrapped graph with a new, mutable one built
+// from the document.
+func (gj *GraphJSON) UnmarshalJSON(data []byte) error {
+	var doc graphJSONDoc
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&doc); err != nil {
+		return err
+	}
+
+	vertices := make([]gogl.Vertex, 0, len(doc.Vertices))
+	for _, id := range doc.Vertices {
+		v, err := nodeLinkVertex(id)
+		if err != nil {
+			return err
+		}
+		vertices = append(vertices, v)
+	}
+
+	spec := gogl.Spec()
+	if doc.Directed {
+		spec = spec.Directed()
+	}
+	if doc.Weighted {
+		spec = spec.Weighted()
+	}
+
+	var el gogl.EdgeList
+	var arcs gogl.ArcList
+	for _, de := range doc.Edges {
+		u, err := nodeLinkVertex(de.U)
+		if err != nil {
+			return err
+		}
+		v, err := nodeLinkVertex(de.V)
+		if err != nil {
+			return err
+		}
+
+		var e gogl.Arc
+		if doc.Weighted {
+			if de.Weight == nil {
+				return errors.New("Edge in weighted graph JSON has no weight.")
+			}
+			e = gogl.NewWeightedArc(u, v, *de.Weight)
+		} else {
+			e = gogl.NewArc(u, v)
+		}
+
+		if doc.Directed {
+			arcs = append(arcs, e)
+		} else {
+			el = append(el, e)
+		}
+	}
+
+	var src gogl.GraphSource = el
+	if doc.Directed {
+		src = arcs
+	}
+
+	gj.Graph = spec.Using(gogl.WithIsolates(src, vertices...)).Create(al.G)
+	return nil
+}
+
+// The graph returned by this package's readers. It is mutable whatever its kind;
+// gogl.MutableGraph itself covers only undirected, unweighted graphs, so type assert to
+// the mutator interface appropriate to the graph's kind (e.g. gogl.MutableWeightedDigraph)
+// to add or remove edges.
+type MutableGraph interface {
+	gogl.Graph
+	gogl.VertexSetMutator
+}
+
+// Encodes the provided graph as graph JSON. See GraphJSON for the format and its limits.
+func MarshalGraph(g gogl.Graph) ([]byte, error) {
+	return json.Marshal(GraphJSON{g})
+}
+
+// Decodes a graph from graph JSON, as produced by MarshalGraph. See GraphJSON for the
+// format and its limits.
+func UnmarshalGraph(data []byte) (MutableGraph, error) {
+	var gj GraphJSON
+	if err := json.Unmarshal(data, &gj); err != nil {
+		return nil, err
+	}
+	return gj.Graph.(MutableGraph), nil
+}
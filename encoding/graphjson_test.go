@@ -0,0 +1,73 @@
+package encoding
+
+import (
+	"encoding/json"
+
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+type GraphJSONSuite struct{}
+
+var _ = Suite(&GraphJSONSuite{})
+
+func (s *GraphJSONSuite) TestRoundTrip(c *C) {
+	src := gogl.Spec().Directed().Weighted().
+		Using(gogl.WithIsolates(gogl.WeightedArcList{
+			gogl.NewWeightedArc("foo", "bar", 1.5),
+			gogl.NewWeightedArc("bar", "foo", 0),
+			gogl.NewWeightedArc(1, 2, -3),
+		}, "qux")).
+		Create(al.G)
+
+	data, err := MarshalGraph(src)
+	c.Assert(err, IsNil)
+	c.Assert(string(data), Equals, `{"directed":true,"weighted":true,"vertices":[1,2,"bar","foo","qux"],`+
+		`"edges":[{"u":1,"v":2,"weight":-3},{"u":"bar","v":"foo","weight":0},{"u":"foo","v":"bar","weight":1.5}]}`)
+
+	g, err := UnmarshalGraph(data)
+	c.Assert(err, IsNil)
+	c.Assert(gogl.Equal(src, g), Equals, true)
+	_, ok := g.(gogl.WeightedArcSetMutator)
+	c.Assert(ok, Equals, true)
+
+	ug := gogl.Spec().Using(gogl.EdgeList{gogl.NewEdge("a", "b")}).Create(al.G)
+	data, err = MarshalGraph(ug)
+	c.Assert(err, IsNil)
+	g, err = UnmarshalGraph(data)
+	c.Assert(err, IsNil)
+	c.Assert(gogl.Equal(ug, g), Equals, true)
+	_, ok = g.(gogl.MutableGraph)
+	c.Assert(ok, Equals, true)
+}
+
+func (s *GraphJSONSuite) TestEmbedded(c *C) {
+	type payload struct {
+		Name  string    `json:"name"`
+		Graph GraphJSON `json:"graph"`
+	}
+
+	in := payload{"deps", GraphJSON{gogl.Spec().Directed().Using(gogl.ArcList{gogl.NewArc("a", "b")}).Create(al.G)}}
+	data, err := json.Marshal(in)
+	c.Assert(err, IsNil)
+
+	var out payload
+	c.Assert(json.Unmarshal(data, &out), IsNil)
+	c.Assert(out.Name, Equals, "deps")
+	c.Assert(gogl.Equal(in.Graph.Graph, out.Graph.Graph), Equals, true)
+}
+
+func (s *GraphJSONSuite) TestErrors(c *C) {
+	_, err := MarshalGraph(gogl.Spec().Labeled().Create(al.G))
+	c.Assert(err, NotNil)
+
+	_, err = UnmarshalGraph([]byte(`{"directed":false,"vertices":[[1,2]],"edges":[]}`))
+	c.Assert(err, NotNil)
+
+	_, err = UnmarshalGraph([]byte(`{"directed":`))
+	c.Assert(err, NotNil)
+
+	_, err = UnmarshalGraph([]byte(`{"directed":true,"weighted":true,"vertices":[],"edges":[{"u":"a","v":"b"}]}`))
+	c.Assert(err, ErrorMatches, ".*has no weight.*")
+}
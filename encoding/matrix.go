@@ -0,0 +1,170 @@
+package encoding
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+// Builds a weighted graph from a square weight matrix. The vertices slice labels the
+// matrix's rows and columns, in order; cell (i, j) holds the weight of the edge from
+// vertices[i] to vertices[j], or the absent value if there is no such edge. If absent is
+// NaN, NaN cells are treated as absent.
+//
+// If directed is false, the matrix must be symmetric, and a WeightedGraph is returned;
+// otherwise, the result is a WeightedDigraph. Either way the result is mutable, and may
+// be type asserted to gogl.MutableWeightedGraph or gogl.MutableWeightedDigraph,
+// respectively. All labeled vertices are present in the result, even those with no
+// edges.
+//
+// An error is returned if the matrix is not square, if its dimension does not match the
+// number of vertices, or if an undirected matrix is not symmetric.
+func NewWeightedFromMatrix(matrix [][]float64, vertices []gogl.Vertex, directed bool, absent float64) (gogl.WeightedGraph, error) {
+	n := len(matrix)
+	if n != len(vertices) {
+		return nil, fmt.Errorf("Matrix has %d rows, but %d vertices were provided.", n, len(vertices))
+	}
+	for i, row := range matrix {
+		if len(row) != n {
+			return nil, fmt.Errorf("Matrix is not square; row %d has %d columns, expected %d.", i, len(row), n)
+		}
+	}
+
+	isAbsent := func(w float64) bool {
+		return w == absent || (math.IsNaN(absent) && math.IsNaN(w))
+	}
+
+	spec := gogl.Spec().Weighted()
+	var src gogl.GraphSource
+	if directed {
+		arcs := gogl.WeightedArcList{}
+		for i, row := range matrix {
+			for j, w := range row {
+				if !isAbsent(w) {
+					arcs = append(arcs, gogl.NewWeightedArc(vertices[i], vertices[j], w))
+				}
+			}
+		}
+		spec, src = spec.Directed(), arcs
+	} else {
+		edges := gogl.WeightedEdgeList{}
+		for i, row := range matrix {
+			for j := i; j < n; j++ {
+				w := row[j]
+				if w != matrix[j][i] && !(isAbsent(w) && isAbsent(matrix[j][i])) {
+					return nil, errors.New("Matrix for an undirected graph must be symmetric.")
+				}
+				if !isAbsent(w) {
+					edges = append(edges, gogl.NewWeightedEdge(vertices[i], vertices[j], w))
+				}
+			}
+		}
+		src = edges
+	}
+
+	return spec.Using(gogl.WithIsolates(src, vertices...)).Create(al.G).(gogl.WeightedGraph), nil
+}
+
+// Produces the adjacency matrix of the provided graph, with rows and columns in the order
+// of the provided vertices. Cell (i, j) holds the weight of the edge from vertices[i] to
+// vertices[j], or the absent value if there is none. Edges in unweighted graphs are given
+// a weight of 1. Undirected graphs produce symmetric matrices.
+//
+// An error is returned if the vertices provided are not exactly those of the graph.
+func ToAdjacencyMatrix(g gogl.Graph, vertices []gogl.Vertex, absent float64) ([][]float64, error) {
+	if len(vertices) != gogl.Order(g) {
+		return nil, fmt.Errorf("Graph has %d vertices, but %d were provided.", gogl.Order(g), len(vertices))
+	}
+
+	index := make(map[gogl.Vertex]int, len(vertices))
+	for i, v := range vertices {
+		if !g.HasVertex(v) {
+			return nil, fmt.Errorf("Vertex %v is not present in the graph.", v)
+		}
+		index[v] = i
+	}
+	if len(index) != len(vertices) {
+		return nil, errors.New("Vertices provided for matrix rows must be unique.")
+	}
+
+	matrix := make([][]float64, len(vertices))
+	for i := range matrix {
+		matrix[i] = make([]float64, len(vertices))
+		for j := range matrix[i] {
+			matrix[i][j] = absent
+		}
+	}
+
+	directed := g.IsDirected()
+	g.Edges(func(e gogl.Edge) (terminate bool) {
+		u, v := e.Both()
+		w := 1.0
+		if we, ok := e.(gogl.WeightedEdge); ok {
+			w = we.Weight()
+		}
+
+		matrix[index[u]][index[v]] = w
+		if !directed {
+			matrix[index[v]][index[u]] = w
+		}
+		return
+	})
+
+	return matrix, nil
+}
+
+// Produces the vertex-by-edge incidence matrix of the provided graph, along with the
+// orderings of its rows and columns. Vertices are in their natural order, and edges are
+// sorted by their endpoints (see gogl.EdgesSorted); both orderings are returned so that
+// matrix entries can be mapped back to the graph.
+//
+// For digraphs, the matrix is oriented: the column for each arc holds -1 in its source's
+// row and +1 in its target's, and the returned edges are the graph's own arcs. For
+// undirected graphs, each edge's column holds 1 in the row of each endpoint. A loop's
+// column holds 2 in its vertex's row if undirected, and is all zeroes if directed.
+func IncidenceMatrix(g gogl.Graph) ([][]int, []gogl.Vertex, gogl.EdgeList) {
+	var vertices []gogl.Vertex
+	index := make(map[gogl.Vertex]int)
+	gogl.VerticesSorted(g, func(v gogl.Vertex) (terminate bool) {
+		index[v] = len(vertices)
+		vertices = append(vertices, v)
+		return
+	})
+
+	var src gogl.EdgeEnumerator = g
+	dg, directed := g.(gogl.Digraph)
+	if directed {
+		arcs := gogl.ArcList{}
+		dg.Arcs(func(a gogl.Arc) (terminate bool) {
+			arcs = append(arcs, a)
+			return
+		})
+		src = arcs
+	}
+
+	edges := gogl.EdgeList{}
+	gogl.EdgesSorted(src, func(e gogl.Edge) (terminate bool) {
+		edges = append(edges, e)
+		return
+	})
+
+	matrix := make([][]int, len(vertices))
+	for i := range matrix {
+		matrix[i] = make([]int, len(edges))
+	}
+	for j, e := range edges {
+		u, v := e.Both()
+		if directed {
+			matrix[index[u]][j]--
+			matrix[index[v]][j]++
+		} else {
+			matrix[index[u]][j]++
+			matrix[index[v]][j]++
+		}
+	}
+
+	return matrix, vertices, edges
+}
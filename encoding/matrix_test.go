@@ -0,0 +1,150 @@
+package encoding
+
+import (
+	"math"
+
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+type MatrixSuite struct{}
+
+var _ = Suite(&MatrixSuite{})
+
+var matrixVertices = []gogl.Vertex{"a", "b", "c", "d"}
+
+func (s *MatrixSuite) TestDirectedRoundTrip(c *C) {
+	m := [][]float64{
+		{0, 2, 0, 0},
+		{0, 0, -1.5, 0},
+		{4, 0, 0, 0},
+		{0, 0, 0, 0},
+	}
+
+	g, err := NewWeightedFromMatrix(m, matrixVertices, true, 0)
+	c.Assert(err, IsNil)
+
+	dg, ok := g.(gogl.WeightedDigraph)
+	c.Assert(ok, Equals, true)
+	c.Assert(gogl.Order(g), Equals, 4)
+	c.Assert(gogl.Size(g), Equals, 3)
+	c.Assert(dg.HasWeightedArc(gogl.NewWeightedArc("b", "c", -1.5)), Equals, true)
+	c.Assert(dg.HasArc(gogl.NewArc("c", "b")), Equals, false)
+	_, ok = g.(gogl.MutableWeightedDigraph)
+	c.Assert(ok, Equals, true)
+
+	back, err := ToAdjacencyMatrix(g, matrixVertices, 0)
+	c.Assert(err, IsNil)
+	c.Assert(back, DeepEquals, m)
+}
+
+func (s *MatrixSuite) TestUndirectedRoundTrip(c *C) {
+	inf := math.Inf(1)
+	m := [][]float64{
+		{inf, 0, inf, inf},
+		{0, inf, 3, inf},
+		{inf, 3, 7, inf},
+		{inf, inf, inf, inf},
+	}
+
+	// with a non-zero sentinel, zero weights are real edges
+	g, err := NewWeightedFromMatrix(m, matrixVertices, false, inf)
+	c.Assert(err, IsNil)
+	c.Assert(g.IsDirected(), Equals, false)
+	c.Assert(gogl.Size(g), Equals, 3)
+	c.Assert(g.HasWeightedEdge(gogl.NewWeightedEdge("b", "a", 0)), Equals, true)
+	c.Assert(g.HasWeightedEdge(gogl.NewWeightedEdge("c", "c", 7)), Equals, true)
+	c.Assert(g.HasVertex("d"), Equals, true)
+	_, ok := g.(gogl.MutableWeightedGraph)
+	c.Assert(ok, Equals, true)
+
+	back, err := ToAdjacencyMatrix(g, matrixVertices, inf)
+	c.Assert(err, IsNil)
+	c.Assert(back, DeepEquals, m)
+}
+
+func (s *MatrixSuite) TestNaNSentinel(c *C) {
+	nan := math.NaN()
+	g, err := NewWeightedFromMatrix([][]float64{{nan, 1}, {1, nan}}, []gogl.Vertex{1, 2}, false, nan)
+	c.Assert(err, IsNil)
+	c.Assert(gogl.Size(g), Equals, 1)
+}
+
+func (s *MatrixSuite) TestErrors(c *C) {
+	_, err := NewWeightedFromMatrix([][]float64{{0, 1}, {1}}, []gogl.Vertex{1, 2}, true, 0)
+	c.Assert(err, ErrorMatches, "Matrix is not square.*")
+
+	_, err = NewWeightedFromMatrix([][]float64{{0, 1}, {1, 0}}, []gogl.Vertex{1, 2, 3}, true, 0)
+	c.Assert(err, ErrorMatches, "Matrix has 2 rows, but 3 vertices were provided.")
+
+	_, err = NewWeightedFromMatrix([][]float64{{0, 1}, {2, 0}}, []gogl.Vertex{1, 2}, false, 0)
+	c.Assert(err, ErrorMatches, ".*symmetric.*")
+
+	g, _ := NewWeightedFromMatrix([][]float64{{0, 1}, {1, 0}}, []gogl.Vertex{1, 2}, false, 0)
+	_, err = ToAdjacencyMatrix(g, []gogl.Vertex{1}, 0)
+	c.Assert(err, NotNil)
+	_, err = ToAdjacencyMatrix(g, []gogl.Vertex{1, 3}, 0)
+	c.Assert(err, NotNil)
+	_, err = ToAdjacencyMatrix(g, []gogl.Vertex{1, 1}, 0)
+	c.Assert(err, NotNil)
+}
+
+func (s *MatrixSuite) TestIncidenceDirected(c *C) {
+	g := gogl.Spec().Directed().Using(gogl.WithIsolates(gogl.ArcList{
+		gogl.NewArc("a", "b"),
+		gogl.NewArc("b", "c"),
+		gogl.NewArc("c", "a"),
+		gogl.NewArc("a", "c"),
+	}, "d")).Create(al.G)
+
+	m, vertices, edges := IncidenceMatrix(g)
+	c.Assert(vertices, DeepEquals, matrixVertices)
+	c.Assert(edges, HasLen, gogl.Size(g))
+	c.Assert(m, HasLen, gogl.Order(g))
+
+	for j, e := range edges {
+		a := e.(gogl.Arc)
+		var plus, minus int
+		for i, row := range m {
+			c.Assert(row, HasLen, gogl.Size(g))
+			switch row[j] {
+			case 1:
+				plus++
+				c.Assert(vertices[i], Equals, a.Target())
+			case -1:
+				minus++
+				c.Assert(vertices[i], Equals, a.Source())
+			default:
+				c.Assert(row[j], Equals, 0)
+			}
+		}
+		c.Assert(plus, Equals, 1)
+		c.Assert(minus, Equals, 1)
+	}
+
+	// Columns follow the sorted edge order.
+	c.Assert(m, DeepEquals, [][]int{
+		{-1, -1, 0, 1},
+		{1, 0, -1, 0},
+		{0, 1, 1, -1},
+		{0, 0, 0, 0},
+	})
+}
+
+func (s *MatrixSuite) TestIncidenceUndirected(c *C) {
+	g := gogl.Spec().Using(gogl.EdgeList{
+		gogl.NewEdge("b", "a"),
+		gogl.NewEdge("c", "b"),
+		gogl.NewEdge("c", "c"),
+	}).Create(al.G)
+
+	m, vertices, edges := IncidenceMatrix(g)
+	c.Assert(vertices, DeepEquals, []gogl.Vertex{"a", "b", "c"})
+	c.Assert(edges, HasLen, 3)
+	c.Assert(m, DeepEquals, [][]int{
+		{1, 0, 0},
+		{1, 1, 0},
+		{0, 1, 2},
+	})
+}
@@ -0,0 +1,181 @@
+// Contains readers and writers for exchanging graphs in common serialization formats.
+package encoding
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+// The node-link document structure used by NetworkX's json_graph.node_link_data.
+type nodeLinkDoc struct {
+	Directed   bool                   `json:"directed"`
+	Multigraph bool                   `json:"multigraph"`
+	Graph      map[string]interface{} `json:"graph"`
+	Nodes      []nodeLinkNode         `json:"nodes"`
+	Links      []nodeLinkLink         `json:"links"`
+}
+
+type nodeLinkNode struct {
+	ID interface{} `json:"id"`
+}
+
+type nodeLinkLink struct {
+	Source interface{} `json:"source"`
+	Target interface{} `json:"target"`
+	Weight *float64    `json:"weight,omitempty"`
+	Label  *string     `json:"label,omitempty"`
+}
+
+// Writes the provided graph to the writer as node-link JSON, in the format produced
+// and consumed by NetworkX's json_graph.node_link_data and node_link_graph.
+//
+// Vertices are written as node ids directly, so they must be JSON-encodable; for
+// NetworkX interop, strings and numbers are the sensible choices. Weighted and labeled
+// graphs write a "weight" or "label" attribute on each link, respectively. gogl has no
+// multigraph implementations, so "multigraph" is always false.
+func WriteNodeLinkJSON(g gogl.Graph, w io.Writer) error {
+	doc := nodeLinkDoc{
+		Directed: g.IsDirected(),
+		Graph:    map[string]interface{}{},
+		Nodes:    make([]nodeLinkNode, 0),
+		Links:    make([]nodeLinkLink, 0),
+	}
+
+	g.Vertices(func(v gogl.Vertex) (terminate bool) {
+		doc.Nodes = append(doc.Nodes, nodeLinkNode{ID: v})
+		return
+	})
+
+	_, weighted := g.(gogl.WeightedGraph)
+	_, labeled := g.(gogl.LabeledGraph)
+	g.Edges(func(e gogl.Edge) (terminate bool) {
+		u, v := e.Both()
+		link := nodeLinkLink{Source: u, Target: v}
+		if weighted {
+			wt := e.(gogl.WeightedEdge).Weight()
+			link.Weight = &wt
+		} else if labeled {
+			l := e.(gogl.LabeledEdge).Label()
+			link.Label = &l
+		}
+		doc.Links = append(doc.Links, link)
+		return
+	})
+
+	return json.NewEncoder(w).Encode(doc)
+}
+
+// Reads a graph from node-link JSON, as produced by NetworkX's json_graph.node_link_data.
+//
+// Integer node ids are decoded as int, other numbers as float64, strings as string and
+// bools as bool; other id types (e.g., tuples, which arrive as arrays) are rejected. If
+// any link carries a "weight" attribute, a weighted graph is produced, with absent
+// weights taken as 1, as NetworkX's weighted algorithms do. Failing that, if any link
+// carries a "label", a labeled graph is produced. Other attributes are ignored.
+//
+// Multigraphs cannot be represented by gogl's graph implementations, so documents with
+// "multigraph" set are rejected with an error, rather than silently collapsing parallel
+// edges.
+func ReadNodeLinkJSON(r io.Reader) (MutableGraph, error) {
+	var doc nodeLinkDoc
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+	if err := dec.Decode(&doc); err != nil {
+		return nil, err
+	}
+
+	if doc.Multigraph {
+		return nil, errors.New("Multigraphs are not supported.")
+	}
+
+	vertices := make([]gogl.Vertex, 0, len(doc.Nodes))
+	for _, n := range doc.Nodes {
+		v, err := nodeLinkVertex(n.ID)
+		if err != nil {
+			return nil, err
+		}
+		vertices = append(vertices, v)
+	}
+
+	var weighted, labeled bool
+	for _, l := range doc.Links {
+		weighted = weighted || l.Weight != nil
+		labeled = labeled || l.Label != nil
+	}
+
+	spec := gogl.Spec()
+	if doc.Directed {
+		spec = spec.Directed()
+	}
+
+	var el gogl.EdgeList
+	var arcs gogl.ArcList
+	for _, l := range doc.Links {
+		u, err := nodeLinkVertex(l.Source)
+		if err != nil {
+			return nil, err
+		}
+		v, err := nodeLinkVertex(l.Target)
+		if err != nil {
+			return nil, err
+		}
+
+		var e gogl.Arc
+		switch {
+		case weighted:
+			w := 1.0
+			if l.Weight != nil {
+				w = *l.Weight
+			}
+			e = gogl.NewWeightedArc(u, v, w)
+		case labeled:
+			var label string
+			if l.Label != nil {
+				label = *l.Label
+			}
+			e = gogl.NewLabeledArc(u, v, label)
+		default:
+			e = gogl.NewArc(u, v)
+		}
+
+		if doc.Directed {
+			arcs = append(arcs, e)
+		} else {
+			el = append(el, e)
+		}
+	}
+
+	switch {
+	case weighted:
+		spec = spec.Weighted()
+	case labeled:
+		spec = spec.Labeled()
+	}
+
+	var src gogl.GraphSource = el
+	if doc.Directed {
+		src = arcs
+	}
+
+	return spec.Using(gogl.WithIsolates(src, vertices...)).Create(al.G).(MutableGraph), nil
+}
+
+// Converts a decoded node-link id into a vertex.
+func nodeLinkVertex(id interface{}) (gogl.Vertex, error) {
+	switch v := id.(type) {
+	case string, bool:
+		return v, nil
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return int(i), nil
+		}
+		return v.Float64()
+	default:
+		return nil, fmt.Errorf("Unsupported node id %v; only strings, numbers and bools may be used.", id)
+	}
+}
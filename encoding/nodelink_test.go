@@ -0,0 +1,96 @@
+package encoding
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+// Hook gocheck into the go test runner
+func TestHookup(t *testing.T) { TestingT(t) }
+
+type NodeLinkSuite struct{}
+
+var _ = Suite(&NodeLinkSuite{})
+
+// Output of networkx.readwrite.json_graph.node_link_data for a small weighted DiGraph.
+const nxWeightedDigraph = `{"directed": true, "multigraph": false, "graph": {"name": "roads"},
+"nodes": [{"id": "a"}, {"id": "b"}, {"id": "c"}, {"id": "iso"}],
+"links": [{"weight": 1.5, "source": "a", "target": "b"}, {"weight": 2, "source": "b", "target": "c"}]}`
+
+// Output of networkx.readwrite.json_graph.node_link_data for an unweighted Graph with int nodes.
+const nxGraph = `{"directed": false, "multigraph": false, "graph": {},
+"nodes": [{"id": 1}, {"id": 2}, {"id": 3}, {"id": 4}],
+"links": [{"source": 1, "target": 2}, {"source": 2, "target": 3}]}`
+
+func (s *NodeLinkSuite) TestReadNetworkX(c *C) {
+	g, err := ReadNodeLinkJSON(strings.NewReader(nxWeightedDigraph))
+	c.Assert(err, IsNil)
+
+	wg, ok := g.(gogl.WeightedDigraph)
+	c.Assert(ok, Equals, true)
+	c.Assert(gogl.Order(g), Equals, 4)
+	c.Assert(gogl.Size(g), Equals, 2)
+	c.Assert(wg.HasWeightedArc(gogl.NewWeightedArc("a", "b", 1.5)), Equals, true)
+	c.Assert(wg.HasWeightedArc(gogl.NewWeightedArc("b", "c", 2)), Equals, true)
+	c.Assert(wg.HasVertex("iso"), Equals, true)
+
+	g, err = ReadNodeLinkJSON(strings.NewReader(nxGraph))
+	c.Assert(err, IsNil)
+	c.Assert(g.IsDirected(), Equals, false)
+	c.Assert(gogl.Order(g), Equals, 4)
+	c.Assert(g.HasEdge(gogl.NewEdge(3, 2)), Equals, true)
+	c.Assert(g.HasVertex(4), Equals, true)
+
+	// As in NetworkX, a link without a weight counts as 1, not as a free edge.
+	g, err = ReadNodeLinkJSON(strings.NewReader(`{"directed": false, "nodes": [{"id": "a"}, {"id": "b"}, {"id": "c"}],
+"links": [{"weight": 3, "source": "a", "target": "b"}, {"source": "b", "target": "c"}]}`))
+	c.Assert(err, IsNil)
+	c.Assert(g.(gogl.WeightedGraph).HasWeightedEdge(gogl.NewWeightedEdge("b", "c", 1)), Equals, true)
+}
+
+func (s *NodeLinkSuite) TestReadErrors(c *C) {
+	_, err := ReadNodeLinkJSON(strings.NewReader(`{"directed": false, "multigraph": true, "nodes": [], "links": []}`))
+	c.Assert(err, NotNil)
+
+	_, err = ReadNodeLinkJSON(strings.NewReader(`{"directed": false, "nodes": [{"id": [1, 2]}], "links": []}`))
+	c.Assert(err, ErrorMatches, "Unsupported node id .*; only strings, numbers and bools may be used.")
+
+	g, err := ReadNodeLinkJSON(strings.NewReader(`{"directed": false, "nodes": [{"id": true}, {"id": "a"}], "links": [{"source": true, "target": "a"}]}`))
+	c.Assert(err, IsNil)
+	c.Assert(g.HasEdge(gogl.NewEdge(true, "a")), Equals, true)
+
+	_, err = ReadNodeLinkJSON(strings.NewReader(`{"directed": `))
+	c.Assert(err, NotNil)
+}
+
+func (s *NodeLinkSuite) TestRoundTrip(c *C) {
+	src := gogl.Spec().Directed().Weighted().
+		Using(gogl.WithIsolates(gogl.WeightedArcList{
+			gogl.NewWeightedArc("foo", "bar", 1.5),
+			gogl.NewWeightedArc("bar", "baz", -3),
+		}, "qux")).
+		Create(al.G)
+
+	var buf bytes.Buffer
+	c.Assert(WriteNodeLinkJSON(src, &buf), IsNil)
+	c.Assert(strings.Contains(buf.String(), `"multigraph":false`), Equals, true)
+
+	g, err := ReadNodeLinkJSON(&buf)
+	c.Assert(err, IsNil)
+	c.Assert(gogl.Equal(src, g), Equals, true)
+
+	ls := gogl.Spec().Labeled().
+		Using(gogl.LabeledEdgeList{gogl.NewLabeledEdge(1, 2, "foo")}).
+		Create(al.G)
+
+	buf.Reset()
+	c.Assert(WriteNodeLinkJSON(ls, &buf), IsNil)
+	g, err = ReadNodeLinkJSON(&buf)
+	c.Assert(err, IsNil)
+	c.Assert(gogl.Equal(ls, g), Equals, true)
+}
@@ -0,0 +1,103 @@
+package gogl
+
+// Indicates whether or not two graphs are equal: they must agree on directedness, and
+// contain the same vertex and edge sets.
+//
+// If both graphs are weighted (or both labeled), edge weights (or labels) must also
+// match. Other edge properties are disregarded.
+func Equal(a, b Graph) bool {
+	return equal(a, b, func(Graph, Vertex) bool {
+		return true
+	})
+}
+
+// Indicates whether or not two graphs are equal, disregarding any isolated vertices
+// (those with degree 0) either of them may contain. This is useful for comparing
+// graphs where stray isolates are an artifact of processing, rather than a
+// meaningful difference.
+//
+// In all other respects, the comparison is identical to that performed by Equal().
+func EqualIgnoringIsolated(a, b Graph) bool {
+	return equal(a, b, func(g Graph, v Vertex) bool {
+		deg, _ := g.DegreeOf(v)
+		return deg > 0
+	})
+}
+
+// Shared logic for graph equality checks. Only those vertices for which the
+// include func returns true are compared.
+func equal(a, b Graph, include func(Graph, Vertex) bool) bool {
+	da, adir := a.(Digraph)
+	db, bdir := b.(Digraph)
+	if adir != bdir || Size(a) != Size(b) {
+		return false
+	}
+
+	count := func(g Graph) (n int) {
+		g.Vertices(func(v Vertex) (terminate bool) {
+			if include(g, v) {
+				n++
+			}
+			return
+		})
+		return
+	}
+
+	if count(a) != count(b) {
+		return false
+	}
+
+	eq := true
+	a.Vertices(func(v Vertex) (terminate bool) {
+		if include(a, v) && !b.HasVertex(v) {
+			eq = false
+		}
+		return !eq
+	})
+
+	if !eq {
+		return false
+	}
+
+	if adir {
+		_, weighted := a.(WeightedDigraph)
+		wb, _ := b.(WeightedDigraph)
+		weighted = weighted && wb != nil
+		_, labeled := a.(LabeledDigraph)
+		lb, _ := b.(LabeledDigraph)
+		labeled = labeled && lb != nil
+
+		da.Arcs(func(arc Arc) (terminate bool) {
+			switch {
+			case weighted:
+				eq = wb.HasWeightedArc(arc.(WeightedArc))
+			case labeled:
+				eq = lb.HasLabeledArc(arc.(LabeledArc))
+			default:
+				eq = db.HasArc(arc)
+			}
+			return !eq
+		})
+	} else {
+		_, weighted := a.(WeightedGraph)
+		wb, _ := b.(WeightedGraph)
+		weighted = weighted && wb != nil
+		_, labeled := a.(LabeledGraph)
+		lb, _ := b.(LabeledGraph)
+		labeled = labeled && lb != nil
+
+		a.Edges(func(e Edge) (terminate bool) {
+			switch {
+			case weighted:
+				eq = wb.HasWeightedEdge(e.(WeightedEdge))
+			case labeled:
+				eq = lb.HasLabeledEdge(e.(LabeledEdge))
+			default:
+				eq = b.HasEdge(e)
+			}
+			return !eq
+		})
+	}
+
+	return eq
+}
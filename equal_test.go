@@ -0,0 +1,46 @@
+package gogl_test
+
+import (
+	. "github.com/sdboyer/gocheck"
+	. "github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+	"github.com/sdboyer/gogl/spec"
+)
+
+type EqualitySuite struct{}
+
+var _ = Suite(&EqualitySuite{})
+
+func (s *EqualitySuite) TestEqual(c *C) {
+	a := Spec().Using(spec.GraphFixtures["2e3v"]).Create(al.G)
+	b := Spec().Using(EdgeList{NewEdge("baz", "bar"), NewEdge("bar", "foo")}).Create(al.G)
+	c.Assert(Equal(a, b), Equals, true)
+
+	// directedness must match
+	d := Spec().Directed().Using(spec.GraphFixtures["2e3v"]).Create(al.G)
+	c.Assert(Equal(a, d), Equals, false)
+
+	// arcs must match direction
+	d2 := Spec().Directed().Using(ArcList{NewArc("bar", "foo"), NewArc("bar", "baz")}).Create(al.G)
+	c.Assert(Equal(d, d2), Equals, false)
+
+	// weights must match if both are weighted
+	w1 := Spec().Weighted().Using(spec.GraphFixtures["w-2e3v"]).Create(al.G)
+	w2 := Spec().Weighted().Using(WeightedEdgeList{NewWeightedEdge(1, 2, 5.23), NewWeightedEdge(2, 3, 1)}).Create(al.G)
+	c.Assert(Equal(w1, w1), Equals, true)
+	c.Assert(Equal(w1, w2), Equals, false)
+}
+
+func (s *EqualitySuite) TestEqualIgnoringIsolated(c *C) {
+	a := Spec().Mutable().Using(spec.GraphFixtures["2e3v"]).Create(al.G)
+	b := Spec().Mutable().Using(spec.GraphFixtures["2e3v"]).Create(al.G)
+	b.(MutableGraph).EnsureVertex("isolate")
+
+	c.Assert(Equal(a, b), Equals, false)
+	c.Assert(EqualIgnoringIsolated(a, b), Equals, true)
+	c.Assert(EqualIgnoringIsolated(b, a), Equals, true)
+
+	// Non-isolate differences still count
+	b.(MutableGraph).AddEdges(NewEdge("isolate", "foo"))
+	c.Assert(EqualIgnoringIsolated(a, b), Equals, false)
+}
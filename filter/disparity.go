@@ -0,0 +1,72 @@
+package filter
+
+import (
+	"math"
+
+	"github.com/sdboyer/gogl"
+)
+
+// Extracts the backbone of a weighted graph using the disparity filter described by
+// Serrano, Boguñá and Vespignani in "Extracting the multiscale backbone of complex
+// weighted networks" (PNAS, 2009).
+//
+// For each vertex i of degree k and strength s (the sum of its incident edge weights),
+// each incident edge's normalized weight p = w/s is tested against the null hypothesis
+// that i's strength is distributed uniformly at random across its edges. The probability
+// of observing a normalized weight at least as large as p under that null model is
+//
+//	α_ij = (1 - p)^(k-1)
+//
+// and the edge is statistically significant from i's perspective if α_ij < alpha. An edge
+// is kept in the backbone if it is significant from the perspective of either endpoint.
+// Lower values of alpha are thus more stringent, and keep fewer edges.
+//
+// Vertices of degree 1 can never find their sole edge significant, so such edges survive
+// only on the strength of the vertex at their other end.
+//
+// For digraphs, the source side of each arc is evaluated against out-degree and out-strength,
+// and the target side against in-degree and in-strength.
+//
+// All vertices are retained in the returned graph, which is directed iff the provided graph is
+// a Digraph. Edge weights are assumed to be positive.
+func DisparityFilterBackbone(g gogl.WeightedGraph, alpha float64) gogl.WeightedGraph {
+	// Out and in degree/strength; for undirected graphs, only the out maps are used.
+	outdeg, indeg := make(map[gogl.Vertex]int), make(map[gogl.Vertex]int)
+	outstr, instr := make(map[gogl.Vertex]float64), make(map[gogl.Vertex]float64)
+
+	_, directed := g.(gogl.Digraph)
+	g.Edges(func(e gogl.Edge) (terminate bool) {
+		we, ok := e.(gogl.WeightedEdge)
+		if !ok {
+			return
+		}
+
+		u, v := e.Both()
+		outdeg[u]++
+		outstr[u] += we.Weight()
+		if directed {
+			indeg[v]++
+			instr[v] += we.Weight()
+		} else if u != v {
+			outdeg[v]++
+			outstr[v] += we.Weight()
+		}
+		return
+	})
+
+	significant := func(w, s float64, k int) bool {
+		if k < 2 || s == 0 {
+			return false
+		}
+		return math.Pow(1-w/s, float64(k-1)) < alpha
+	}
+
+	return copyWeighted(g, func(e gogl.WeightedEdge) bool {
+		u, v := e.Both()
+		w := e.Weight()
+		if directed {
+			return significant(w, outstr[u], outdeg[u]) || significant(w, instr[v], indeg[v])
+		}
+		return significant(w, outstr[u], outdeg[u]) || significant(w, outstr[v], outdeg[v])
+	})
+}
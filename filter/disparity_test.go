@@ -0,0 +1,51 @@
+package filter
+
+import (
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+type DisparityFilterSuite struct{}
+
+var _ = Suite(&DisparityFilterSuite{})
+
+// A hub with one dominant edge and several weak ones, plus a single edge between two leaves.
+var hubEdges = gogl.WeightedEdgeList{
+	gogl.NewWeightedEdge("hub", "a", 10),
+	gogl.NewWeightedEdge("hub", "b", 1),
+	gogl.NewWeightedEdge("hub", "c", 1),
+	gogl.NewWeightedEdge("hub", "d", 1),
+	gogl.NewWeightedEdge("hub", "e", 1),
+	gogl.NewWeightedEdge("hub", "f", 1),
+	gogl.NewWeightedEdge("b", "c", 1),
+}
+
+func (s *DisparityFilterSuite) TestAlphaSweep(c *C) {
+	g := gogl.Spec().Weighted().Using(hubEdges).Create(al.G).(gogl.WeightedGraph)
+
+	last := -1
+	for _, alpha := range []float64{1, 0.8, 0.6, 0.3, 0.05, 0.001} {
+		b := DisparityFilterBackbone(g, alpha)
+		c.Assert(gogl.Order(b), Equals, gogl.Order(g))
+		if last >= 0 {
+			c.Assert(gogl.Size(b) <= last, Equals, true, Commentf("alpha %v kept more edges than a looser alpha", alpha))
+		}
+		last = gogl.Size(b)
+
+		if alpha > 0.01 {
+			c.Assert(b.HasEdge(gogl.NewEdge("hub", "a")), Equals, true)
+		}
+	}
+
+	// Only the dominant edge survives a strict test.
+	b := DisparityFilterBackbone(g, 0.05)
+	c.Assert(gogl.Size(b), Equals, 1)
+	c.Assert(b.HasWeightedEdge(gogl.NewWeightedEdge("hub", "a", 10)), Equals, true)
+
+	// The leaf-to-leaf edge is half of b's strength, significant only at a loose alpha.
+	c.Assert(DisparityFilterBackbone(g, 0.6).HasEdge(gogl.NewEdge("b", "c")), Equals, true)
+	c.Assert(DisparityFilterBackbone(g, 0.3).HasEdge(gogl.NewEdge("b", "c")), Equals, false)
+
+	c.Assert(gogl.Size(DisparityFilterBackbone(g, 0.001)), Equals, 0)
+}
@@ -0,0 +1,101 @@
+// Contains algos for thinning graphs down to a subset of their edges or vertices.
+package filter
+
+import (
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+// Returns the subgraph of the provided graph induced by those vertices for which
+// the keep func returns true.
+//
+// Edges are retained iff both of their endpoints are kept. The returned graph has
+// the same directedness and edge type as the provided graph.
+func FilterVertices(g gogl.Graph, keep func(gogl.Vertex) bool) gogl.Graph {
+	return subgraph(g, keep, func(gogl.Edge) bool { return true })
+}
+
+// Returns a graph containing every vertex of the provided graph, but only those
+// edges for which the keep func returns true.
+//
+// If the provided graph is a Digraph, the keep func is passed arcs. The returned
+// graph has the same directedness and edge type as the provided graph.
+func FilterEdges(g gogl.Graph, keep func(gogl.Edge) bool) gogl.Graph {
+	return subgraph(g, func(gogl.Vertex) bool { return true }, keep)
+}
+
+// Returns a new weighted graph containing every vertex of the provided graph, but
+// only those edges with a weight greater than or equal to minWeight.
+//
+// Vertices left isolated by the removal of their edges are retained. The returned
+// graph is directed iff the provided graph is a Digraph.
+func ThresholdFilter(g gogl.WeightedGraph, minWeight float64) gogl.WeightedGraph {
+	return copyWeighted(g, func(e gogl.WeightedEdge) bool {
+		return e.Weight() >= minWeight
+	})
+}
+
+// Copies all of the provided graph's vertices, plus those of its edges accepted by
+// the keep func, into a new weighted adjacency list of the same directedness.
+func copyWeighted(g gogl.WeightedGraph, keep func(gogl.WeightedEdge) bool) gogl.WeightedGraph {
+	return subgraph(g, func(gogl.Vertex) bool { return true }, func(e gogl.Edge) bool {
+		we, ok := e.(gogl.WeightedEdge)
+		return ok && keep(we)
+	}).(gogl.WeightedGraph)
+}
+
+// Copies the vertices and edges of the provided graph that pass the given predicates
+// into a new adjacency list of the same kind.
+func subgraph(g gogl.Graph, vkeep func(gogl.Vertex) bool, ekeep func(gogl.Edge) bool) gogl.Graph {
+	fg := filtered{g: g, vkeep: vkeep, ekeep: ekeep}
+
+	if dg, ok := g.(gogl.Digraph); ok {
+		return gogl.SpecOf(g).Using(filteredDigraph{fg, dg}).Create(al.G)
+	}
+	return gogl.SpecOf(g).Using(fg).Create(al.G)
+}
+
+// A GraphSource that presents a filtered view of another graph.
+type filtered struct {
+	g     gogl.Graph
+	vkeep func(gogl.Vertex) bool
+	ekeep func(gogl.Edge) bool
+}
+
+func (f filtered) Vertices(fn gogl.VertexStep) {
+	f.g.Vertices(func(v gogl.Vertex) bool {
+		if f.vkeep(v) {
+			return fn(v)
+		}
+		return false
+	})
+}
+
+func (f filtered) Edges(fn gogl.EdgeStep) {
+	f.g.Edges(func(e gogl.Edge) bool {
+		if f.keep(e) {
+			return fn(e)
+		}
+		return false
+	})
+}
+
+func (f filtered) keep(e gogl.Edge) bool {
+	u, v := e.Both()
+	return f.vkeep(u) && f.vkeep(v) && f.ekeep(e)
+}
+
+// A DigraphSource that presents a filtered view of another digraph.
+type filteredDigraph struct {
+	filtered
+	dg gogl.Digraph
+}
+
+func (f filteredDigraph) Arcs(fn gogl.ArcStep) {
+	f.dg.Arcs(func(a gogl.Arc) bool {
+		if f.keep(a) {
+			return fn(a)
+		}
+		return false
+	})
+}
@@ -0,0 +1,132 @@
+package filter
+
+import (
+	"testing"
+
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+// Hook gocheck into the go test runner
+func Test(t *testing.T) { TestingT(t) }
+
+var correlations = gogl.WeightedEdgeList{
+	gogl.NewWeightedEdge("a", "b", 0.9),
+	gogl.NewWeightedEdge("a", "c", 0.7),
+	gogl.NewWeightedEdge("b", "c", 0.5),
+	gogl.NewWeightedEdge("c", "d", 0.3),
+	gogl.NewWeightedEdge("d", "e", 0.1),
+}
+
+type ThresholdFilterSuite struct{}
+
+var _ = Suite(&ThresholdFilterSuite{})
+
+func (s *ThresholdFilterSuite) TestMonotonicSweep(c *C) {
+	g := gogl.Spec().Weighted().Using(correlations).Create(al.G).(gogl.WeightedGraph)
+
+	last := gogl.Size(g) + 1
+	for _, t := range []float64{0, 0.1, 0.2, 0.5, 0.8, 1} {
+		f := ThresholdFilter(g, t)
+
+		c.Assert(gogl.Order(f), Equals, gogl.Order(g))
+		g.Vertices(func(v gogl.Vertex) (terminate bool) {
+			c.Assert(f.HasVertex(v), Equals, true)
+			return
+		})
+
+		f.Edges(func(e gogl.Edge) (terminate bool) {
+			c.Assert(e.(gogl.WeightedEdge).Weight() >= t, Equals, true)
+			return
+		})
+
+		c.Assert(gogl.Size(f) <= last, Equals, true)
+		last = gogl.Size(f)
+	}
+
+	c.Assert(gogl.Size(ThresholdFilter(g, 0.5)), Equals, 3)
+	c.Assert(gogl.Size(ThresholdFilter(g, 1)), Equals, 0)
+}
+
+func (s *ThresholdFilterSuite) TestDirected(c *C) {
+	g := gogl.Spec().Directed().Weighted().Using(gogl.WeightedArcList{
+		gogl.NewWeightedArc("a", "b", 2),
+		gogl.NewWeightedArc("b", "a", 1),
+	}).Create(al.G).(gogl.WeightedGraph)
+
+	f := ThresholdFilter(g, 2)
+	c.Assert(f, Implements, new(gogl.WeightedDigraph))
+	c.Assert(f.(gogl.Digraph).HasArc(gogl.NewArc("a", "b")), Equals, true)
+	c.Assert(f.(gogl.Digraph).HasArc(gogl.NewArc("b", "a")), Equals, false)
+}
+
+type PredicateFilterSuite struct{}
+
+var _ = Suite(&PredicateFilterSuite{})
+
+var numbers = gogl.ArcList{
+	gogl.NewArc(1, 2),
+	gogl.NewArc(2, 3),
+	gogl.NewArc(3, 4),
+	gogl.NewArc(4, 1),
+	gogl.NewArc(1, 3),
+	gogl.NewArc(2, 4),
+}
+
+func even(v gogl.Vertex) bool {
+	return v.(int)%2 == 0
+}
+
+func ascending(e gogl.Edge) bool {
+	u, v := e.Both()
+	return u.(int) < v.(int)
+}
+
+func (s *PredicateFilterSuite) TestFilterVertices(c *C) {
+	g := gogl.Spec().Using(numbers).Create(al.G)
+	f := FilterVertices(g, func(v gogl.Vertex) bool { return v != 4 })
+
+	c.Assert(gogl.Order(f), Equals, 3)
+	c.Assert(gogl.Size(f), Equals, 3)
+	c.Assert(f.HasEdge(gogl.NewEdge(1, 2)), Equals, true)
+	c.Assert(f.HasEdge(gogl.NewEdge(3, 4)), Equals, false)
+
+	f = FilterVertices(g, even)
+	c.Assert(gogl.Order(f), Equals, 2)
+	c.Assert(gogl.Size(f), Equals, 1)
+	c.Assert(f.HasEdge(gogl.NewEdge(2, 4)), Equals, true)
+}
+
+func (s *PredicateFilterSuite) TestFilterEdges(c *C) {
+	g := gogl.Spec().Directed().Using(numbers).Create(al.G)
+	f := FilterEdges(g, ascending)
+
+	c.Assert(f, Implements, new(gogl.Digraph))
+	c.Assert(gogl.Order(f), Equals, 4)
+	c.Assert(gogl.Size(f), Equals, 5)
+	c.Assert(f.(gogl.Digraph).HasArc(gogl.NewArc(4, 1)), Equals, false)
+}
+
+func (s *PredicateFilterSuite) TestComposed(c *C) {
+	g := gogl.Spec().Directed().Using(numbers).Create(al.G)
+
+	f := FilterEdges(FilterVertices(g, func(v gogl.Vertex) bool { return v != 3 }), ascending)
+	c.Assert(gogl.Order(f), Equals, 3)
+	c.Assert(gogl.Size(f), Equals, 2)
+	c.Assert(f.(gogl.Digraph).HasArc(gogl.NewArc(1, 2)), Equals, true)
+	c.Assert(f.(gogl.Digraph).HasArc(gogl.NewArc(2, 4)), Equals, true)
+
+	c.Assert(gogl.Equal(f, FilterVertices(FilterEdges(g, ascending), func(v gogl.Vertex) bool { return v != 3 })), Equals, true)
+}
+
+func (s *PredicateFilterSuite) TestPreservesEdgeType(c *C) {
+	g := gogl.Spec().Labeled().Using(gogl.LabeledEdgeList{
+		gogl.NewLabeledEdge(1, 2, "foo"),
+		gogl.NewLabeledEdge(2, 3, "bar"),
+	}).Create(al.G)
+
+	f := FilterVertices(g, func(v gogl.Vertex) bool { return v != 3 })
+	c.Assert(f, Implements, new(gogl.LabeledGraph))
+	c.Assert(f.(gogl.LabeledGraph).HasLabeledEdge(gogl.NewLabeledEdge(1, 2, "foo")), Equals, true)
+}
@@ -0,0 +1,95 @@
+package flow
+
+import (
+	"github.com/sdboyer/gogl"
+)
+
+// Finds a maximum b-matching in the given bipartite graph: a largest set of edges such
+// that each vertex v is an endpoint of at most capacity(v) of them. Among all such sets,
+// one of greatest total weight is chosen. Returns the matched edges, which are the
+// graph's own, along with their number. With a capacity of 1 everywhere, this is an
+// ordinary maximum-cardinality bipartite matching.
+//
+// The problem is solved as a min-cost flow: a source feeds each vertex on one side up to
+// its capacity, each edge carries one unit across at a cost of its negated weight, and
+// each vertex on the other side drains up to its capacity into a sink. Vertices with a
+// capacity of zero or less are never matched. Loops and arc direction are disregarded.
+//
+// The sides are found by 2-coloring each connected component; this panics if the graph
+// is not bipartite.
+func BMatching(g gogl.WeightedGraph, capacity func(gogl.Vertex) int) (gogl.EdgeList, int) {
+	index, vertices := indexVertices(g)
+	side := bipartition(g, index, vertices)
+
+	s, t := len(vertices), len(vertices)+1
+	n := newNetwork(len(vertices) + 2)
+	for i, v := range vertices {
+		c := capacity(v)
+		if c <= 0 {
+			continue
+		}
+		if side[i] {
+			n.addCostArc(i, t, c, 0, nil)
+		} else {
+			n.addCostArc(s, i, c, 0, nil)
+		}
+	}
+
+	eachEdge(g, index, func(u, v int, e gogl.Edge) {
+		if side[u] {
+			u, v = v, u
+		}
+		n.addCostArc(u, v, 1, -e.(gogl.WeightedEdge).Weight(), e)
+	})
+
+	count := n.minCostFlow(s, t)
+
+	matched := make(gogl.EdgeList, 0, count)
+	for u := range vertices {
+		if side[u] {
+			continue
+		}
+		for _, i := range n.adj[u] {
+			if i%2 == 0 && n.arcs[i].edge != nil && n.flowing(i) {
+				matched = append(matched, n.arcs[i].edge)
+			}
+		}
+	}
+
+	return matched, count
+}
+
+// 2-colors the graph's vertices by breadth-first search over each component, returning
+// true for those on the second side. Panics if the graph is not bipartite.
+func bipartition(g gogl.Graph, index map[gogl.Vertex]int, vertices []gogl.Vertex) []bool {
+	side := make([]bool, len(vertices))
+	colored := make([]bool, len(vertices))
+
+	for root := range vertices {
+		if colored[root] {
+			continue
+		}
+		colored[root] = true
+		queue := []int{root}
+
+		for len(queue) > 0 {
+			u := queue[0]
+			queue = queue[1:]
+			g.AdjacentTo(vertices[u], func(adj gogl.Vertex) (terminate bool) {
+				v := index[adj]
+				if v == u {
+					return
+				}
+				if !colored[v] {
+					colored[v], side[v] = true, !side[u]
+					queue = append(queue, v)
+				} else if side[v] == side[u] {
+					panic("BMatching requires a bipartite graph.")
+				}
+				return
+			})
+		}
+	}
+
+	return side
+}
@@ -0,0 +1,142 @@
+package flow
+
+import (
+	"math/rand"
+
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+type BMatchingSuite struct{}
+
+var _ = Suite(&BMatchingSuite{})
+
+func one(gogl.Vertex) int { return 1 }
+
+// Asserts that the matching uses only edges of g, and respects the capacities.
+func checkBMatching(c *C, g gogl.WeightedGraph, capacity func(gogl.Vertex) int, matched gogl.EdgeList) {
+	load := make(map[gogl.Vertex]int)
+	for _, e := range matched {
+		c.Assert(g.HasWeightedEdge(e.(gogl.WeightedEdge)), Equals, true)
+		u, v := e.Both()
+		load[u]++
+		load[v]++
+	}
+	for v, l := range load {
+		c.Assert(l <= capacity(v), Equals, true, Commentf("vertex %v over capacity", v))
+	}
+}
+
+// Computes the size of a maximum bipartite matching by Kuhn's augmenting path
+// algorithm, as a reference.
+func kuhn(g gogl.Graph, left []gogl.Vertex) int {
+	match := make(map[gogl.Vertex]gogl.Vertex)
+	var try func(u gogl.Vertex, seen map[gogl.Vertex]bool) bool
+	try = func(u gogl.Vertex, seen map[gogl.Vertex]bool) (found bool) {
+		g.AdjacentTo(u, func(v gogl.Vertex) (terminate bool) {
+			if seen[v] {
+				return
+			}
+			seen[v] = true
+			if m, taken := match[v]; !taken || try(m, seen) {
+				match[v] = u
+				found = true
+			}
+			return found
+		})
+		return
+	}
+
+	var size int
+	for _, u := range left {
+		if try(u, make(map[gogl.Vertex]bool)) {
+			size++
+		}
+	}
+	return size
+}
+
+func (s *BMatchingSuite) TestUnitCapacityIsMatching(c *C) {
+	r := rand.New(rand.NewSource(5))
+	for trial := 0; trial < 20; trial++ {
+		el := gogl.WeightedEdgeList{}
+		var left []gogl.Vertex
+		for i := 0; i < 8; i++ {
+			left = append(left, i)
+			for j := 100; j < 108; j++ {
+				if r.Float64() < 0.25 {
+					el = append(el, gogl.NewWeightedEdge(i, j, float64(r.Intn(10))))
+				}
+			}
+		}
+		g := gogl.Spec().Weighted().Using(gogl.WithIsolates(el, left...)).Create(al.G).(gogl.WeightedGraph)
+
+		matched, count := BMatching(g, one)
+		c.Assert(count, Equals, len(matched))
+		c.Assert(count, Equals, kuhn(g, left))
+		checkBMatching(c, g, one, matched)
+	}
+}
+
+func (s *BMatchingSuite) TestCapacities(c *C) {
+	// Two workers, four shifts; every worker can take every shift.
+	el := gogl.WeightedEdgeList{}
+	for _, w := range []string{"ann", "bob"} {
+		for shift := 1; shift <= 4; shift++ {
+			el = append(el, gogl.NewWeightedEdge(w, shift, 1))
+		}
+	}
+	g := gogl.Spec().Weighted().Using(el).Create(al.G).(gogl.WeightedGraph)
+
+	matched, count := BMatching(g, one)
+	c.Assert(count, Equals, 2)
+	checkBMatching(c, g, one, matched)
+
+	quota := func(v gogl.Vertex) int {
+		switch v {
+		case "ann":
+			return 3
+		case "bob":
+			return 2
+		}
+		return 1
+	}
+	matched, count = BMatching(g, quota)
+	c.Assert(count, Equals, 4)
+	checkBMatching(c, g, quota, matched)
+
+	none := func(gogl.Vertex) int { return 0 }
+	matched, count = BMatching(g, none)
+	c.Assert(count, Equals, 0)
+	c.Assert(matched, HasLen, 0)
+}
+
+func (s *BMatchingSuite) TestPrefersWeight(c *C) {
+	g := gogl.Spec().Weighted().Using(gogl.WeightedEdgeList{
+		gogl.NewWeightedEdge("a", "x", 5),
+		gogl.NewWeightedEdge("a", "y", 1),
+		gogl.NewWeightedEdge("b", "x", 1),
+		gogl.NewWeightedEdge("b", "y", 5),
+		gogl.NewWeightedEdge("b", "z", 9),
+	}).Create(al.G).(gogl.WeightedGraph)
+
+	// Several matchings of size 2 exist; a-x with b-z is the heaviest.
+	matched, count := BMatching(g, one)
+	c.Assert(count, Equals, 2)
+	var total float64
+	for _, e := range matched {
+		total += e.(gogl.WeightedEdge).Weight()
+	}
+	c.Assert(total, Equals, float64(14))
+}
+
+func (s *BMatchingSuite) TestNotBipartite(c *C) {
+	g := gogl.Spec().Weighted().Using(gogl.WeightedEdgeList{
+		gogl.NewWeightedEdge(1, 2, 1),
+		gogl.NewWeightedEdge(2, 3, 1),
+		gogl.NewWeightedEdge(3, 1, 1),
+	}).Create(al.G).(gogl.WeightedGraph)
+
+	c.Assert(func() { BMatching(g, one) }, PanicMatches, "BMatching requires a bipartite graph.")
+}
@@ -0,0 +1,120 @@
+package flow
+
+import (
+	"github.com/sdboyer/gogl"
+)
+
+// Computes the edge connectivity of the graph: the least number of edges whose removal
+// disconnects it. Graphs that are already disconnected, or have fewer than two vertices,
+// have an edge connectivity of zero.
+//
+// Every minimum cut separates some vertex from an arbitrary fixed one, so only the max
+// flows between that vertex and each other need be computed. If the graph is a Digraph,
+// connectivity is strong connectivity, and flows are computed in both directions.
+func EdgeConnectivity(g gogl.Graph) int {
+	index, vertices := indexVertices(g)
+	if len(vertices) < 2 {
+		return 0
+	}
+
+	n := newNetwork(len(vertices))
+	_, directed := g.(gogl.Digraph)
+	eachEdge(g, index, func(u, v int, e gogl.Edge) {
+		if directed {
+			n.addArc(u, v, 1, 0, e)
+		} else {
+			n.addArc(u, v, 1, 1, e)
+		}
+	})
+
+	min := -1
+	cut := func(s, t int) {
+		n.reset()
+		if f := n.maxFlow(s, t, min); min == -1 || f < min {
+			min = f
+		}
+	}
+
+	for t := 1; t < len(vertices) && min != 0; t++ {
+		cut(0, t)
+		if directed {
+			cut(t, 0)
+		}
+	}
+
+	return min
+}
+
+// Computes the vertex connectivity of the graph: the least number of vertices whose
+// removal disconnects it, or leaves only a single vertex. A complete graph on n vertices
+// thus has a vertex connectivity of n-1. Graphs that are already disconnected, or have
+// fewer than two vertices, have a vertex connectivity of zero.
+//
+// For undirected graphs, the number of pairs checked is reduced by fixing a vertex v of
+// minimum degree: every minimum vertex cut either omits v, and so separates it from some
+// non-neighbor, or contains v, and so separates two of its neighbors (Esfahanian and
+// Hakimi). For digraphs, connectivity is strong connectivity, and all ordered pairs of
+// non-adjacent vertices are checked.
+func VertexConnectivity(g gogl.Graph) int {
+	index, vertices := indexVertices(g)
+	if len(vertices) < 2 {
+		return 0
+	}
+
+	n := splitNetwork(g, index, len(vertices))
+	adj := make([]map[int]bool, len(vertices))
+	for k := range adj {
+		adj[k] = make(map[int]bool)
+	}
+	_, directed := g.(gogl.Digraph)
+	eachEdge(g, index, func(u, v int, e gogl.Edge) {
+		adj[u][v] = true
+		if !directed {
+			adj[v][u] = true
+		}
+	})
+
+	min := len(vertices) - 1
+	cut := func(u, v int) {
+		if u == v || adj[u][v] {
+			return
+		}
+		n.reset()
+		if f := n.maxFlow(2*u+1, 2*v, min); f < min {
+			min = f
+		}
+	}
+
+	if directed {
+		for u := range vertices {
+			for v := range vertices {
+				cut(u, v)
+			}
+		}
+		return min
+	}
+
+	pivot := 0
+	for k := range adj {
+		if len(adj[k]) < len(adj[pivot]) {
+			pivot = k
+		}
+	}
+
+	var neighbors []int
+	for v := range vertices {
+		if adj[pivot][v] {
+			neighbors = append(neighbors, v)
+		} else {
+			cut(pivot, v)
+		}
+	}
+
+	for i, x := range neighbors {
+		for _, y := range neighbors[i+1:] {
+			cut(x, y)
+		}
+	}
+
+	return min
+}
@@ -0,0 +1,92 @@
+package flow
+
+import (
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+type ConnectivitySuite struct{}
+
+var _ = Suite(&ConnectivitySuite{})
+
+func cycle(n int) gogl.Graph {
+	el := gogl.EdgeList{}
+	for i := 0; i < n; i++ {
+		el = append(el, gogl.NewEdge(i, (i+1)%n))
+	}
+	return gogl.Spec().Using(el).Create(al.G)
+}
+
+func complete(n int) gogl.Graph {
+	el := gogl.EdgeList{}
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			el = append(el, gogl.NewEdge(i, j))
+		}
+	}
+	return gogl.Spec().Using(el).Create(al.G)
+}
+
+func (s *ConnectivitySuite) TestCycle(c *C) {
+	for n := 3; n < 8; n++ {
+		c.Assert(EdgeConnectivity(cycle(n)), Equals, 2)
+		c.Assert(VertexConnectivity(cycle(n)), Equals, 2)
+	}
+}
+
+func (s *ConnectivitySuite) TestComplete(c *C) {
+	for n := 2; n < 8; n++ {
+		c.Assert(EdgeConnectivity(complete(n)), Equals, n-1)
+		c.Assert(VertexConnectivity(complete(n)), Equals, n-1)
+	}
+}
+
+func (s *ConnectivitySuite) TestCutVertex(c *C) {
+	// Two K4s sharing vertex 3: edge connectivity 3, but removing 3 disconnects.
+	el := gogl.EdgeList{}
+	for _, base := range []int{0, 3} {
+		for i := base; i < base+4; i++ {
+			for j := i + 1; j < base+4; j++ {
+				el = append(el, gogl.NewEdge(i, j))
+			}
+		}
+	}
+	g := gogl.Spec().Using(el).Create(al.G)
+
+	c.Assert(EdgeConnectivity(g), Equals, 3)
+	c.Assert(VertexConnectivity(g), Equals, 1)
+}
+
+func (s *ConnectivitySuite) TestDegenerate(c *C) {
+	disconnected := gogl.Spec().Using(gogl.EdgeList{
+		gogl.NewEdge(1, 2),
+		gogl.NewEdge(3, 4),
+	}).Create(al.G)
+	c.Assert(EdgeConnectivity(disconnected), Equals, 0)
+	c.Assert(VertexConnectivity(disconnected), Equals, 0)
+
+	single := gogl.Spec().Using(gogl.WithIsolates(gogl.EdgeList{}, 1)).Create(al.G)
+	c.Assert(EdgeConnectivity(single), Equals, 0)
+	c.Assert(VertexConnectivity(single), Equals, 0)
+}
+
+func (s *ConnectivitySuite) TestDirected(c *C) {
+	// A directed cycle is strongly connected, but only just.
+	g := gogl.Spec().Directed().Using(gogl.ArcList{
+		gogl.NewArc(0, 1),
+		gogl.NewArc(1, 2),
+		gogl.NewArc(2, 3),
+		gogl.NewArc(3, 0),
+	}).Create(al.G)
+	c.Assert(EdgeConnectivity(g), Equals, 1)
+	c.Assert(VertexConnectivity(g), Equals, 1)
+
+	// A path is not strongly connected at all.
+	p := gogl.Spec().Directed().Using(gogl.ArcList{
+		gogl.NewArc(0, 1),
+		gogl.NewArc(1, 2),
+	}).Create(al.G)
+	c.Assert(EdgeConnectivity(p), Equals, 0)
+	c.Assert(VertexConnectivity(p), Equals, 0)
+}
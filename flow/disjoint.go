@@ -0,0 +1,77 @@
+package flow
+
+import (
+	"github.com/sdboyer/gogl"
+)
+
+// Finds a maximum set of pairwise edge-disjoint paths from source to sink, returning
+// their number and the paths themselves, made up of the graph's own edges. By Menger's
+// theorem, the number is also the size of a minimum edge cut separating the two.
+//
+// Paths are found via a unit-capacity max flow. If the graph is a Digraph, paths follow
+// arc direction. If either vertex is absent, or source and sink are the same vertex, the
+// result is zero.
+func EdgeDisjointPaths(g gogl.Graph, source, sink gogl.Vertex) (int, []gogl.Path) {
+	if source == sink || !g.HasVertex(source) || !g.HasVertex(sink) {
+		return 0, nil
+	}
+
+	index, vertices := indexVertices(g)
+	n := newNetwork(len(vertices))
+	_, directed := g.(gogl.Digraph)
+
+	eachEdge(g, index, func(u, v int, e gogl.Edge) {
+		if directed {
+			n.addArc(u, v, 1, 0, e)
+		} else {
+			n.addArc(u, v, 1, 1, e)
+		}
+	})
+
+	s, t := index[source], index[sink]
+	count := n.maxFlow(s, t, 0)
+	return count, n.paths(s, t, count)
+}
+
+// Finds a maximum set of internally vertex-disjoint paths from source to sink - paths
+// that share no vertex other than source and sink - returning their number and the
+// paths themselves, made up of the graph's own edges. By Menger's theorem, if source and
+// sink are not adjacent, the number is also the size of a minimum vertex cut separating
+// the two.
+//
+// Each vertex is split into an in-node and an out-node joined by a unit-capacity arc,
+// so a max flow through the split network uses each vertex at most once. If the graph
+// is a Digraph, paths follow arc direction. If either vertex is absent, or source and
+// sink are the same vertex, the result is zero.
+func VertexDisjointPaths(g gogl.Graph, source, sink gogl.Vertex) (int, []gogl.Path) {
+	if source == sink || !g.HasVertex(source) || !g.HasVertex(sink) {
+		return 0, nil
+	}
+
+	index, vertices := indexVertices(g)
+	n := splitNetwork(g, index, len(vertices))
+
+	s, t := 2*index[source]+1, 2*index[sink]
+	count := n.maxFlow(s, t, 0)
+	return count, n.paths(s, t, count)
+}
+
+// Builds the vertex-split network for the graph: vertex i becomes in-node 2i and out-node
+// 2i+1, and each edge becomes an arc from the out-node of one endpoint to the in-node of
+// the other (in both directions, for undirected graphs).
+func splitNetwork(g gogl.Graph, index map[gogl.Vertex]int, order int) *network {
+	n := newNetwork(2 * order)
+	for i := 0; i < order; i++ {
+		n.addArc(2*i, 2*i+1, 1, 0, nil)
+	}
+
+	_, directed := g.(gogl.Digraph)
+	eachEdge(g, index, func(u, v int, e gogl.Edge) {
+		n.addArc(2*u+1, 2*v, 1, 0, e)
+		if !directed {
+			n.addArc(2*v+1, 2*u, 1, 0, e)
+		}
+	})
+
+	return n
+}
@@ -0,0 +1,161 @@
+package flow
+
+import (
+	"testing"
+
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+// Hook gocheck into the go test runner
+func Test(t *testing.T) { TestingT(t) }
+
+type DisjointSuite struct{}
+
+var _ = Suite(&DisjointSuite{})
+
+// Asserts that the paths are valid in g, run from source to sink, and share no edge.
+func checkEdgeDisjoint(c *C, g gogl.Graph, source, sink gogl.Vertex, paths []gogl.Path) {
+	used := make(map[gogl.Edge]bool)
+	for _, p := range paths {
+		c.Assert(gogl.IsValidPath(g, p), Equals, true)
+		c.Assert(endsOf(p, source), Equals, sink)
+		for _, e := range p {
+			c.Assert(used[e], Equals, false)
+			used[e] = true
+		}
+	}
+}
+
+// Follows an undirected path from the given start, returning where it ends.
+func endsOf(p gogl.Path, start gogl.Vertex) gogl.Vertex {
+	at := start
+	for _, e := range p {
+		u, v := e.Both()
+		if u == at {
+			at = v
+		} else {
+			at = u
+		}
+	}
+	return at
+}
+
+func (s *DisjointSuite) TestTwoRoutes(c *C) {
+	// Two independent routes from s to t, plus a spur and a cross-link that can't add a
+	// third: t has degree two.
+	g := gogl.Spec().Using(gogl.EdgeList{
+		gogl.NewEdge("s", "a"),
+		gogl.NewEdge("a", "b"),
+		gogl.NewEdge("b", "t"),
+		gogl.NewEdge("s", "c"),
+		gogl.NewEdge("c", "d"),
+		gogl.NewEdge("d", "t"),
+		gogl.NewEdge("a", "d"),
+		gogl.NewEdge("s", "x"),
+	}).Create(al.G)
+
+	count, paths := EdgeDisjointPaths(g, "s", "t")
+	c.Assert(count, Equals, 2)
+	c.Assert(paths, HasLen, 2)
+	checkEdgeDisjoint(c, g, "s", "t", paths)
+}
+
+func (s *DisjointSuite) TestDirected(c *C) {
+	g := gogl.Spec().Directed().Using(gogl.ArcList{
+		gogl.NewArc(1, 2),
+		gogl.NewArc(2, 4),
+		gogl.NewArc(1, 3),
+		gogl.NewArc(3, 4),
+		gogl.NewArc(4, 1),
+	}).Create(al.G)
+
+	count, paths := EdgeDisjointPaths(g, 1, 4)
+	c.Assert(count, Equals, 2)
+	checkEdgeDisjoint(c, g, 1, 4, paths)
+
+	count, paths = EdgeDisjointPaths(g, 4, 1)
+	c.Assert(count, Equals, 1)
+	c.Assert(paths, DeepEquals, []gogl.Path{{gogl.NewArc(4, 1)}})
+}
+
+func (s *DisjointSuite) TestDegenerate(c *C) {
+	g := gogl.Spec().Using(gogl.WithIsolates(gogl.EdgeList{gogl.NewEdge(1, 2)}, 3)).Create(al.G)
+
+	count, paths := EdgeDisjointPaths(g, 1, 3)
+	c.Assert(count, Equals, 0)
+	c.Assert(paths, HasLen, 0)
+
+	count, _ = EdgeDisjointPaths(g, 1, 1)
+	c.Assert(count, Equals, 0)
+	count, _ = EdgeDisjointPaths(g, 1, 9)
+	c.Assert(count, Equals, 0)
+}
+
+func (s *DisjointSuite) TestVertexDisjoint(c *C) {
+	// Two triangles joined at a cut vertex m: there are two edge-disjoint routes from s to
+	// t, but both must pass through m.
+	g := gogl.Spec().Using(gogl.EdgeList{
+		gogl.NewEdge("s", "a"),
+		gogl.NewEdge("s", "b"),
+		gogl.NewEdge("a", "m"),
+		gogl.NewEdge("b", "m"),
+		gogl.NewEdge("m", "c"),
+		gogl.NewEdge("m", "d"),
+		gogl.NewEdge("c", "t"),
+		gogl.NewEdge("d", "t"),
+	}).Create(al.G)
+
+	ecount, _ := EdgeDisjointPaths(g, "s", "t")
+	c.Assert(ecount, Equals, 2)
+
+	vcount, paths := VertexDisjointPaths(g, "s", "t")
+	c.Assert(vcount, Equals, 1)
+	c.Assert(paths, HasLen, 1)
+	checkEdgeDisjoint(c, g, "s", "t", paths)
+
+	// Adding a bypass around m makes room for a second path.
+	m := gogl.Spec().Using(g).Create(al.G).(gogl.MutableGraph)
+	m.AddEdges(gogl.NewEdge("a", "c"))
+
+	vcount, paths = VertexDisjointPaths(m, "s", "t")
+	c.Assert(vcount, Equals, 2)
+	checkVertexDisjoint(c, m, "s", "t", paths)
+}
+
+func (s *DisjointSuite) TestVertexDisjointDirect(c *C) {
+	// The direct edge is a path with no intermediate vertices at all.
+	g := gogl.Spec().Using(gogl.EdgeList{
+		gogl.NewEdge(1, 2),
+		gogl.NewEdge(1, 3),
+		gogl.NewEdge(3, 2),
+		gogl.NewEdge(1, 4),
+		gogl.NewEdge(4, 3),
+	}).Create(al.G)
+
+	count, paths := VertexDisjointPaths(g, 1, 2)
+	c.Assert(count, Equals, 2)
+	checkVertexDisjoint(c, g, 1, 2, paths)
+}
+
+// Asserts that the paths are valid in g, run from source to sink, and share no
+// intermediate vertex.
+func checkVertexDisjoint(c *C, g gogl.Graph, source, sink gogl.Vertex, paths []gogl.Path) {
+	checkEdgeDisjoint(c, g, source, sink, paths)
+
+	used := make(map[gogl.Vertex]bool)
+	for _, p := range paths {
+		at := source
+		for _, e := range p[:len(p)-1] {
+			u, v := e.Both()
+			if u == at {
+				at = v
+			} else {
+				at = u
+			}
+			c.Assert(used[at], Equals, false)
+			used[at] = true
+		}
+	}
+}
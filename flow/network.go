@@ -0,0 +1,243 @@
+// Contains algos for network flow, and the disjoint path and connectivity measures
+// derived from it.
+package flow
+
+import (
+	"math"
+
+	"github.com/sdboyer/gogl"
+)
+
+// An arc in a residual network. Arcs are stored in pairs, so the residual partner of
+// arc i is always arc i^1. edge is the graph edge the arc derives from, if any. cost is
+// per unit of flow, and is only used by minCostFlow.
+type narc struct {
+	to, cap, orig int
+	cost          float64
+	edge          gogl.Edge
+}
+
+// A residual network over int-numbered nodes, used for computing integral max flows.
+type network struct {
+	adj  [][]int
+	arcs []narc
+}
+
+func newNetwork(nodes int) *network {
+	return &network{adj: make([][]int, nodes)}
+}
+
+// Adds an arc from u to v with the given capacity, paired with an arc from v to u with
+// the given reverse capacity. An undirected edge is modeled by giving both the same
+// capacity; a directed one by a reverse capacity of zero.
+func (n *network) addArc(u, v, cap, revcap int, e gogl.Edge) {
+	n.adj[u] = append(n.adj[u], len(n.arcs))
+	n.arcs = append(n.arcs, narc{to: v, cap: cap, orig: cap, edge: e})
+	n.adj[v] = append(n.adj[v], len(n.arcs))
+	n.arcs = append(n.arcs, narc{to: u, cap: revcap, orig: revcap, edge: e})
+}
+
+// Adds an arc from u to v with the given capacity and per-unit cost, paired with a
+// residual arc from v to u of zero capacity and opposing cost.
+func (n *network) addCostArc(u, v, cap int, cost float64, e gogl.Edge) {
+	n.adj[u] = append(n.adj[u], len(n.arcs))
+	n.arcs = append(n.arcs, narc{to: v, cap: cap, orig: cap, cost: cost, edge: e})
+	n.adj[v] = append(n.adj[v], len(n.arcs))
+	n.arcs = append(n.arcs, narc{to: u, cost: -cost, edge: e})
+}
+
+// Pushes as much flow as possible from s to t, at minimum total cost among all maximum
+// flows, by successive shortest (cheapest) augmenting paths. Costs may be negative, but
+// the network must initially contain no negative-cost cycle. Paths are found with
+// Bellman-Ford, so this is O(F * V * E). Returns the amount of flow pushed.
+func (n *network) minCostFlow(s, t int) (flow int) {
+	if s == t {
+		return 0
+	}
+
+	dist := make([]float64, len(n.adj))
+	via := make([]int, len(n.adj))
+	for {
+		for k := range dist {
+			dist[k], via[k] = math.Inf(1), -1
+		}
+		dist[s] = 0
+
+		for round := 1; round < len(n.adj); round++ {
+			changed := false
+			for u := range n.adj {
+				if math.IsInf(dist[u], 1) {
+					continue
+				}
+				for _, i := range n.adj[u] {
+					a := n.arcs[i]
+					if a.cap > 0 && dist[u]+a.cost < dist[a.to] {
+						dist[a.to] = dist[u] + a.cost
+						via[a.to] = i
+						changed = true
+					}
+				}
+			}
+			if !changed {
+				break
+			}
+		}
+
+		if via[t] == -1 {
+			return
+		}
+
+		push := -1
+		for v := t; v != s; v = n.arcs[via[v]^1].to {
+			if c := n.arcs[via[v]].cap; push == -1 || c < push {
+				push = c
+			}
+		}
+		for v := t; v != s; v = n.arcs[via[v]^1].to {
+			n.arcs[via[v]].cap -= push
+			n.arcs[via[v]^1].cap += push
+		}
+		flow += push
+	}
+}
+
+// Pushes flow from s to t along shortest augmenting paths until no more can be pushed,
+// or until at least limit units are flowing. A limit of zero or less means no limit.
+// Returns the amount of flow pushed.
+func (n *network) maxFlow(s, t, limit int) (flow int) {
+	if s == t {
+		return 0
+	}
+
+	via := make([]int, len(n.adj))
+	for limit <= 0 || flow < limit {
+		for k := range via {
+			via[k] = -1
+		}
+
+		queue := []int{s}
+		for len(queue) > 0 && via[t] == -1 {
+			u := queue[0]
+			queue = queue[1:]
+			for _, i := range n.adj[u] {
+				a := n.arcs[i]
+				if a.cap > 0 && a.to != s && via[a.to] == -1 {
+					via[a.to] = i
+					queue = append(queue, a.to)
+				}
+			}
+		}
+
+		if via[t] == -1 {
+			return
+		}
+
+		push := -1
+		for v := t; v != s; v = n.arcs[via[v]^1].to {
+			if c := n.arcs[via[v]].cap; push == -1 || c < push {
+				push = c
+			}
+		}
+		for v := t; v != s; v = n.arcs[via[v]^1].to {
+			n.arcs[via[v]].cap -= push
+			n.arcs[via[v]^1].cap += push
+		}
+		flow += push
+	}
+
+	return
+}
+
+// Clears all flow from the network, restoring every arc to its original capacity.
+func (n *network) reset() {
+	for i := range n.arcs {
+		n.arcs[i].cap = n.arcs[i].orig
+	}
+}
+
+// Indicates whether arc i carries positive flow in its own direction.
+func (n *network) flowing(i int) bool {
+	return n.arcs[i].cap < n.arcs[i].orig
+}
+
+// Decomposes the current flow from s to t into count paths, returning the graph edges
+// along each. Any flow circulating in cycles is discarded along the way.
+func (n *network) paths(s, t, count int) []gogl.Path {
+	paths := make([]gogl.Path, 0, count)
+
+	for k := 0; k < count; k++ {
+		// Tracks the position of each node on the current walk, for erasing cycles.
+		pos := map[int]int{s: 0}
+		nodes := []int{s}
+		var arcs []int
+
+		for u := s; u != t; {
+			next := -1
+			for _, i := range n.adj[u] {
+				if n.flowing(i) {
+					next = i
+					break
+				}
+			}
+			if next == -1 {
+				// Flow conservation guarantees this is unreachable.
+				panic("Flow decomposition stranded at a node with no outflow.")
+			}
+
+			n.arcs[next].cap++
+			n.arcs[next^1].cap--
+			u = n.arcs[next].to
+
+			if p, seen := pos[u]; seen {
+				for _, v := range nodes[p+1:] {
+					delete(pos, v)
+				}
+				nodes, arcs = nodes[:p+1], arcs[:p]
+				continue
+			}
+
+			pos[u] = len(nodes)
+			nodes = append(nodes, u)
+			arcs = append(arcs, next)
+		}
+
+		var p gogl.Path
+		for _, i := range arcs {
+			if e := n.arcs[i].edge; e != nil {
+				p = append(p, e)
+			}
+		}
+		paths = append(paths, p)
+	}
+
+	return paths
+}
+
+// Assigns each vertex in the graph an int, in sorted order so results are reproducible.
+func indexVertices(g gogl.Graph) (index map[gogl.Vertex]int, vertices []gogl.Vertex) {
+	index = make(map[gogl.Vertex]int)
+	gogl.VerticesSorted(g, func(v gogl.Vertex) (terminate bool) {
+		index[v] = len(vertices)
+		vertices = append(vertices, v)
+		return
+	})
+	return
+}
+
+// Calls f with the endpoint indices of each non-loop edge in the graph, along with the
+// edge itself. For digraphs, the edges are the graph's arcs.
+func eachEdge(g gogl.Graph, index map[gogl.Vertex]int, f func(u, v int, e gogl.Edge)) {
+	step := func(e gogl.Edge) (terminate bool) {
+		u, v := e.Both()
+		if u != v {
+			f(index[u], index[v], e)
+		}
+		return
+	}
+
+	if dg, ok := g.(gogl.Digraph); ok {
+		dg.Arcs(func(a gogl.Arc) bool { return step(a) })
+	} else {
+		g.Edges(step)
+	}
+}
@@ -0,0 +1,179 @@
+// Contains helpers for testing code that produces gogl graphs.
+package gogltest
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/sdboyer/gogl"
+)
+
+// Fails the test if the two graphs are not equal (per gogl.Equal), reporting a readable
+// diff of the vertices and edges that are missing from got or extra in it.
+func AssertGraphEqual(t testing.TB, want, got gogl.Graph) {
+	t.Helper()
+	if d := Diff(want, got); d != "" {
+		t.Errorf("Graphs are not equal:\n%s", d)
+	}
+}
+
+// As AssertGraphEqual, but disregards isolated vertices in either graph (per
+// gogl.EqualIgnoringIsolated).
+func AssertGraphEqualIgnoringIsolated(t testing.TB, want, got gogl.Graph) {
+	t.Helper()
+	if d := DiffIgnoringIsolated(want, got); d != "" {
+		t.Errorf("Graphs are not equal:\n%s", d)
+	}
+}
+
+// Describes the differences between two graphs, one per line, or returns the empty string
+// if they are equal. Lines are prefixed with "-" for elements of want that are missing
+// from got, and "+" for elements of got that are absent from want. Vertices are listed
+// before edges, and each group is sorted, so the output is deterministic.
+//
+// Edges are rendered as "u -> v" in digraphs and "u -- v" otherwise, with the endpoints
+// of undirected edges in their natural order (per gogl.VertexLess). Weights and labels
+// are appended when both graphs carry them, and count towards equality as in gogl.Equal.
+func Diff(want, got gogl.Graph) string {
+	return diff(want, got, func(gogl.Graph, gogl.Vertex) bool {
+		return true
+	})
+}
+
+// As Diff, but disregards isolated vertices in either graph.
+func DiffIgnoringIsolated(want, got gogl.Graph) string {
+	return diff(want, got, func(g gogl.Graph, v gogl.Vertex) bool {
+		deg, _ := g.DegreeOf(v)
+		return deg > 0
+	})
+}
+
+// Shared logic for diffing. Only those vertices for which the include func returns true
+// are compared.
+func diff(want, got gogl.Graph, include func(gogl.Graph, gogl.Vertex) bool) string {
+	_, wdir := want.(gogl.Digraph)
+	_, gdir := got.(gogl.Digraph)
+	if wdir != gdir {
+		return fmt.Sprintf("directedness: want %v, got %v\n", wdir, gdir)
+	}
+
+	var lines []string
+	vertices := func(a, b gogl.Graph, prefix string) {
+		var vs []string
+		gogl.VerticesSorted(a, func(v gogl.Vertex) (terminate bool) {
+			if include(a, v) && !b.HasVertex(v) {
+				vs = append(vs, fmt.Sprintf("%s vertex %v", prefix, v))
+			}
+			return
+		})
+		lines = append(lines, vs...)
+	}
+	vertices(want, got, "-")
+	vertices(got, want, "+")
+
+	edges := func(a, b gogl.Graph, prefix string) {
+		var es []string
+		eachEdge(a, func(e gogl.Edge) {
+			if !hasEdge(b, a, e) {
+				es = append(es, fmt.Sprintf("%s edge %s", prefix, format(e, a, b)))
+			}
+		})
+		sort.Strings(es)
+		lines = append(lines, es...)
+	}
+	edges(want, got, "-")
+	edges(got, want, "+")
+
+	if len(lines) == 0 {
+		return ""
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
+
+// Enumerates a graph's edges, as arcs if it is a Digraph.
+func eachEdge(g gogl.Graph, f func(gogl.Edge)) {
+	if dg, ok := g.(gogl.Digraph); ok {
+		dg.Arcs(func(a gogl.Arc) (terminate bool) {
+			f(a)
+			return
+		})
+	} else {
+		g.Edges(func(e gogl.Edge) (terminate bool) {
+			f(e)
+			return
+		})
+	}
+}
+
+// Indicates whether g contains the given edge from src, taking weights or labels into
+// account if both graphs carry them.
+func hasEdge(g, src gogl.Graph, e gogl.Edge) bool {
+	if dg, ok := g.(gogl.Digraph); ok {
+		switch {
+		case weighted(g, src):
+			return g.(gogl.WeightedDigraph).HasWeightedArc(e.(gogl.WeightedArc))
+		case labeled(g, src):
+			return g.(gogl.LabeledDigraph).HasLabeledArc(e.(gogl.LabeledArc))
+		}
+		return dg.HasArc(e.(gogl.Arc))
+	}
+
+	switch {
+	case weighted(g, src):
+		return g.(gogl.WeightedGraph).HasWeightedEdge(e.(gogl.WeightedEdge))
+	case labeled(g, src):
+		return g.(gogl.LabeledGraph).HasLabeledEdge(e.(gogl.LabeledEdge))
+	}
+	return g.HasEdge(e)
+}
+
+// Indicates whether both graphs are weighted. The graphs must agree on directedness.
+func weighted(a, b gogl.Graph) bool {
+	if isDirected(a) {
+		_, wa := a.(gogl.WeightedDigraph)
+		_, wb := b.(gogl.WeightedDigraph)
+		return wa && wb
+	}
+	return gogl.CanWeight(a) && gogl.CanWeight(b)
+}
+
+// Indicates whether both graphs are labeled. The graphs must agree on directedness.
+func labeled(a, b gogl.Graph) bool {
+	if isDirected(a) {
+		_, la := a.(gogl.LabeledDigraph)
+		_, lb := b.(gogl.LabeledDigraph)
+		return la && lb
+	}
+	_, la := a.(gogl.LabeledGraph)
+	_, lb := b.(gogl.LabeledGraph)
+	return la && lb
+}
+
+// Renders an edge for a diff line.
+func format(e gogl.Edge, a, b gogl.Graph) string {
+	var s string
+	if arc, ok := e.(gogl.Arc); ok && isDirected(a) {
+		s = fmt.Sprintf("%v -> %v", arc.Source(), arc.Target())
+	} else {
+		u, v := e.Both()
+		if gogl.VertexLess(v, u) {
+			u, v = v, u
+		}
+		s = fmt.Sprintf("%v -- %v", u, v)
+	}
+
+	switch {
+	case weighted(a, b):
+		s += fmt.Sprintf(" (weight %v)", e.(gogl.WeightedEdge).Weight())
+	case labeled(a, b):
+		s += fmt.Sprintf(" (label %q)", e.(gogl.LabeledEdge).Label())
+	}
+	return s
+}
+
+func isDirected(g gogl.Graph) bool {
+	_, ok := g.(gogl.Digraph)
+	return ok
+}
@@ -0,0 +1,81 @@
+package gogltest
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+// Hook gocheck into the go test runner
+func Test(t *testing.T) { TestingT(t) }
+
+// Records failures rather than reporting them.
+type fakeT struct {
+	testing.TB
+	errors []string
+}
+
+func (t *fakeT) Helper() {}
+
+func (t *fakeT) Errorf(format string, args ...interface{}) {
+	t.errors = append(t.errors, fmt.Sprintf(format, args...))
+}
+
+type DiffSuite struct{}
+
+var _ = Suite(&DiffSuite{})
+
+func (s *DiffSuite) TestOneEdge(c *C) {
+	want := gogl.Spec().Using(gogl.EdgeList{
+		gogl.NewEdge("a", "b"),
+		gogl.NewEdge("b", "c"),
+	}).Create(al.G)
+	got := gogl.Spec().Using(gogl.EdgeList{
+		gogl.NewEdge("a", "b"),
+		gogl.NewEdge("c", "a"),
+		gogl.NewEdge("b", "c"),
+		gogl.NewEdge("c", "d"),
+	}).Create(al.G)
+
+	t := &fakeT{}
+	AssertGraphEqual(t, want, want)
+	c.Assert(t.errors, HasLen, 0)
+
+	AssertGraphEqual(t, want, got)
+	c.Assert(t.errors, DeepEquals, []string{"Graphs are not equal:\n+ vertex d\n+ edge a -- c\n+ edge c -- d\n"})
+
+	c.Assert(Diff(got, want), Equals, "- vertex d\n- edge a -- c\n- edge c -- d\n")
+}
+
+func (s *DiffSuite) TestDirectedWeighted(c *C) {
+	want := gogl.Spec().Directed().Weighted().Using(gogl.WeightedArcList{
+		gogl.NewWeightedArc(1, 2, 1.5),
+		gogl.NewWeightedArc(2, 3, 2),
+	}).Create(al.G)
+	got := gogl.Spec().Directed().Weighted().Using(gogl.WeightedArcList{
+		gogl.NewWeightedArc(1, 2, 1.5),
+		gogl.NewWeightedArc(3, 2, 2),
+	}).Create(al.G)
+
+	c.Assert(Diff(want, got), Equals, "- edge 2 -> 3 (weight 2)\n+ edge 3 -> 2 (weight 2)\n")
+
+	undirected := gogl.Spec().Weighted().Using(gogl.WeightedEdgeList{
+		gogl.NewWeightedEdge(1, 2, 1.5),
+	}).Create(al.G)
+	c.Assert(Diff(want, undirected), Equals, "directedness: want true, got false\n")
+}
+
+func (s *DiffSuite) TestIgnoringIsolated(c *C) {
+	want := gogl.Spec().Using(gogl.EdgeList{gogl.NewEdge(1, 2)}).Create(al.G)
+	got := gogl.Spec().Using(gogl.WithIsolates(gogl.EdgeList{gogl.NewEdge(1, 2)}, 3)).Create(al.G)
+
+	c.Assert(Diff(want, got), Equals, "+ vertex 3\n")
+	c.Assert(DiffIgnoringIsolated(want, got), Equals, "")
+
+	t := &fakeT{}
+	AssertGraphEqualIgnoringIsolated(t, want, got)
+	c.Assert(t.errors, HasLen, 0)
+}
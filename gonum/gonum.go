@@ -0,0 +1,168 @@
+// Contains adapters for converting between gogl graphs and gonum graphs.
+//
+// This lives in its own package so that only users who need gonum interop take on
+// the dependency.
+package gonum
+
+import (
+	"math"
+
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+// An Index records the bijection between gogl vertices and the int64 node ids that
+// gonum requires.
+type Index struct {
+	ids      map[gogl.Vertex]int64
+	vertices []gogl.Vertex
+}
+
+// Returns the gonum node id assigned to the given vertex, if any.
+func (idx *Index) ID(v gogl.Vertex) (id int64, exists bool) {
+	id, exists = idx.ids[v]
+	return
+}
+
+// Returns the vertex to which the given gonum node id was assigned, if any.
+func (idx *Index) Vertex(id int64) (v gogl.Vertex, exists bool) {
+	if id < 0 || id >= int64(len(idx.vertices)) {
+		return nil, false
+	}
+	return idx.vertices[id], true
+}
+
+// ToGonum converts a gogl graph into an equivalent gonum graph, along with the Index
+// mapping its vertices to gonum node ids.
+//
+// Directedness is preserved, and if the input is a WeightedGraph, so are the weights.
+// The gonum graphs produced are simple, so loops are dropped.
+func ToGonum(g gogl.Graph) (graph.Graph, *Index) {
+	idx := &Index{ids: make(map[gogl.Vertex]int64)}
+	g.Vertices(func(v gogl.Vertex) (terminate bool) {
+		idx.ids[v] = int64(len(idx.vertices))
+		idx.vertices = append(idx.vertices, v)
+		return
+	})
+
+	if _, weighted := g.(gogl.WeightedGraph); weighted {
+		var b interface {
+			graph.Graph
+			graph.WeightedBuilder
+		}
+		if g.IsDirected() {
+			b = simple.NewWeightedDirectedGraph(0, math.Inf(1))
+		} else {
+			b = simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+		}
+
+		idx.addNodes(b)
+		idx.eachEdge(g, func(e gogl.Edge, from, to graph.Node) {
+			b.SetWeightedEdge(simple.WeightedEdge{F: from, T: to, W: e.(gogl.WeightedEdge).Weight()})
+		})
+		return b, idx
+	}
+
+	var b interface {
+		graph.Graph
+		graph.Builder
+	}
+	if g.IsDirected() {
+		b = simple.NewDirectedGraph()
+	} else {
+		b = simple.NewUndirectedGraph()
+	}
+
+	idx.addNodes(b)
+	idx.eachEdge(g, func(e gogl.Edge, from, to graph.Node) {
+		b.SetEdge(simple.Edge{F: from, T: to})
+	})
+	return b, idx
+}
+
+func (idx *Index) addNodes(b graph.NodeAdder) {
+	for id := range idx.vertices {
+		b.AddNode(simple.Node(id))
+	}
+}
+
+// Calls the given func for each of the graph's edges, along with its endpoints as
+// gonum nodes. Loops are skipped, as simple gonum graphs reject them.
+func (idx *Index) eachEdge(g gogl.Graph, f func(e gogl.Edge, from, to graph.Node)) {
+	g.Edges(func(e gogl.Edge) (terminate bool) {
+		u, v := e.Both()
+		if u != v {
+			f(e, simple.Node(idx.ids[u]), simple.Node(idx.ids[v]))
+		}
+		return
+	})
+}
+
+// FromGonum converts a gonum graph into an equivalent gogl graph.
+//
+// If an Index is provided, node ids are translated back into the vertices they were
+// assigned from; otherwise, the int64 node ids themselves are used as vertices. Graphs
+// implementing graph.Directed produce digraphs, and graphs implementing graph.Weighted
+// produce weighted graphs. Isolated nodes are preserved.
+func FromGonum(g graph.Graph, idx *Index) gogl.Graph {
+	vertex := func(n graph.Node) gogl.Vertex {
+		if idx != nil {
+			if v, exists := idx.Vertex(n.ID()); exists {
+				return v
+			}
+		}
+		return n.ID()
+	}
+
+	_, directed := g.(graph.Directed)
+	wg, weighted := g.(graph.Weighted)
+
+	var vertices []gogl.Vertex
+	var el gogl.EdgeList
+	var arcs gogl.ArcList
+	var wel gogl.WeightedEdgeList
+	var wal gogl.WeightedArcList
+
+	nodes := g.Nodes()
+	for nodes.Next() {
+		u := nodes.Node()
+		vertices = append(vertices, vertex(u))
+
+		succ := g.From(u.ID())
+		for succ.Next() {
+			v := succ.Node()
+			// undirected graphs report each edge from both ends
+			if !directed && v.ID() < u.ID() {
+				continue
+			}
+
+			switch {
+			case directed && weighted:
+				wal = append(wal, gogl.NewWeightedArc(vertex(u), vertex(v), wg.WeightedEdge(u.ID(), v.ID()).Weight()))
+			case directed:
+				arcs = append(arcs, gogl.NewArc(vertex(u), vertex(v)))
+			case weighted:
+				wel = append(wel, gogl.NewWeightedEdge(vertex(u), vertex(v), wg.WeightedEdge(u.ID(), v.ID()).Weight()))
+			default:
+				el = append(el, gogl.NewEdge(vertex(u), vertex(v)))
+			}
+		}
+	}
+
+	spec := gogl.Spec()
+	var src gogl.GraphSource
+	switch {
+	case directed && weighted:
+		spec, src = spec.Directed().Weighted(), wal
+	case directed:
+		spec, src = spec.Directed(), arcs
+	case weighted:
+		spec, src = spec.Weighted(), wel
+	default:
+		src = el
+	}
+
+	return spec.Using(gogl.WithIsolates(src, vertices...)).Create(al.G)
+}
@@ -0,0 +1,75 @@
+package gonum
+
+import (
+	"testing"
+
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+	"gonum.org/v1/gonum/graph"
+)
+
+// Hook gocheck into the go test runner
+func TestHookup(t *testing.T) { TestingT(t) }
+
+type GonumSuite struct{}
+
+var _ = Suite(&GonumSuite{})
+
+var wdg = gogl.Spec().Directed().Weighted().
+	Using(gogl.WithIsolates(gogl.WeightedArcList{
+		gogl.NewWeightedArc("foo", "bar", 1.5),
+		gogl.NewWeightedArc("bar", "foo", -2),
+		gogl.NewWeightedArc("bar", "baz", 3),
+	}, "qux")).
+	Create(al.G).(gogl.WeightedDigraph)
+
+func (s *GonumSuite) TestToGonum(c *C) {
+	g, idx := ToGonum(wdg)
+
+	dg, ok := g.(graph.WeightedDirected)
+	c.Assert(ok, Equals, true)
+	c.Assert(g.Nodes().Len(), Equals, 4)
+
+	foo, _ := idx.ID("foo")
+	bar, _ := idx.ID("bar")
+	c.Assert(dg.HasEdgeFromTo(foo, bar), Equals, true)
+	w, _ := dg.Weight(bar, foo)
+	c.Assert(w, Equals, -2.0)
+
+	_, exists := idx.ID("quark")
+	c.Assert(exists, Equals, false)
+}
+
+func (s *GonumSuite) TestRoundTrip(c *C) {
+	g, idx := ToGonum(wdg)
+	back := FromGonum(g, idx)
+
+	c.Assert(gogl.Order(back), Equals, 4)
+	c.Assert(gogl.Size(back), Equals, 3)
+	c.Assert(gogl.Equal(back, wdg), Equals, true)
+
+	// without an index, the gonum ids become the vertices
+	raw := FromGonum(g, nil)
+	c.Assert(gogl.Order(raw), Equals, 4)
+	foo, _ := idx.ID("foo")
+	c.Assert(raw.HasVertex(foo), Equals, true)
+}
+
+func (s *GonumSuite) TestUndirected(c *C) {
+	ug := gogl.Spec().Using(gogl.EdgeList{
+		gogl.NewEdge(1, 2),
+		gogl.NewEdge(2, 3),
+		gogl.NewEdge(3, 3),
+	}).Create(al.G)
+
+	g, idx := ToGonum(ug)
+	_, ok := g.(graph.Undirected)
+	c.Assert(ok, Equals, true)
+
+	back := FromGonum(g, idx)
+	c.Assert(back.IsDirected(), Equals, false)
+	c.Assert(gogl.Order(back), Equals, 3)
+	// the loop does not survive the trip through a simple gonum graph
+	c.Assert(gogl.Size(back), Equals, 2)
+}
@@ -40,6 +40,7 @@ type Graph interface {
 	VertexMembershipChecker // Allows inspection of contained vertices
 	EdgeMembershipChecker   // Allows inspection of contained edges
 	DegreeChecker           // Reports degree of vertices
+	DirectednessChecker     // Reports whether or not edges are directed
 }
 
 // GraphSource is a subset of Graph, describing the minimal set of methods
@@ -52,7 +53,9 @@ type GraphSource interface {
 // Digraph (directed graph) describes a Graph where all the edges are directed.
 //
 // gogl treats edge directionality as a property of the graph, not the edge itself.
-// Thus, implementing this interface is gogl's only signal that a graph's edges are directed.
+// Thus, implementing this interface is gogl's signal that a graph's edges are directed.
+// Every Graph also reports the same via IsDirected(), which allows branching on
+// directedness without a type assertion.
 type Digraph interface {
 	Graph
 	ArcEnumerator         // Enumerates all arcs to an injected step function
@@ -93,6 +96,16 @@ type SimpleGraph interface {
 	Density() float64
 }
 
+// A multigraph permits parallel edges: more than one edge connecting the same pair of
+// vertices (or, in a digraph, more than one arc in the same direction). Implementing this
+// interface signals that a pair of vertices does not identify a single edge, and that
+// enumerators may report several edges between them.
+type Multigraph interface {
+	Graph
+	// Returns the number of edges connecting the endpoints of the given edge.
+	Multiplicity(Edge) int
+}
+
 // A weighted graph is a graph subtype where the edges have a numeric weight;
 // as described by the WeightedEdge interface, this weight is a signed int.
 //
@@ -127,6 +140,15 @@ type MutableWeightedGraph interface {
 	WeightedEdgeSetMutator
 }
 
+// MutableWeightedDigraph is the mutable version of a weighted digraph. Its
+// AddArcs() method is incompatible with MutableDigraph, guaranteeing
+// only weighted arcs can be present in the graph.
+type MutableWeightedDigraph interface {
+	WeightedDigraph
+	VertexSetMutator
+	WeightedArcSetMutator
+}
+
 // A labeled graph is a graph subtype where the edges have an identifier;
 // as described by the LabeledEdge interface, this identifier is a string.
 //
@@ -283,6 +305,13 @@ type DegreeChecker interface {
 	DegreeOf(Vertex) (degree int, exists bool) // Number of incident edges; if vertex is present
 }
 
+// A DirectednessChecker reports whether or not a graph's edges are directed.
+type DirectednessChecker interface {
+	// Returns true iff the graph's edges are directed - that is, iff the graph
+	// implements Digraph.
+	IsDirected() bool
+}
+
 // A DirectedDegreeChecker reports the number of in or out-edges incident to given vertex.
 type DirectedDegreeChecker interface {
 	InDegreeOf(Vertex) (degree int, exists bool)  // Number of in-edges; if vertex is present
@@ -301,13 +330,17 @@ type ArcMembershipChecker interface {
 
 // A VertexSetMutator allows the addition and removal of vertices from a set.
 type VertexSetMutator interface {
-	// Ensures the provided vertices are present in the graph.
+	// Ensures the provided vertices are present in the graph. nil is not a
+	// valid vertex, and is ignored.
 	EnsureVertex(...Vertex)
 	// Removes the provided vertices from the graph, if present.
 	RemoveVertex(...Vertex)
 }
 
 // An EdgeSetMutator allows the addition and removal of edges from a set.
+//
+// Edges with a nil endpoint are not valid, and are skipped by AddEdges. The same
+// holds for all the other edge and arc mutators.
 type EdgeSetMutator interface {
 	AddEdges(edges ...Edge)
 	RemoveEdges(edges ...Edge)
@@ -381,6 +414,45 @@ type EdgeCounter interface {
 	Size() int
 }
 
+// A BulkEdgeMembershipChecker can indicate the presence of many edges at once, typically
+// more cheaply than by repeated calls to HasEdge (e.g., by taking a lock only once).
+//
+// The returned slice is positionally aligned with the provided edges.
+type BulkEdgeMembershipChecker interface {
+	HasEdges(...Edge) []bool
+}
+
+// A Compacter can rebuild its internal structures sized tightly to its current contents,
+// releasing memory retained after vertices and edges have been removed.
+type Compacter interface {
+	Compact()
+}
+
+// A TryMutator offers non-blocking mutation, for latency-sensitive writers that would
+// rather back off than wait for in-progress traversals to finish.
+//
+// TryMutate returns false immediately, without calling the provided func, if any reader
+// or writer currently holds the graph. Otherwise, it calls the func with exclusive access
+// to the graph for its duration, and returns true. The func is passed a view of the
+// graph, of the same kind, through which all reads and writes must be made; calling the
+// graph's own methods from within the func will deadlock. The view must not be retained
+// after the func returns.
+type TryMutator interface {
+	TryMutate(func(Graph)) bool
+}
+
+// A WeightAdjuster can add to the weight of an existing edge in a single atomic step, for
+// accumulating weights from many goroutines without a read-modify-write race. See the
+// AdjustWeight functor.
+type WeightAdjuster interface {
+	// Adds delta to the weight of the edge from u to v, returning the new weight. In an
+	// undirected graph, the edge may be given in either orientation. If there is no such
+	// edge, the graph is untouched and false is returned. Implementations that cannot
+	// store NaN weights likewise leave the graph untouched and return false if the new
+	// weight would be NaN, returning the edge's unchanged weight.
+	AdjustWeight(u, v Vertex, delta float64) (weight float64, exists bool)
+}
+
 // A Transposer produces a transposed version of a Digraph.
 type Transposer interface {
 	Transpose() Digraph
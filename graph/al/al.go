@@ -100,9 +100,8 @@ func (g *al_basic) Size() int {
 // already present in the graph, it is a no-op (for that vertex only).
 func (g *al_basic) ensureVertex(vertices ...Vertex) {
 	for _, vertex := range vertices {
-		if !g.hasVertex(vertex) {
-			// TODO experiment with different lengths...possibly by analyzing existing density?
-			g.list[vertex] = make(map[Vertex]struct{}, 10)
+		if vertex != nil && !g.hasVertex(vertex) {
+			g.list[vertex] = make(map[Vertex]struct{}, adjacencyCapacity(g.size, len(g.list)))
 		}
 	}
 
@@ -304,3 +304,45 @@ func eachEdgeIncidentToDirected(g al_digraph, v Vertex, f EdgeStep) {
 	g.ArcsFrom(v, interloper)
 	g.ArcsTo(v, interloper)
 }
+
+// Indicates whether either of the given edge's endpoints is nil. nil is not a valid
+// vertex, so edges with a nil endpoint are discarded by all adjacency list adders.
+func hasNilEndpoint(e Edge) bool {
+	u, v := e.Both()
+	return u == nil || v == nil
+}
+
+// Checks each of the given edges for presence in the adjacency list, in either direction.
+func hasEdgesInAdjacencyList(list interface{}, edges []Edge) []bool {
+	has := make([]bool, len(edges))
+
+	for k, e := range edges {
+		u, v := e.Both()
+		switch l := list.(type) {
+		case map[Vertex]map[Vertex]struct{}:
+			_, has[k] = l[u][v]
+			if !has[k] {
+				_, has[k] = l[v][u]
+			}
+		case map[Vertex]map[Vertex]float64:
+			_, has[k] = l[u][v]
+			if !has[k] {
+				_, has[k] = l[v][u]
+			}
+		case map[Vertex]map[Vertex]string:
+			_, has[k] = l[u][v]
+			if !has[k] {
+				_, has[k] = l[v][u]
+			}
+		case map[Vertex]map[Vertex]interface{}:
+			_, has[k] = l[u][v]
+			if !has[k] {
+				_, has[k] = l[v][u]
+			}
+		default:
+			panic("Unrecognized adjacency list map type.")
+		}
+	}
+
+	return has
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/sdboyer/gocheck"
+	. "github.com/sdboyer/gogl"
 	"github.com/sdboyer/gogl/spec"
 )
 
@@ -14,4 +15,5 @@ func init() {
 	for gp := range alCreators {
 		spec.SetUpTestsFromSpec(gp, G)
 	}
+	spec.SetUpTestsFromSpec(GraphProperties(G_MUTABLE|G_DIRECTED|G_WEIGHTED|G_SIMPLE), GIndexed)
 }
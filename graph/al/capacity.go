@@ -0,0 +1,38 @@
+package al
+
+import "sync/atomic"
+
+// The initial capacity of a new vertex's adjacency map when its graph has no edges.
+const defaultAdjacencyCapacity = 10
+
+// An explicit initial adjacency map capacity set via SetDefaultAdjacencyCapacity, or 0
+// for the adaptive heuristic.
+var fixedAdjacencyCapacity atomic.Int64
+
+// Sets the initial capacity of the adjacency map allocated for each new vertex, across
+// all adjacency list graphs. Maps grow as needed regardless; a good initial capacity
+// just avoids the cost of rehashing them as they fill.
+//
+// By default (or after calling this with n <= 0), the capacity is chosen adaptively from
+// the density of the graph the vertex is being added to: its current average degree,
+// 2*Size/Order, on the assumption that new vertices will come to resemble existing ones.
+// For digraphs, which keep only out-arcs in these maps, this overestimates by up to a
+// factor of two. A graph with no edges yet falls back to a small constant.
+func SetDefaultAdjacencyCapacity(n int) {
+	if n < 0 {
+		n = 0
+	}
+	fixedAdjacencyCapacity.Store(int64(n))
+}
+
+// Returns the initial capacity for a new adjacency map in a graph of the given size and
+// order, per SetDefaultAdjacencyCapacity.
+func adjacencyCapacity(size, order int) int {
+	if n := fixedAdjacencyCapacity.Load(); n > 0 {
+		return int(n)
+	}
+	if size == 0 || order == 0 {
+		return defaultAdjacencyCapacity
+	}
+	return 2 * size / order
+}
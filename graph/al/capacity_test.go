@@ -0,0 +1,68 @@
+package al
+
+import (
+	"math/rand"
+	"testing"
+
+	. "github.com/sdboyer/gocheck"
+	. "github.com/sdboyer/gogl"
+)
+
+type CapacitySuite struct{}
+
+var _ = Suite(&CapacitySuite{})
+
+func (s *CapacitySuite) TestHeuristic(c *C) {
+	c.Assert(adjacencyCapacity(0, 0), Equals, defaultAdjacencyCapacity)
+	c.Assert(adjacencyCapacity(0, 50), Equals, defaultAdjacencyCapacity)
+	c.Assert(adjacencyCapacity(500, 100), Equals, 10)
+	c.Assert(adjacencyCapacity(5000, 100), Equals, 100)
+
+	SetDefaultAdjacencyCapacity(3)
+	c.Assert(adjacencyCapacity(5000, 100), Equals, 3)
+	SetDefaultAdjacencyCapacity(-1)
+	c.Assert(adjacencyCapacity(5000, 100), Equals, 100)
+}
+
+func (s *CapacitySuite) TestContentsUnaffected(c *C) {
+	build := func() Graph {
+		g := Spec().Weighted().Create(G).(MutableWeightedGraph)
+		r := rand.New(rand.NewSource(1))
+		for i := 0; i < 2000; i++ {
+			g.AddEdges(NewWeightedEdge(r.Intn(100), r.Intn(100), float64(i)))
+		}
+		return g
+	}
+
+	adaptive := build()
+	SetDefaultAdjacencyCapacity(1)
+	fixed := build()
+	SetDefaultAdjacencyCapacity(0)
+
+	c.Assert(Equal(adaptive, fixed), Equals, true)
+}
+
+// Grows a dense graph one vertex at a time, connecting each new vertex to many of the
+// existing ones, so that most adjacency maps are allocated once the graph is dense.
+func benchmarkDenseInsert(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		r := rand.New(rand.NewSource(1))
+		g := Spec().Create(G).(MutableGraph)
+		for v := 0; v < 1000; v++ {
+			g.EnsureVertex(v)
+			for k := 0; k < v/2; k++ {
+				g.AddEdges(NewEdge(v, r.Intn(v)))
+			}
+		}
+	}
+}
+
+func BenchmarkDenseInsertAdaptive(b *testing.B) {
+	benchmarkDenseInsert(b)
+}
+
+func BenchmarkDenseInsertFixed10(b *testing.B) {
+	SetDefaultAdjacencyCapacity(10)
+	defer SetDefaultAdjacencyCapacity(0)
+	benchmarkDenseInsert(b)
+}
@@ -0,0 +1,64 @@
+package al
+
+import (
+	. "github.com/sdboyer/gogl"
+)
+
+// Go maps never shrink, so after heavy deletion an adjacency list retains the memory
+// of its high-water mark. Compaction copies the list into freshly allocated maps sized
+// to its current contents, letting the old ones be collected.
+
+// Copies an adjacency list into maps sized exactly to its contents.
+func compactList[T any](list map[Vertex]map[Vertex]T) map[Vertex]map[Vertex]T {
+	compacted := make(map[Vertex]map[Vertex]T, len(list))
+	for v, adj := range list {
+		c := make(map[Vertex]T, len(adj))
+		for w, x := range adj {
+			c[w] = x
+		}
+		compacted[v] = c
+	}
+	return compacted
+}
+
+// Rebuilds the graph's internal structures sized tightly to its current contents.
+func (g *al_basic_mut) Compact() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.list = compactList(g.list)
+}
+
+// Rebuilds the graph's internal structures sized tightly to its current contents.
+func (g *baseWeighted) Compact() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.list = compactList(g.list)
+}
+
+// Rebuilds the graph's internal structures sized tightly to its current contents.
+func (g *baseLabeled) Compact() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.list = compactList(g.list)
+}
+
+// Rebuilds the graph's internal structures sized tightly to its current contents.
+func (g *baseData) Compact() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.list = compactList(g.list)
+}
+
+// Rebuilds the graph's internal structures, including the reverse index, sized tightly
+// to its current contents.
+func (g *weightedDirectedIndexed) Compact() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.list = compactList(g.list)
+	g.pred = compactList(g.pred)
+}
@@ -0,0 +1,126 @@
+package al
+
+import (
+	"math/rand"
+	"runtime"
+	"testing"
+
+	. "github.com/sdboyer/gocheck"
+	. "github.com/sdboyer/gogl"
+)
+
+type CompactSuite struct{}
+
+var _ = Suite(&CompactSuite{})
+
+// Builds a graph from the spec, adds a batch of random edges, then deletes most vertices.
+func churned(spec GraphSpec, create func(GraphSpec) Graph, seed int64) Graph {
+	r := rand.New(rand.NewSource(seed))
+	g := spec.Create(create)
+
+	for i := 0; i < 500; i++ {
+		u, v := r.Intn(100), r.Intn(100)
+		switch m := g.(type) {
+		case WeightedArcSetMutator:
+			m.AddArcs(NewWeightedArc(u, v, float64(i)))
+		case WeightedEdgeSetMutator:
+			m.AddEdges(NewWeightedEdge(u, v, float64(i)))
+		case LabeledArcSetMutator:
+			m.AddArcs(NewLabeledArc(u, v, "l"))
+		case LabeledEdgeSetMutator:
+			m.AddEdges(NewLabeledEdge(u, v, "l"))
+		case DataArcSetMutator:
+			m.AddArcs(NewDataArc(u, v, i))
+		case DataEdgeSetMutator:
+			m.AddEdges(NewDataEdge(u, v, i))
+		case ArcSetMutator:
+			m.AddArcs(NewArc(u, v))
+		case EdgeSetMutator:
+			m.AddEdges(NewEdge(u, v))
+		}
+	}
+
+	for i := 0; i < 80; i++ {
+		g.(VertexSetMutator).RemoveVertex(r.Intn(100))
+	}
+	return g
+}
+
+func (s *CompactSuite) TestContentsPreserved(c *C) {
+	for _, tc := range []struct {
+		spec   GraphSpec
+		create func(GraphSpec) Graph
+	}{
+		{Spec(), G},
+		{Spec().Directed(), G},
+		{Spec().Weighted(), G},
+		{Spec().Directed().Weighted(), G},
+		{Spec().Labeled(), G},
+		{Spec().Directed().Labeled(), G},
+		{Spec().DataEdges(), G},
+		{Spec().Directed().DataEdges(), G},
+		{Spec().Directed().Weighted(), GIndexed},
+	} {
+		g, want := churned(tc.spec, tc.create, 3), churned(tc.spec, tc.create, 3)
+
+		c.Assert(Compact(g), Equals, true)
+		c.Assert(Equal(g, want), Equals, true)
+		c.Assert(Size(g), Equals, Size(want))
+
+		// the compacted graph remains fully usable
+		g.(VertexSetMutator).EnsureVertex("new")
+		c.Assert(g.HasVertex("new"), Equals, true)
+	}
+}
+
+func (s *CompactSuite) TestIndexSurvives(c *C) {
+	g := churned(Spec().Directed().Weighted(), GIndexed, 5).(Digraph)
+	Compact(g)
+
+	plain := churned(Spec().Directed().Weighted(), G, 5).(Digraph)
+	plain.Vertices(func(v Vertex) (terminate bool) {
+		c.Assert(sortedPredecessors(g, v), DeepEquals, sortedPredecessors(plain, v))
+		return
+	})
+}
+
+func (s *CompactSuite) TestUnsupported(c *C) {
+	c.Assert(Compact(Spec().Immutable().Directed().Create(G)), Equals, false)
+}
+
+func heapInUse() uint64 {
+	var m runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&m)
+	return m.HeapAlloc
+}
+
+// Measures heap retained by a graph after a delete-heavy workload, before and after
+// compaction.
+func BenchmarkCompact(b *testing.B) {
+	var before, after uint64
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		base := heapInUse()
+		r := rand.New(rand.NewSource(1))
+		g := Spec().Weighted().Create(G).(MutableWeightedGraph)
+		for k := 0; k < 200000; k++ {
+			g.AddEdges(NewWeightedEdge(r.Intn(20000), r.Intn(20000), 1))
+		}
+		for v := 0; v < 19000; v++ {
+			g.RemoveVertex(v)
+		}
+		before += heapInUse() - base
+		b.StartTimer()
+
+		Compact(g)
+
+		b.StopTimer()
+		after += heapInUse() - base
+		runtime.KeepAlive(g)
+		b.StartTimer()
+	}
+
+	b.ReportMetric(float64(before)/float64(b.N), "heap-before-B")
+	b.ReportMetric(float64(after)/float64(b.N), "heap-after-B")
+}
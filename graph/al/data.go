@@ -74,9 +74,8 @@ func (g *baseData) EnsureVertex(vertices ...Vertex) {
 // already present in the graph, it is a no-op (for that vertex only).
 func (g *baseData) ensureVertex(vertices ...Vertex) {
 	for _, vertex := range vertices {
-		if !g.hasVertex(vertex) {
-			// TODO experiment with different lengths...possibly by analyzing existing density?
-			g.list[vertex] = make(map[Vertex]interface{}, 10)
+		if vertex != nil && !g.hasVertex(vertex) {
+			g.list[vertex] = make(map[Vertex]interface{}, adjacencyCapacity(g.size, len(g.list)))
 		}
 	}
 
@@ -238,6 +237,15 @@ func (g *dataDirected) HasEdge(edge Edge) bool {
 	return exists
 }
 
+// Indicates, for each of the given edges, whether or not it is present in the
+// graph. The checks are made in a single pass, under a single lock.
+func (g *dataDirected) HasEdges(edges ...Edge) []bool {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	return hasEdgesInAdjacencyList(g.list, edges)
+}
+
 // Indicates whether or not the given arc is present in the graph.
 func (g *dataDirected) HasArc(arc Arc) bool {
 	g.mu.RLock()
@@ -276,6 +284,11 @@ func (g *dataDirected) HasDataArc(arc DataArc) bool {
 	return false
 }
 
+// Indicates whether or not the graph's edges are directed. Always true for this type.
+func (g *dataDirected) IsDirected() bool {
+	return true
+}
+
 // Returns the density of the graph. Density is the ratio of edge count to the
 // number of edges there would be in complete graph (maximum edge count).
 func (g *dataDirected) Density() float64 {
@@ -327,6 +340,10 @@ func (g *dataDirected) AddArcs(arcs ...DataArc) {
 // Adds a new arc to the graph.
 func (g *dataDirected) addArcs(arcs ...DataArc) {
 	for _, arc := range arcs {
+		if hasNilEndpoint(arc) {
+			continue
+		}
+
 		u, v := arc.Both()
 		g.ensureVertex(u, v)
 
@@ -396,6 +413,10 @@ func (g *dataUndirected) DegreeOf(vertex Vertex) (degree int, exists bool) {
 
 	if exists = g.hasVertex(vertex); exists {
 		degree = len(g.list[vertex])
+		// A loop contributes two to the degree of its vertex.
+		if _, loop := g.list[vertex][vertex]; loop {
+			degree++
+		}
 	}
 	return
 }
@@ -458,6 +479,15 @@ func (g *dataUndirected) HasEdge(edge Edge) bool {
 	return exists
 }
 
+// Indicates, for each of the given edges, whether or not it is present in the
+// graph. The checks are made in a single pass, under a single lock.
+func (g *dataUndirected) HasEdges(edges ...Edge) []bool {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	return hasEdgesInAdjacencyList(g.list, edges)
+}
+
 // Indicates whether or not the given property edge is present in the graph.
 // It will only match if the provided DataEdge has the same property as
 // the edge contained in the graph.
@@ -475,6 +505,11 @@ func (g *dataUndirected) HasDataEdge(edge DataEdge) bool {
 	return false
 }
 
+// Indicates whether or not the graph's edges are directed. Always false for this type.
+func (g *dataUndirected) IsDirected() bool {
+	return false
+}
+
 // Returns the density of the graph. Density is the ratio of edge count to the
 // number of edges there would be in complete graph (maximum edge count).
 func (g *dataUndirected) Density() float64 {
@@ -497,11 +532,13 @@ func (g *dataUndirected) RemoveVertex(vertices ...Vertex) {
 
 	for _, vertex := range vertices {
 		if g.hasVertex(vertex) {
+			// Size must be taken before the adjacent entries are cleared, as a
+			// loop's entry is removed from the vertex's own list in the process.
+			g.size -= len(g.list[vertex])
 			eachVertexInAdjacencyList(g.list, vertex, func(adjacent Vertex) (terminate bool) {
 				delete(g.list[adjacent], vertex)
 				return
 			})
-			g.size -= len(g.list[vertex])
 			delete(g.list, vertex)
 		}
 	}
@@ -523,6 +560,10 @@ func (g *dataUndirected) AddEdges(edges ...DataEdge) {
 // Adds a new edge to the graph.
 func (g *dataUndirected) addEdges(edges ...DataEdge) {
 	for _, edge := range edges {
+		if hasNilEndpoint(edge) {
+			continue
+		}
+
 		u, v := edge.Both()
 		g.ensureVertex(u, v)
 
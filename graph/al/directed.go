@@ -158,6 +158,15 @@ func (g *mutableDirected) HasEdge(edge Edge) bool {
 	return exists
 }
 
+// Indicates, for each of the given edges, whether or not it is present in the
+// graph. The checks are made in a single pass, under a single lock.
+func (g *mutableDirected) HasEdges(edges ...Edge) []bool {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	return hasEdgesInAdjacencyList(g.list, edges)
+}
+
 // Indicates whether or not the given arc is present in the graph.
 func (g *mutableDirected) HasArc(arc Arc) bool {
 	g.mu.RLock()
@@ -167,6 +176,11 @@ func (g *mutableDirected) HasArc(arc Arc) bool {
 	return exists
 }
 
+// Indicates whether or not the graph's edges are directed. Always true for this type.
+func (g *mutableDirected) IsDirected() bool {
+	return true
+}
+
 // Returns the density of the graph. Density is the ratio of edge count to the
 // number of edges there would be in complete graph (maximum edge count).
 func (g *mutableDirected) Density() float64 {
@@ -221,6 +235,10 @@ func (g *mutableDirected) AddArcs(arcs ...Arc) {
 // Adds a new arc to the graph.
 func (g *mutableDirected) addArcs(arcs ...Arc) {
 	for _, arc := range arcs {
+		if hasNilEndpoint(arc) {
+			continue
+		}
+
 		g.ensureVertex(arc.Source(), arc.Target())
 
 		if _, exists := g.list[arc.Source()][arc.Target()]; !exists {
@@ -364,6 +382,11 @@ func (g *immutableDirected) PredecessorsOf(v Vertex, f VertexStep) {
 	eachPredecessorOf(g.list, v, f)
 }
 
+// Indicates whether or not the graph's edges are directed. Always true for this type.
+func (g *immutableDirected) IsDirected() bool {
+	return true
+}
+
 // Returns the density of the graph. Density is the ratio of edge count to the
 // number of edges there would be in complete graph (maximum edge count).
 func (g *immutableDirected) Density() float64 {
@@ -381,6 +404,12 @@ func (g *immutableDirected) HasEdge(edge Edge) bool {
 	return exists
 }
 
+// Indicates, for each of the given edges, whether or not it is present in the
+// graph.
+func (g *immutableDirected) HasEdges(edges ...Edge) []bool {
+	return hasEdgesInAdjacencyList(g.list, edges)
+}
+
 // Indicates whether or not the given arc is present in the graph.
 func (g *immutableDirected) HasArc(arc Arc) bool {
 	_, exists := g.list[arc.Source()][arc.Target()]
@@ -451,6 +480,10 @@ func (g *immutableDirected) Transpose() Digraph {
 // Adds a new arc to the graph.
 func (g *immutableDirected) addArcs(arcs ...Arc) {
 	for _, arc := range arcs {
+		if hasNilEndpoint(arc) {
+			continue
+		}
+
 		g.ensureVertex(arc.Source(), arc.Target())
 
 		if _, exists := g.list[arc.Source()][arc.Target()]; !exists {
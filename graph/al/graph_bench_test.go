@@ -2,6 +2,7 @@ package al
 
 import (
 	"math/rand"
+	"runtime"
 	"testing"
 	"time"
 
@@ -188,3 +189,53 @@ func BenchmarkEdges(b *testing.B) {
 		})
 	}
 }
+
+// Measures how long a writer is blocked by an in-progress enumeration whose step
+// function yields the processor at every step. With the snapshot variants, the graph's lock is only held
+// while the copy is made, rather than for the whole enumeration.
+func benchWriterWait(b *testing.B, enumerate func(VertexStep)) {
+	m := bgraph.(MutableGraph)
+	var waited time.Duration
+
+	for i := 0; i < b.N; i++ {
+		started, done := make(chan struct{}), make(chan struct{})
+		go func() {
+			var once bool
+			enumerate(func(v Vertex) (terminate bool) {
+				if !once {
+					once = true
+					close(started)
+				}
+				runtime.Gosched()
+				return
+			})
+			close(done)
+		}()
+
+		<-started
+		start := time.Now()
+		m.EnsureVertex(50) // already present; only takes the write lock
+		waited += time.Since(start)
+		<-done
+	}
+
+	b.ReportMetric(float64(waited.Nanoseconds())/float64(b.N), "ns-writer-wait/op")
+}
+
+func BenchmarkVerticesWriterWait(b *testing.B) {
+	benchWriterWait(b, bgraph.Vertices)
+}
+
+func BenchmarkVerticesSnapshotWriterWait(b *testing.B) {
+	benchWriterWait(b, func(f VertexStep) {
+		VerticesSnapshot(bgraph, f)
+	})
+}
+
+func BenchmarkEdgesSnapshot(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		EdgesSnapshot(bgraph, func(e Edge) (terminate bool) {
+			return
+		})
+	}
+}
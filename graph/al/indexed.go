@@ -0,0 +1,229 @@
+package al
+
+import (
+	. "github.com/sdboyer/gogl"
+)
+
+// Create a directed, weighted adjacency list from the provided GraphSpec that also
+// maintains a reverse (in-arc) index.
+//
+// Plain directed adjacency lists only record out-arcs, so finding a vertex's in-arcs or
+// predecessors requires a full scan of the graph. The reverse index makes ArcsTo and
+// PredecessorsOf (and so IncidentTo and AdjacentTo) O(indegree), and InDegreeOf and
+// DegreeOf O(1), at the cost of some extra memory and bookkeeping on every mutation.
+//
+// Use it with the builder in place of G:
+//
+//	g := Spec().Directed().Weighted().Using(src).Create(al.GIndexed)
+//
+// This function will panic if the GraphSpec does not describe a mutable, directed,
+// weighted graph.
+func GIndexed(gs GraphSpec) Graph {
+	want := GraphProperties(G_MUTABLE | G_DIRECTED | G_WEIGHTED)
+	if gs.Props&want != want {
+		panic("Indexed adjacency lists are only implemented for mutable, weighted digraphs.")
+	}
+
+	g := &weightedDirectedIndexed{
+		weightedDirected: weightedDirected{baseWeighted{list: make(map[Vertex]map[Vertex]float64)}},
+		pred:             make(map[Vertex]map[Vertex]struct{}),
+	}
+
+	if gs.Source != nil {
+		if dgs, ok := gs.Source.(DigraphSource); ok {
+			return functorToDirectedAdjacencyList(dgs, g)
+		}
+		panic("Cannot create a digraph from a graph.")
+	}
+
+	return g
+}
+
+type weightedDirectedIndexed struct {
+	weightedDirected
+	// pred[v] is the set of vertices with an arc to v
+	pred map[Vertex]map[Vertex]struct{}
+}
+
+// Returns the indegree of the provided vertex. If the vertex is not present in the
+// graph, the second return value will be false.
+//
+// Unlike plain directed adjacency lists, this is O(1), courtesy of the reverse index.
+func (g *weightedDirectedIndexed) InDegreeOf(vertex Vertex) (degree int, exists bool) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	if exists = g.hasVertex(vertex); exists {
+		degree = len(g.pred[vertex])
+	}
+	return
+}
+
+// Returns the degree of the given vertex, counting both in and out-edges.
+func (g *weightedDirectedIndexed) DegreeOf(vertex Vertex) (degree int, exists bool) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	if exists = g.hasVertex(vertex); exists {
+		degree = len(g.pred[vertex]) + len(g.list[vertex])
+	}
+	return
+}
+
+// Enumerates the set of all edges incident to the provided vertex.
+func (g *weightedDirectedIndexed) IncidentTo(v Vertex, f EdgeStep) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	for adjacent, weight := range g.list[v] {
+		if f(NewWeightedArc(v, adjacent, weight)) {
+			return
+		}
+	}
+
+	for p := range g.pred[v] {
+		if f(NewWeightedArc(p, v, g.list[p][v])) {
+			return
+		}
+	}
+}
+
+// Enumerates the vertices adjacent to the provided vertex.
+func (g *weightedDirectedIndexed) AdjacentTo(start Vertex, f VertexStep) {
+	g.IncidentTo(start, func(e Edge) bool {
+		u, v := e.Both()
+		if u == start {
+			return f(v)
+		}
+		return f(u)
+	})
+}
+
+// Enumerates the set of in-edges for the provided vertex.
+func (g *weightedDirectedIndexed) ArcsTo(v Vertex, f ArcStep) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	for p := range g.pred[v] {
+		if f(NewWeightedArc(p, v, g.list[p][v])) {
+			return
+		}
+	}
+}
+
+// Enumerates the vertices with an arc to the provided vertex.
+func (g *weightedDirectedIndexed) PredecessorsOf(v Vertex, f VertexStep) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	for p := range g.pred[v] {
+		if f(p) {
+			return
+		}
+	}
+}
+
+// Removes a vertex from the graph. Also removes any edges of which that
+// vertex is a member.
+func (g *weightedDirectedIndexed) RemoveVertex(vertices ...Vertex) {
+	if len(vertices) == 0 {
+		return
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	for _, vertex := range vertices {
+		if !g.hasVertex(vertex) {
+			continue
+		}
+
+		// Thanks to the index, only the vertex's own neighbors need visiting.
+		for p := range g.pred[vertex] {
+			delete(g.list[p], vertex)
+			g.size--
+		}
+		for s := range g.list[vertex] {
+			delete(g.pred[s], vertex)
+		}
+
+		g.size -= len(g.list[vertex])
+		delete(g.list, vertex)
+		delete(g.pred, vertex)
+	}
+}
+
+// Adds arcs to the graph.
+func (g *weightedDirectedIndexed) AddArcs(arcs ...WeightedArc) {
+	if len(arcs) == 0 {
+		return
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.addArcs(arcs...)
+}
+
+// Adds new arcs to the graph, recording them in the reverse index.
+func (g *weightedDirectedIndexed) addArcs(arcs ...WeightedArc) {
+	g.weightedDirected.addArcs(arcs...)
+
+	for _, arc := range arcs {
+		if hasNilEndpoint(arc) {
+			continue
+		}
+
+		s, t := arc.Both()
+		if _, exists := g.pred[t]; !exists {
+			g.pred[t] = make(map[Vertex]struct{})
+		}
+		g.pred[t][s] = struct{}{}
+	}
+}
+
+// Removes arcs from the graph. This does NOT remove vertex members of the
+// removed arcs.
+func (g *weightedDirectedIndexed) RemoveArcs(arcs ...WeightedArc) {
+	if len(arcs) == 0 {
+		return
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	for _, arc := range arcs {
+		s, t := arc.Both()
+		if _, exists := g.list[s][t]; exists {
+			delete(g.list[s], t)
+			delete(g.pred[t], s)
+			g.size--
+		}
+	}
+}
+
+// Returns a transposed copy of the graph, itself indexed. As the index is just
+// the transpose's adjacency list, this requires no scanning.
+func (g *weightedDirectedIndexed) Transpose() Digraph {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	g2 := &weightedDirectedIndexed{
+		weightedDirected: weightedDirected{baseWeighted{list: make(map[Vertex]map[Vertex]float64, len(g.list)), size: g.size}},
+		pred:             make(map[Vertex]map[Vertex]struct{}, len(g.list)),
+	}
+
+	for v := range g.list {
+		g2.list[v] = make(map[Vertex]float64, len(g.pred[v]))
+		for p := range g.pred[v] {
+			g2.list[v][p] = g.list[p][v]
+		}
+
+		g2.pred[v] = make(map[Vertex]struct{}, len(g.list[v]))
+		for s := range g.list[v] {
+			g2.pred[v][s] = struct{}{}
+		}
+	}
+
+	return g2
+}
@@ -0,0 +1,188 @@
+package al
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	. "github.com/sdboyer/gocheck"
+	. "github.com/sdboyer/gogl"
+)
+
+type IndexedSuite struct{}
+
+var _ = Suite(&IndexedSuite{})
+
+func sortedPredecessors(g Digraph, v Vertex) (preds []int) {
+	g.PredecessorsOf(v, func(p Vertex) (terminate bool) {
+		preds = append(preds, p.(int))
+		return
+	})
+	sort.Ints(preds)
+	return
+}
+
+// Runs the same random sequence of mutations against an indexed and a plain graph,
+// checking that the index always agrees with the scanning implementation.
+func (s *IndexedSuite) TestIndexMatchesScan(c *C) {
+	r := rand.New(rand.NewSource(11))
+	spec := Spec().Directed().Weighted()
+	ig := spec.Create(GIndexed).(MutableWeightedDigraph)
+	pg := spec.Create(G).(MutableWeightedDigraph)
+
+	for i := 0; i < 2000; i++ {
+		u, v := r.Intn(30), r.Intn(30)
+		switch r.Intn(10) {
+		case 0:
+			ig.RemoveVertex(u)
+			pg.RemoveVertex(u)
+		case 1, 2, 3:
+			ig.RemoveArcs(NewWeightedArc(u, v, 0))
+			pg.RemoveArcs(NewWeightedArc(u, v, 0))
+		default:
+			w := float64(r.Intn(5))
+			ig.AddArcs(NewWeightedArc(u, v, w))
+			pg.AddArcs(NewWeightedArc(u, v, w))
+		}
+	}
+
+	c.Assert(Size(ig), Equals, Size(pg))
+	c.Assert(Order(ig), Equals, Order(pg))
+
+	pg.Vertices(func(v Vertex) (terminate bool) {
+		c.Assert(sortedPredecessors(ig, v), DeepEquals, sortedPredecessors(pg, v))
+
+		var in int
+		ig.ArcsTo(v, func(a Arc) (terminate bool) {
+			in++
+			c.Assert(pg.HasWeightedArc(a.(WeightedArc)), Equals, true)
+			return
+		})
+		pdeg, _ := pg.InDegreeOf(v)
+		c.Assert(in, Equals, pdeg)
+		return
+	})
+}
+
+// Checks the cached indegree against the scanning implementation throughout a random
+// sequence of mutations, not just at the end.
+func (s *IndexedSuite) TestInDegree(c *C) {
+	r := rand.New(rand.NewSource(23))
+	spec := Spec().Directed().Weighted()
+	ig := spec.Create(GIndexed).(MutableWeightedDigraph)
+	pg := spec.Create(G).(MutableWeightedDigraph)
+
+	for i := 0; i < 500; i++ {
+		u, v := r.Intn(15), r.Intn(15)
+		switch r.Intn(8) {
+		case 0:
+			ig.RemoveVertex(u)
+			pg.RemoveVertex(u)
+		case 1, 2:
+			ig.RemoveArcs(NewWeightedArc(u, v, 0))
+			pg.RemoveArcs(NewWeightedArc(u, v, 0))
+		default:
+			ig.AddArcs(NewWeightedArc(u, v, 1))
+			pg.AddArcs(NewWeightedArc(u, v, 1))
+		}
+
+		for _, x := range []Vertex{u, v} {
+			ideg, iexists := ig.InDegreeOf(x)
+			pdeg, pexists := pg.InDegreeOf(x)
+			c.Assert(iexists, Equals, pexists)
+			c.Assert(ideg, Equals, pdeg)
+
+			ideg, _ = ig.DegreeOf(x)
+			pdeg, _ = pg.DegreeOf(x)
+			c.Assert(ideg, Equals, pdeg)
+		}
+	}
+}
+
+// Checks the cached indegree against a brute-force count over every arc in the graph,
+// after vertex and arc removals have churned the index.
+func (s *IndexedSuite) TestInDegreeBruteForce(c *C) {
+	r := rand.New(rand.NewSource(31))
+	ig := Spec().Directed().Weighted().Create(GIndexed).(MutableWeightedDigraph)
+
+	for i := 0; i < 1000; i++ {
+		ig.AddArcs(NewWeightedArc(r.Intn(40), r.Intn(40), 1))
+	}
+	for i := 0; i < 10; i++ {
+		ig.RemoveVertex(r.Intn(40))
+	}
+	for i := 0; i < 300; i++ {
+		ig.RemoveArcs(NewWeightedArc(r.Intn(40), r.Intn(40), 1))
+	}
+
+	counts := make(map[Vertex]int)
+	ig.Arcs(func(a Arc) (terminate bool) {
+		counts[a.Target()]++
+		return
+	})
+
+	ig.Vertices(func(v Vertex) (terminate bool) {
+		deg, exists := ig.InDegreeOf(v)
+		c.Assert(exists, Equals, true)
+		c.Assert(deg, Equals, counts[v], Commentf("vertex %v", v))
+		return
+	})
+}
+
+func (s *IndexedSuite) TestTransposeIndex(c *C) {
+	r := rand.New(rand.NewSource(5))
+	ig := Spec().Directed().Weighted().Create(GIndexed).(MutableWeightedDigraph)
+	for i := 0; i < 200; i++ {
+		ig.AddArcs(NewWeightedArc(r.Intn(30), r.Intn(30), 1))
+	}
+
+	tg := ig.Transpose()
+	c.Assert(Size(tg), Equals, Size(ig))
+	ig.Arcs(func(a Arc) (terminate bool) {
+		u, v := a.Both()
+		c.Assert(tg.HasArc(NewArc(v, u)), Equals, true)
+		c.Assert(sortedPredecessors(tg, u), DeepEquals, func() (succ []int) {
+			ig.SuccessorsOf(u, func(s Vertex) (terminate bool) {
+				succ = append(succ, s.(int))
+				return
+			})
+			sort.Ints(succ)
+			return
+		}())
+		return
+	})
+}
+
+func (s *IndexedSuite) TestOnlyWeightedDigraphs(c *C) {
+	c.Assert(func() { Spec().Weighted().Create(GIndexed) }, PanicMatches, ".*only implemented.*")
+	c.Assert(func() { Spec().Directed().Create(GIndexed) }, PanicMatches, ".*only implemented.*")
+}
+
+// A sparse random digraph over 1000 vertices, for predecessor benchmarks.
+func predBenchArcs() WeightedArcList {
+	r := rand.New(rand.NewSource(1))
+	arcs := WeightedArcList{}
+	for i := 0; i < 5000; i++ {
+		arcs = append(arcs, NewWeightedArc(r.Intn(1000), r.Intn(1000), 1))
+	}
+	return arcs
+}
+
+func benchPredecessors(b *testing.B, create func(GraphSpec) Graph) {
+	g := Spec().Directed().Weighted().Using(predBenchArcs()).Create(create).(Digraph)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		g.PredecessorsOf(i%1000, func(v Vertex) (terminate bool) {
+			return
+		})
+	}
+}
+
+func BenchmarkPredecessorsOf(b *testing.B) {
+	benchPredecessors(b, G)
+}
+
+func BenchmarkPredecessorsOfIndexed(b *testing.B) {
+	benchPredecessors(b, GIndexed)
+}
@@ -74,9 +74,8 @@ func (g *baseLabeled) EnsureVertex(vertices ...Vertex) {
 // already present in the graph, it is a no-op (for that vertex only).
 func (g *baseLabeled) ensureVertex(vertices ...Vertex) {
 	for _, vertex := range vertices {
-		if !g.hasVertex(vertex) {
-			// TODO experiment with different lengths...possibly by analyzing existing density?
-			g.list[vertex] = make(map[Vertex]string, 10)
+		if vertex != nil && !g.hasVertex(vertex) {
+			g.list[vertex] = make(map[Vertex]string, adjacencyCapacity(g.size, len(g.list)))
 		}
 	}
 
@@ -238,6 +237,15 @@ func (g *labeledDirected) HasEdge(edge Edge) bool {
 	return exists
 }
 
+// Indicates, for each of the given edges, whether or not it is present in the
+// graph. The checks are made in a single pass, under a single lock.
+func (g *labeledDirected) HasEdges(edges ...Edge) []bool {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	return hasEdgesInAdjacencyList(g.list, edges)
+}
+
 // Indicates whether or not the given arc is present in the graph.
 func (g *labeledDirected) HasArc(arc Arc) bool {
 	g.mu.RLock()
@@ -276,6 +284,11 @@ func (g *labeledDirected) HasLabeledArc(arc LabeledArc) bool {
 	return false
 }
 
+// Indicates whether or not the graph's edges are directed. Always true for this type.
+func (g *labeledDirected) IsDirected() bool {
+	return true
+}
+
 // Returns the density of the graph. Density is the ratio of edge count to the
 // number of edges there would be in complete graph (maximum edge count).
 func (g *labeledDirected) Density() float64 {
@@ -327,6 +340,10 @@ func (g *labeledDirected) AddArcs(arcs ...LabeledArc) {
 // Adds a new arc to the graph.
 func (g *labeledDirected) addArcs(arcs ...LabeledArc) {
 	for _, arc := range arcs {
+		if hasNilEndpoint(arc) {
+			continue
+		}
+
 		g.ensureVertex(arc.Source(), arc.Target())
 
 		if _, exists := g.list[arc.Source()][arc.Target()]; !exists {
@@ -395,6 +412,10 @@ func (g *labeledUndirected) DegreeOf(vertex Vertex) (degree int, exists bool) {
 
 	if exists = g.hasVertex(vertex); exists {
 		degree = len(g.list[vertex])
+		// A loop contributes two to the degree of its vertex.
+		if _, loop := g.list[vertex][vertex]; loop {
+			degree++
+		}
 	}
 	return
 }
@@ -461,6 +482,15 @@ func (g *labeledUndirected) HasEdge(edge Edge) bool {
 	return false
 }
 
+// Indicates, for each of the given edges, whether or not it is present in the
+// graph. The checks are made in a single pass, under a single lock.
+func (g *labeledUndirected) HasEdges(edges ...Edge) []bool {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	return hasEdgesInAdjacencyList(g.list, edges)
+}
+
 // Indicates whether or not the given labeled edge is present in the graph.
 // It will only match if the provided LabeledEdge has the same label as
 // the edge contained in the graph.
@@ -478,6 +508,11 @@ func (g *labeledUndirected) HasLabeledEdge(edge LabeledEdge) bool {
 	return false
 }
 
+// Indicates whether or not the graph's edges are directed. Always false for this type.
+func (g *labeledUndirected) IsDirected() bool {
+	return false
+}
+
 // Returns the density of the graph. Density is the ratio of edge count to the
 // number of edges there would be in complete graph (maximum edge count).
 func (g *labeledUndirected) Density() float64 {
@@ -500,11 +535,13 @@ func (g *labeledUndirected) RemoveVertex(vertices ...Vertex) {
 
 	for _, vertex := range vertices {
 		if g.hasVertex(vertex) {
+			// Size must be taken before the adjacent entries are cleared, as a
+			// loop's entry is removed from the vertex's own list in the process.
+			g.size -= len(g.list[vertex])
 			eachVertexInAdjacencyList(g.list, vertex, func(adjacent Vertex) (terminate bool) {
 				delete(g.list[adjacent], vertex)
 				return
 			})
-			g.size -= len(g.list[vertex])
 			delete(g.list, vertex)
 		}
 	}
@@ -526,6 +563,10 @@ func (g *labeledUndirected) AddEdges(edges ...LabeledEdge) {
 // Adds a new edge to the graph.
 func (g *labeledUndirected) addEdges(edges ...LabeledEdge) {
 	for _, edge := range edges {
+		if hasNilEndpoint(edge) {
+			continue
+		}
+
 		u, v := edge.Both()
 		g.ensureVertex(u, v)
 
@@ -0,0 +1,139 @@
+package al
+
+import (
+	. "github.com/sdboyer/gogl"
+)
+
+// TryMutate works by handing the func an unlocked copy of the graph's header, sharing
+// the same underlying maps. Once the func returns, anything it replaced wholesale (the
+// size, or the maps themselves, if it compacted) is copied back into the graph, all while
+// the graph's own lock is held.
+
+// Calls f with exclusive access to the graph, unless it is currently in use, in which
+// case false is returned immediately. See TryMutator.
+func (g *mutableDirected) TryMutate(f func(Graph)) bool {
+	if !g.mu.TryLock() {
+		return false
+	}
+	defer g.mu.Unlock()
+
+	view := &mutableDirected{al_basic_mut{al_basic: g.al_basic}}
+	f(view)
+	g.al_basic = view.al_basic
+	return true
+}
+
+// Calls f with exclusive access to the graph, unless it is currently in use, in which
+// case false is returned immediately. See TryMutator.
+func (g *mutableUndirected) TryMutate(f func(Graph)) bool {
+	if !g.mu.TryLock() {
+		return false
+	}
+	defer g.mu.Unlock()
+
+	view := &mutableUndirected{al_basic_mut{al_basic: g.al_basic}}
+	f(view)
+	g.al_basic = view.al_basic
+	return true
+}
+
+// Calls f with exclusive access to the graph, unless it is currently in use, in which
+// case false is returned immediately. See TryMutator.
+func (g *weightedDirected) TryMutate(f func(Graph)) bool {
+	if !g.mu.TryLock() {
+		return false
+	}
+	defer g.mu.Unlock()
+
+	view := &weightedDirected{baseWeighted{list: g.list, size: g.size}}
+	f(view)
+	g.list, g.size = view.list, view.size
+	return true
+}
+
+// Calls f with exclusive access to the graph, unless it is currently in use, in which
+// case false is returned immediately. See TryMutator.
+func (g *weightedUndirected) TryMutate(f func(Graph)) bool {
+	if !g.mu.TryLock() {
+		return false
+	}
+	defer g.mu.Unlock()
+
+	view := &weightedUndirected{baseWeighted{list: g.list, size: g.size}}
+	f(view)
+	g.list, g.size = view.list, view.size
+	return true
+}
+
+// Calls f with exclusive access to the graph, unless it is currently in use, in which
+// case false is returned immediately. See TryMutator.
+func (g *weightedDirectedIndexed) TryMutate(f func(Graph)) bool {
+	if !g.mu.TryLock() {
+		return false
+	}
+	defer g.mu.Unlock()
+
+	view := &weightedDirectedIndexed{
+		weightedDirected: weightedDirected{baseWeighted{list: g.list, size: g.size}},
+		pred:             g.pred,
+	}
+	f(view)
+	g.list, g.size, g.pred = view.list, view.size, view.pred
+	return true
+}
+
+// Calls f with exclusive access to the graph, unless it is currently in use, in which
+// case false is returned immediately. See TryMutator.
+func (g *labeledDirected) TryMutate(f func(Graph)) bool {
+	if !g.mu.TryLock() {
+		return false
+	}
+	defer g.mu.Unlock()
+
+	view := &labeledDirected{baseLabeled{list: g.list, size: g.size}}
+	f(view)
+	g.list, g.size = view.list, view.size
+	return true
+}
+
+// Calls f with exclusive access to the graph, unless it is currently in use, in which
+// case false is returned immediately. See TryMutator.
+func (g *labeledUndirected) TryMutate(f func(Graph)) bool {
+	if !g.mu.TryLock() {
+		return false
+	}
+	defer g.mu.Unlock()
+
+	view := &labeledUndirected{baseLabeled{list: g.list, size: g.size}}
+	f(view)
+	g.list, g.size = view.list, view.size
+	return true
+}
+
+// Calls f with exclusive access to the graph, unless it is currently in use, in which
+// case false is returned immediately. See TryMutator.
+func (g *dataDirected) TryMutate(f func(Graph)) bool {
+	if !g.mu.TryLock() {
+		return false
+	}
+	defer g.mu.Unlock()
+
+	view := &dataDirected{baseData{list: g.list, size: g.size}}
+	f(view)
+	g.list, g.size = view.list, view.size
+	return true
+}
+
+// Calls f with exclusive access to the graph, unless it is currently in use, in which
+// case false is returned immediately. See TryMutator.
+func (g *dataUndirected) TryMutate(f func(Graph)) bool {
+	if !g.mu.TryLock() {
+		return false
+	}
+	defer g.mu.Unlock()
+
+	view := &dataUndirected{baseData{list: g.list, size: g.size}}
+	f(view)
+	g.list, g.size = view.list, view.size
+	return true
+}
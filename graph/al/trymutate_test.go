@@ -0,0 +1,103 @@
+package al
+
+import (
+	. "github.com/sdboyer/gocheck"
+	. "github.com/sdboyer/gogl"
+)
+
+type TryMutateSuite struct{}
+
+var _ = Suite(&TryMutateSuite{})
+
+// Starts a traversal of the graph that stalls on its first vertex until released.
+func stallTraversal(g Graph) (release func()) {
+	started, proceed, done := make(chan struct{}), make(chan struct{}), make(chan struct{})
+	go func() {
+		g.Vertices(func(v Vertex) (terminate bool) {
+			close(started)
+			<-proceed
+			return true
+		})
+		close(done)
+	}()
+
+	<-started
+	return func() {
+		close(proceed)
+		<-done
+	}
+}
+
+func (s *TryMutateSuite) TestBacksOffDuringTraversal(c *C) {
+	g := Spec().Weighted().Using(WeightedEdgeList{NewWeightedEdge(1, 2, 1)}).Create(G).(MutableWeightedGraph)
+	tm := g.(TryMutator)
+
+	release := stallTraversal(g)
+	called := false
+	c.Assert(tm.TryMutate(func(Graph) { called = true }), Equals, false)
+	c.Assert(called, Equals, false)
+	release()
+
+	c.Assert(tm.TryMutate(func(v Graph) {
+		v.(MutableWeightedGraph).AddEdges(NewWeightedEdge(2, 3, 4))
+		v.(MutableWeightedGraph).RemoveEdges(NewWeightedEdge(1, 2, 1))
+		v.(MutableWeightedGraph).AddEdges(NewWeightedEdge(3, 4, 5))
+	}), Equals, true)
+
+	c.Assert(Size(g), Equals, 2)
+	c.Assert(g.HasWeightedEdge(NewWeightedEdge(2, 3, 4)), Equals, true)
+	c.Assert(g.HasEdge(NewEdge(1, 2)), Equals, false)
+}
+
+func (s *TryMutateSuite) TestAllMutableKinds(c *C) {
+	for _, tc := range []struct {
+		spec   GraphSpec
+		create func(GraphSpec) Graph
+	}{
+		{Spec(), G},
+		{Spec().Directed(), G},
+		{Spec().Weighted(), G},
+		{Spec().Directed().Weighted(), G},
+		{Spec().Labeled(), G},
+		{Spec().Directed().Labeled(), G},
+		{Spec().DataEdges(), G},
+		{Spec().Directed().DataEdges(), G},
+		{Spec().Directed().Weighted(), GIndexed},
+	} {
+		g, want := churned(tc.spec, tc.create, 7), churned(tc.spec, tc.create, 7)
+		tm, ok := g.(TryMutator)
+		c.Assert(ok, Equals, true)
+
+		release := stallTraversal(g)
+		c.Assert(tm.TryMutate(func(Graph) {}), Equals, false)
+		release()
+
+		// Mutations made through the view, including compaction, land in the graph.
+		c.Assert(tm.TryMutate(func(v Graph) {
+			v.(VertexSetMutator).RemoveVertex(0, 1, 2)
+			v.(VertexSetMutator).EnsureVertex("new")
+			Compact(v)
+		}), Equals, true)
+		want.(VertexSetMutator).RemoveVertex(0, 1, 2)
+		want.(VertexSetMutator).EnsureVertex("new")
+
+		c.Assert(Equal(g, want), Equals, true)
+		c.Assert(Size(g), Equals, Size(want))
+	}
+
+	idx := churned(Spec().Directed().Weighted(), GIndexed, 7).(Digraph)
+	plain := churned(Spec().Directed().Weighted(), G, 7).(Digraph)
+	idx.(TryMutator).TryMutate(func(v Graph) {
+		v.(VertexSetMutator).RemoveVertex(5)
+	})
+	plain.(VertexSetMutator).RemoveVertex(5)
+	plain.Vertices(func(v Vertex) (terminate bool) {
+		c.Assert(sortedPredecessors(idx, v), DeepEquals, sortedPredecessors(plain, v))
+		return
+	})
+}
+
+func (s *TryMutateSuite) TestImmutableUnsupported(c *C) {
+	_, ok := Spec().Immutable().Directed().Create(G).(TryMutator)
+	c.Assert(ok, Equals, false)
+}
@@ -0,0 +1,116 @@
+package al
+
+import (
+	"math/rand"
+	"testing"
+
+	. "github.com/sdboyer/gocheck"
+	. "github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/spec"
+)
+
+func init() {
+	// The spec fixtures mix vertex types, so the full suites run against lists keyed by
+	// the Vertex interface itself; the typed code paths are the same for any K.
+	spec.SetUpTestsFromSpec(GraphProperties(G_MUTABLE|G_DIRECTED|G_BASIC|G_SIMPLE), GTyped[Vertex])
+	spec.SetUpTestsFromSpec(GraphProperties(G_MUTABLE|G_UNDIRECTED|G_BASIC|G_SIMPLE), GTyped[Vertex])
+}
+
+type TypedSuite struct{}
+
+var _ = Suite(&TypedSuite{})
+
+func randomIntEdges(r *rand.Rand, n, m int) EdgeList {
+	el := EdgeList{}
+	for i := 0; i < m; i++ {
+		el = append(el, NewEdge(r.Intn(n), r.Intn(n)))
+	}
+	return el
+}
+
+func (s *TypedSuite) TestMatchesBoxed(c *C) {
+	r := rand.New(rand.NewSource(3))
+	el := randomIntEdges(r, 50, 300)
+	arcs := ArcList{}
+	for _, e := range el {
+		u, v := e.Both()
+		arcs = append(arcs, NewArc(u, v))
+	}
+
+	for _, sp := range []GraphSpec{Spec().Using(el), Spec().Directed().Using(arcs)} {
+		typed := sp.Create(GTyped[int])
+		boxed := sp.Create(G)
+		c.Assert(Equal(typed, boxed), Equals, true)
+
+		for i := 0; i < 10; i++ {
+			typed.(VertexSetMutator).RemoveVertex(i * 3)
+			boxed.(VertexSetMutator).RemoveVertex(i * 3)
+		}
+		c.Assert(Equal(typed, boxed), Equals, true)
+		boxed.Vertices(func(v Vertex) (terminate bool) {
+			want, _ := boxed.DegreeOf(v)
+			got, _ := typed.DegreeOf(v)
+			c.Assert(got, Equals, want)
+			return
+		})
+	}
+}
+
+func (s *TypedSuite) TestWrongType(c *C) {
+	g := Spec().Create(GTyped[int]).(MutableGraph)
+	g.AddEdges(NewEdge(1, 2))
+
+	c.Assert(g.HasVertex("1"), Equals, false)
+	c.Assert(g.HasEdge(NewEdge("1", 2)), Equals, false)
+	g.RemoveVertex("1")
+	c.Assert(Order(g), Equals, 2)
+
+	c.Assert(func() { g.EnsureVertex("1") }, PanicMatches, ".*requires vertices of type int, got string.*")
+	c.Assert(func() { Spec().Weighted().Create(GTyped[int]) }, PanicMatches, ".*only implemented.*")
+}
+
+// A dense-ish random undirected graph on 2000 int vertices.
+func typedBenchGraph(create func(GraphSpec) Graph) Graph {
+	return Spec().Using(randomIntEdges(rand.New(rand.NewSource(1)), 2000, 40000)).Create(create)
+}
+
+func benchmarkAdjacentTo(b *testing.B, create func(GraphSpec) Graph) {
+	g := typedBenchGraph(create)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		g.AdjacentTo(i%2000, func(v Vertex) (terminate bool) {
+			return
+		})
+	}
+}
+
+func benchmarkHasEdge(b *testing.B, create func(GraphSpec) Graph) {
+	g := typedBenchGraph(create)
+	r := rand.New(rand.NewSource(2))
+	probes := make([]Edge, 4096)
+	for k := range probes {
+		probes[k] = NewEdge(r.Intn(2000), r.Intn(2000))
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		g.HasEdge(probes[i%len(probes)])
+	}
+}
+
+func BenchmarkAdjacentToBoxed(b *testing.B) {
+	benchmarkAdjacentTo(b, G)
+}
+
+func BenchmarkAdjacentToTypedInt(b *testing.B) {
+	benchmarkAdjacentTo(b, GTyped[int])
+}
+
+func BenchmarkHasEdgeBoxed(b *testing.B) {
+	benchmarkHasEdge(b, G)
+}
+
+func BenchmarkHasEdgeTypedInt(b *testing.B) {
+	benchmarkHasEdge(b, GTyped[int])
+}
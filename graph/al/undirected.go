@@ -17,6 +17,10 @@ func (g *mutableUndirected) DegreeOf(vertex Vertex) (degree int, exists bool) {
 
 	if exists = g.hasVertex(vertex); exists {
 		degree = len(g.list[vertex])
+		// A loop contributes two to the degree of its vertex.
+		if _, loop := g.list[vertex][vertex]; loop {
+			degree++
+		}
 	}
 	return
 }
@@ -81,6 +85,20 @@ func (g *mutableUndirected) HasEdge(edge Edge) bool {
 	return false
 }
 
+// Indicates, for each of the given edges, whether or not it is present in the
+// graph. The checks are made in a single pass, under a single lock.
+func (g *mutableUndirected) HasEdges(edges ...Edge) []bool {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	return hasEdgesInAdjacencyList(g.list, edges)
+}
+
+// Indicates whether or not the graph's edges are directed. Always false for this type.
+func (g *mutableUndirected) IsDirected() bool {
+	return false
+}
+
 // Returns the density of the graph. Density is the ratio of edge count to the
 // number of edges there would be in complete graph (maximum edge count).
 func (g *mutableUndirected) Density() float64 {
@@ -103,11 +121,13 @@ func (g *mutableUndirected) RemoveVertex(vertices ...Vertex) {
 
 	for _, vertex := range vertices {
 		if g.hasVertex(vertex) {
+			// Size must be taken before the adjacent entries are cleared, as a
+			// loop's entry is removed from the vertex's own list in the process.
+			g.size -= len(g.list[vertex])
 			eachVertexInAdjacencyList(g.list, vertex, func(adjacent Vertex) (terminate bool) {
 				delete(g.list[adjacent], vertex)
 				return
 			})
-			g.size -= len(g.list[vertex])
 			delete(g.list, vertex)
 		}
 	}
@@ -129,6 +149,10 @@ func (g *mutableUndirected) AddEdges(edges ...Edge) {
 // Adds a new edge to the graph.
 func (g *mutableUndirected) addEdges(edges ...Edge) {
 	for _, edge := range edges {
+		if hasNilEndpoint(edge) {
+			continue
+		}
+
 		u, v := edge.Both()
 		g.ensureVertex(u, v)
 
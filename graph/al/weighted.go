@@ -74,9 +74,8 @@ func (g *baseWeighted) EnsureVertex(vertices ...Vertex) {
 // already present in the graph, it is a no-op (for that vertex only).
 func (g *baseWeighted) ensureVertex(vertices ...Vertex) {
 	for _, vertex := range vertices {
-		if !g.hasVertex(vertex) {
-			// TODO experiment with different lengths...possibly by analyzing existing density?
-			g.list[vertex] = make(map[Vertex]float64, 10)
+		if vertex != nil && !g.hasVertex(vertex) {
+			g.list[vertex] = make(map[Vertex]float64, adjacencyCapacity(g.size, len(g.list)))
 		}
 	}
 
@@ -223,6 +222,15 @@ func (g *weightedDirected) HasEdge(edge Edge) bool {
 	return exists
 }
 
+// Indicates, for each of the given edges, whether or not it is present in the
+// graph. The checks are made in a single pass, under a single lock.
+func (g *weightedDirected) HasEdges(edges ...Edge) []bool {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	return hasEdgesInAdjacencyList(g.list, edges)
+}
+
 // Indicates whether or not the given arc is present in the graph.
 func (g *weightedDirected) HasArc(arc Arc) bool {
 	g.mu.RLock()
@@ -261,6 +269,11 @@ func (g *weightedDirected) HasWeightedArc(arc WeightedArc) bool {
 	return false
 }
 
+// Indicates whether or not the graph's edges are directed. Always true for this type.
+func (g *weightedDirected) IsDirected() bool {
+	return true
+}
+
 // Returns the density of the graph. Density is the ratio of edge count to the
 // number of edges there would be in complete graph (maximum edge count).
 func (g *weightedDirected) Density() float64 {
@@ -312,6 +325,10 @@ func (g *weightedDirected) AddArcs(arcs ...WeightedArc) {
 // Adds a new arc to the graph.
 func (g *weightedDirected) addArcs(arcs ...WeightedArc) {
 	for _, arc := range arcs {
+		if hasNilEndpoint(arc) {
+			continue
+		}
+
 		g.ensureVertex(arc.Source(), arc.Target())
 
 		if _, exists := g.list[arc.Source()][arc.Target()]; !exists {
@@ -340,6 +357,19 @@ func (g *weightedDirected) RemoveArcs(arcs ...WeightedArc) {
 	}
 }
 
+// Adds delta to the weight of the arc from u to v, under the graph's lock. Returns the new
+// weight, or false if there is no such arc. See WeightAdjuster.
+func (g *weightedDirected) AdjustWeight(u, v Vertex, delta float64) (weight float64, exists bool) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if weight, exists = g.list[u][v]; exists {
+		weight += delta
+		g.list[u][v] = weight
+	}
+	return
+}
+
 func (g *weightedDirected) Transpose() Digraph {
 	g.mu.RLock()
 	defer g.mu.RUnlock()
@@ -372,6 +402,21 @@ type weightedUndirected struct {
 	baseWeighted
 }
 
+// Adds delta to the weight of the edge between u and v, under the graph's lock, updating
+// it as seen from both endpoints. Returns the new weight, or false if there is no such
+// edge. See WeightAdjuster.
+func (g *weightedUndirected) AdjustWeight(u, v Vertex, delta float64) (weight float64, exists bool) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if weight, exists = g.list[u][v]; exists {
+		weight += delta
+		g.list[u][v] = weight
+		g.list[v][u] = weight
+	}
+	return
+}
+
 // Returns the degree of the provided vertex. If the vertex is not present in the
 // graph, the second return value will be false.
 func (g *weightedUndirected) DegreeOf(vertex Vertex) (degree int, exists bool) {
@@ -380,6 +425,10 @@ func (g *weightedUndirected) DegreeOf(vertex Vertex) (degree int, exists bool) {
 
 	if exists = g.hasVertex(vertex); exists {
 		degree = len(g.list[vertex])
+		// A loop contributes two to the degree of its vertex.
+		if _, loop := g.list[vertex][vertex]; loop {
+			degree++
+		}
 	}
 	return
 }
@@ -461,6 +510,15 @@ func (g *weightedUndirected) HasEdge(edge Edge) bool {
 	return false
 }
 
+// Indicates, for each of the given edges, whether or not it is present in the
+// graph. The checks are made in a single pass, under a single lock.
+func (g *weightedUndirected) HasEdges(edges ...Edge) []bool {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	return hasEdgesInAdjacencyList(g.list, edges)
+}
+
 // Indicates whether or not the given weighted edge is present in the graph.
 // It will only match if the provided WeightedEdge has the same weight as
 // the edge contained in the graph.
@@ -478,6 +536,11 @@ func (g *weightedUndirected) HasWeightedEdge(edge WeightedEdge) bool {
 	return false
 }
 
+// Indicates whether or not the graph's edges are directed. Always false for this type.
+func (g *weightedUndirected) IsDirected() bool {
+	return false
+}
+
 // Returns the density of the graph. Density is the ratio of edge count to the
 // number of edges there would be in complete graph (maximum edge count).
 func (g *weightedUndirected) Density() float64 {
@@ -500,11 +563,13 @@ func (g *weightedUndirected) RemoveVertex(vertices ...Vertex) {
 
 	for _, vertex := range vertices {
 		if g.hasVertex(vertex) {
+			// Size must be taken before the adjacent entries are cleared, as a
+			// loop's entry is removed from the vertex's own list in the process.
+			g.size -= len(g.list[vertex])
 			eachVertexInAdjacencyList(g.list, vertex, func(adjacent Vertex) (terminate bool) {
 				delete(g.list[adjacent], vertex)
 				return
 			})
-			g.size -= len(g.list[vertex])
 			delete(g.list, vertex)
 		}
 	}
@@ -526,6 +591,10 @@ func (g *weightedUndirected) AddEdges(edges ...WeightedEdge) {
 // Adds a new edge to the graph.
 func (g *weightedUndirected) addEdges(edges ...WeightedEdge) {
 	for _, edge := range edges {
+		if hasNilEndpoint(edge) {
+			continue
+		}
+
 		u, v := edge.Both()
 		g.ensureVertex(u, v)
 
@@ -0,0 +1,605 @@
+// Contains graph implementations backed by adjacency matrices.
+package am
+
+import (
+	"math"
+	"sync"
+
+	. "github.com/sdboyer/gogl"
+)
+
+/*
+Adjacency matrices trade memory for speed: every vertex is assigned a small integer id,
+and the graph keeps a V x V matrix of weights indexed by those ids. Checking for, adding,
+or removing an arc is a pair of slice lookups, rather than the map lookups of an
+adjacency list, and in- and out-degrees are tracked as arcs come and go, so reporting
+them is O(1). The cost is O(V^2) memory regardless of the number of arcs, and O(V) to
+enumerate a single vertex's arcs. They are the right choice for dense graphs, where
+E approaches V^2 anyway; for sparse graphs, prefer the adjacency lists in package al.
+*/
+
+// Marks the absence of an arc in the matrix. As NaN never compares equal to anything,
+// arcs with a NaN weight cannot be stored, and are skipped by AddArcs.
+var absent = math.NaN()
+
+// Create a directed, weighted adjacency matrix from the provided GraphSpec. The matrix
+// starts out empty, and grows as vertices are added; use NewDenseDirectedWeighted to
+// size it up front.
+//
+// Use it with the builder in place of al.G:
+//
+//	g := Spec().Directed().Weighted().Using(src).Create(am.G)
+//
+// This function will panic if the GraphSpec does not describe a mutable, directed,
+// weighted graph.
+func G(gs GraphSpec) Graph {
+	want := GraphProperties(G_MUTABLE | G_DIRECTED | G_WEIGHTED)
+	if gs.Props&want != want {
+		panic("Adjacency matrices are only implemented for mutable, weighted digraphs.")
+	}
+
+	g := newDenseWeighted(0)
+	if gs.Source != nil {
+		dgs, ok := gs.Source.(DigraphSource)
+		if !ok {
+			panic("Cannot create a digraph from a graph.")
+		}
+
+		dgs.Vertices(func(v Vertex) (terminate bool) {
+			g.ensureVertex(v)
+			return
+		})
+		dgs.Arcs(func(a Arc) (terminate bool) {
+			if wa, ok := a.(WeightedArc); ok {
+				g.addArcs(wa)
+			} else {
+				g.addArcs(NewWeightedArc(a.Source(), a.Target(), 0))
+			}
+			return
+		})
+	}
+
+	return g
+}
+
+// Creates a new, empty directed, weighted graph backed by an adjacency matrix, with room
+// for expectedOrder vertices before the matrix must be regrown.
+//
+// The returned graph also implements MutableWeightedGraph; see AddEdges.
+func NewDenseDirectedWeighted(expectedOrder int) MutableWeightedDigraph {
+	return newDenseWeighted(expectedOrder)
+}
+
+type denseWeighted struct {
+	mu sync.RWMutex
+	// ids maps each vertex to its row and column in the matrix
+	ids map[Vertex]int
+	// vertices maps ids back to vertices; unassigned ids hold nil
+	vertices []Vertex
+	// ids released by removed vertices, for reuse
+	free []int
+	// matrix[u][v] holds the weight of the arc from u to v, or absent
+	matrix  [][]float64
+	in, out []int
+	size    int
+}
+
+func newDenseWeighted(capacity int) *denseWeighted {
+	if capacity < 0 {
+		capacity = 0
+	}
+
+	g := &denseWeighted{
+		ids:      make(map[Vertex]int, capacity),
+		vertices: make([]Vertex, 0, capacity),
+	}
+	g.grow(capacity)
+	return g
+}
+
+// Grows the matrix, and the slices indexed by id, to hold at least n vertices.
+func (g *denseWeighted) grow(n int) {
+	if n <= len(g.matrix) {
+		return
+	}
+
+	matrix := make([][]float64, n)
+	for u := range matrix {
+		row := make([]float64, n)
+		var kept int
+		if u < len(g.matrix) {
+			kept = copy(row, g.matrix[u])
+		}
+		for v := kept; v < n; v++ {
+			row[v] = absent
+		}
+		matrix[u] = row
+	}
+	g.matrix = matrix
+
+	in := make([]int, n)
+	copy(in, g.in)
+	g.in = in
+
+	out := make([]int, n)
+	copy(out, g.out)
+	g.out = out
+}
+
+// Returns the id assigned to the given vertex, assigning one if necessary.
+func (g *denseWeighted) ensureVertex(v Vertex) int {
+	if id, exists := g.ids[v]; exists {
+		return id
+	}
+
+	var id int
+	if n := len(g.free); n > 0 {
+		id, g.free = g.free[n-1], g.free[:n-1]
+		g.vertices[id] = v
+	} else {
+		id = len(g.vertices)
+		if id == len(g.matrix) {
+			g.grow(2*id + 1)
+		}
+		g.vertices = append(g.vertices, v)
+	}
+
+	g.ids[v] = id
+	return id
+}
+
+// Returns the weight of the arc from u to v, if there is one.
+func (g *denseWeighted) weight(u, v Vertex) (float64, bool) {
+	uid, exists := g.ids[u]
+	if !exists {
+		return 0, false
+	}
+	vid, exists := g.ids[v]
+	if !exists {
+		return 0, false
+	}
+
+	w := g.matrix[uid][vid]
+	return w, !math.IsNaN(w)
+}
+
+// Traverses the graph's vertices, passing each vertex to the provided closure. Vertices
+// are visited in the order of their ids, which is the order in which they were added,
+// save that the ids of removed vertices are reused.
+func (g *denseWeighted) Vertices(f VertexStep) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	for _, v := range g.vertices {
+		if v != nil && f(v) {
+			return
+		}
+	}
+}
+
+// Indicates whether or not the given vertex is present in the graph.
+func (g *denseWeighted) HasVertex(vertex Vertex) bool {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	_, exists := g.ids[vertex]
+	return exists
+}
+
+// Returns the order (number of vertices) in the graph.
+func (g *denseWeighted) Order() int {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	return len(g.ids)
+}
+
+// Returns the size (number of edges) in the graph.
+func (g *denseWeighted) Size() int {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	return g.size
+}
+
+// Returns the outdegree of the provided vertex, in O(1). If the vertex is not present
+// in the graph, the second return value will be false.
+func (g *denseWeighted) OutDegreeOf(vertex Vertex) (degree int, exists bool) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	id, exists := g.ids[vertex]
+	if exists {
+		degree = g.out[id]
+	}
+	return
+}
+
+// Returns the indegree of the provided vertex, in O(1). If the vertex is not present
+// in the graph, the second return value will be false.
+func (g *denseWeighted) InDegreeOf(vertex Vertex) (degree int, exists bool) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	id, exists := g.ids[vertex]
+	if exists {
+		degree = g.in[id]
+	}
+	return
+}
+
+// Returns the degree of the given vertex, counting both in and out-edges.
+func (g *denseWeighted) DegreeOf(vertex Vertex) (degree int, exists bool) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	id, exists := g.ids[vertex]
+	if exists {
+		degree = g.in[id] + g.out[id]
+	}
+	return
+}
+
+// Enumerates the set of out-edges for the provided vertex.
+func (g *denseWeighted) ArcsFrom(v Vertex, f ArcStep) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	g.arcsFrom(v, f)
+}
+
+func (g *denseWeighted) arcsFrom(v Vertex, f ArcStep) bool {
+	id, exists := g.ids[v]
+	if !exists {
+		return false
+	}
+
+	for t, w := range g.matrix[id][:len(g.vertices)] {
+		if !math.IsNaN(w) && f(NewWeightedArc(v, g.vertices[t], w)) {
+			return true
+		}
+	}
+	return false
+}
+
+// Enumerates the set of in-edges for the provided vertex.
+func (g *denseWeighted) ArcsTo(v Vertex, f ArcStep) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	g.arcsTo(v, f)
+}
+
+func (g *denseWeighted) arcsTo(v Vertex, f ArcStep) bool {
+	id, exists := g.ids[v]
+	if !exists {
+		return false
+	}
+
+	for s := range g.vertices {
+		if w := g.matrix[s][id]; !math.IsNaN(w) && f(NewWeightedArc(g.vertices[s], v, w)) {
+			return true
+		}
+	}
+	return false
+}
+
+// Enumerates the vertices with an arc from the provided vertex.
+func (g *denseWeighted) SuccessorsOf(v Vertex, f VertexStep) {
+	g.ArcsFrom(v, func(a Arc) bool {
+		return f(a.Target())
+	})
+}
+
+// Enumerates the vertices with an arc to the provided vertex.
+func (g *denseWeighted) PredecessorsOf(v Vertex, f VertexStep) {
+	g.ArcsTo(v, func(a Arc) bool {
+		return f(a.Source())
+	})
+}
+
+// Enumerates the set of all edges incident to the provided vertex: its out-arcs, then
+// its in-arcs.
+func (g *denseWeighted) IncidentTo(v Vertex, f EdgeStep) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	step := func(a Arc) bool {
+		return f(a)
+	}
+	if !g.arcsFrom(v, step) {
+		g.arcsTo(v, step)
+	}
+}
+
+// Enumerates the vertices adjacent to the provided vertex.
+func (g *denseWeighted) AdjacentTo(start Vertex, f VertexStep) {
+	g.IncidentTo(start, func(e Edge) bool {
+		u, v := e.Both()
+		if u == start {
+			return f(v)
+		}
+		return f(u)
+	})
+}
+
+// Traverses the set of edges in the graph, passing each edge to the
+// provided closure.
+func (g *denseWeighted) Edges(f EdgeStep) {
+	g.Arcs(func(a Arc) bool {
+		return f(a)
+	})
+}
+
+// Traverses the set of arcs in the graph, passing each arc to the
+// provided closure.
+func (g *denseWeighted) Arcs(f ArcStep) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	for s, u := range g.vertices {
+		if u == nil {
+			continue
+		}
+		for t, w := range g.matrix[s][:len(g.vertices)] {
+			if !math.IsNaN(w) && f(NewWeightedArc(u, g.vertices[t], w)) {
+				return
+			}
+		}
+	}
+}
+
+// Indicates whether or not the given edge is present in the graph. It matches
+// based solely on the presence of an edge, disregarding edge weight.
+func (g *denseWeighted) HasEdge(edge Edge) bool {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	u, v := edge.Both()
+	_, exists := g.weight(u, v)
+	if !exists {
+		_, exists = g.weight(v, u)
+	}
+	return exists
+}
+
+// Indicates whether or not the given arc is present in the graph.
+func (g *denseWeighted) HasArc(arc Arc) bool {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	_, exists := g.weight(arc.Source(), arc.Target())
+	return exists
+}
+
+// Indicates whether or not the given weighted edge is present in the graph.
+// It will only match if the provided WeightedEdge has the same weight as
+// the edge contained in the graph.
+func (g *denseWeighted) HasWeightedEdge(edge WeightedEdge) bool {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	u, v := edge.Both()
+	if weight, exists := g.weight(u, v); exists {
+		return weight == edge.Weight()
+	} else if weight, exists = g.weight(v, u); exists {
+		return weight == edge.Weight()
+	}
+	return false
+}
+
+// Indicates whether or not the given weighted arc is present in the graph.
+// It will only match if the provided WeightedArc has the same weight as
+// the arc contained in the graph.
+func (g *denseWeighted) HasWeightedArc(arc WeightedArc) bool {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	weight, exists := g.weight(arc.Source(), arc.Target())
+	return exists && weight == arc.Weight()
+}
+
+// Indicates whether or not the graph's edges are directed. Always true for this type.
+func (g *denseWeighted) IsDirected() bool {
+	return true
+}
+
+// Returns the density of the graph. Density is the ratio of edge count to the
+// number of edges there would be in complete graph (maximum edge count).
+func (g *denseWeighted) Density() float64 {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	order := len(g.ids)
+	return float64(g.size) / float64(order*(order-1))
+}
+
+// Adds the provided vertices to the graph. If a provided vertex is
+// already present in the graph, it is a no-op (for that vertex only).
+func (g *denseWeighted) EnsureVertex(vertices ...Vertex) {
+	if len(vertices) == 0 {
+		return
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	for _, v := range vertices {
+		if v != nil {
+			g.ensureVertex(v)
+		}
+	}
+}
+
+// Removes a vertex from the graph. Also removes any edges of which that
+// vertex is a member. The vertex's id is released for reuse, so the matrix
+// does not grow under churn.
+func (g *denseWeighted) RemoveVertex(vertices ...Vertex) {
+	if len(vertices) == 0 {
+		return
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	for _, vertex := range vertices {
+		id, exists := g.ids[vertex]
+		if !exists {
+			continue
+		}
+
+		for other := range g.vertices {
+			if !math.IsNaN(g.matrix[id][other]) {
+				g.matrix[id][other] = absent
+				g.in[other]--
+				g.size--
+			}
+			if !math.IsNaN(g.matrix[other][id]) {
+				g.matrix[other][id] = absent
+				g.out[other]--
+				g.size--
+			}
+		}
+
+		g.in[id], g.out[id] = 0, 0
+		g.vertices[id] = nil
+		delete(g.ids, vertex)
+		g.free = append(g.free, id)
+	}
+}
+
+// Adds arcs to the graph. Arcs already present keep their existing weight.
+func (g *denseWeighted) AddArcs(arcs ...WeightedArc) {
+	if len(arcs) == 0 {
+		return
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.addArcs(arcs...)
+}
+
+func (g *denseWeighted) addArcs(arcs ...WeightedArc) {
+	for _, arc := range arcs {
+		s, t := arc.Both()
+		if s == nil || t == nil || math.IsNaN(arc.Weight()) {
+			continue
+		}
+
+		sid, tid := g.ensureVertex(s), g.ensureVertex(t)
+		if math.IsNaN(g.matrix[sid][tid]) {
+			g.matrix[sid][tid] = arc.Weight()
+			g.out[sid]++
+			g.in[tid]++
+			g.size++
+		}
+	}
+}
+
+// Removes arcs from the graph. This does NOT remove vertex members of the
+// removed arcs.
+func (g *denseWeighted) RemoveArcs(arcs ...WeightedArc) {
+	if len(arcs) == 0 {
+		return
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	for _, arc := range arcs {
+		g.removeArc(arc.Both())
+	}
+}
+
+func (g *denseWeighted) removeArc(s, t Vertex) {
+	sid, exists := g.ids[s]
+	if !exists {
+		return
+	}
+	tid, exists := g.ids[t]
+	if !exists {
+		return
+	}
+
+	if !math.IsNaN(g.matrix[sid][tid]) {
+		g.matrix[sid][tid] = absent
+		g.out[sid]--
+		g.in[tid]--
+		g.size--
+	}
+}
+
+// Adds edges to the graph, as arcs running from the first vertex each edge reports to
+// the second. This, with RemoveEdges, allows the matrix to be used wherever a
+// MutableWeightedGraph is expected.
+func (g *denseWeighted) AddEdges(edges ...WeightedEdge) {
+	if len(edges) == 0 {
+		return
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	for _, e := range edges {
+		u, v := e.Both()
+		g.addArcs(NewWeightedArc(u, v, e.Weight()))
+	}
+}
+
+// Removes edges from the graph, as arcs running from the first vertex each edge reports
+// to the second. This does NOT remove vertex members of the removed edges.
+func (g *denseWeighted) RemoveEdges(edges ...WeightedEdge) {
+	if len(edges) == 0 {
+		return
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	for _, e := range edges {
+		g.removeArc(e.Both())
+	}
+}
+
+// Adds delta to the weight of the arc from u to v, under the graph's lock. Returns the new
+// weight, or false if there is no such arc. See WeightAdjuster. As with AddArcs, NaN
+// weights cannot be stored; an adjustment that would produce one is not applied, and
+// returns the unchanged weight with false.
+func (g *denseWeighted) AdjustWeight(u, v Vertex, delta float64) (float64, bool) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	w, exists := g.weight(u, v)
+	if !exists || math.IsNaN(w+delta) {
+		// A NaN weight would read as an absent arc.
+		return w, false
+	}
+	w += delta
+	g.matrix[g.ids[u]][g.ids[v]] = w
+	return w, true
+}
+
+// Returns a new adjacency matrix with every arc reversed. Vertices keep their ids, so
+// the transpose is a straight transposition of the matrix.
+func (g *denseWeighted) Transpose() Digraph {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	g2 := newDenseWeighted(len(g.matrix))
+	g2.vertices = append(g2.vertices, g.vertices...)
+	g2.free = append(g2.free, g.free...)
+	for v, id := range g.ids {
+		g2.ids[v] = id
+	}
+	for u := range g.vertices {
+		for v := range g.vertices {
+			g2.matrix[v][u] = g.matrix[u][v]
+		}
+	}
+	copy(g2.in, g.out)
+	copy(g2.out, g.in)
+	g2.size = g.size
+
+	return g2
+}
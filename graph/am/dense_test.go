@@ -0,0 +1,174 @@
+package am
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	. "github.com/sdboyer/gocheck"
+	. "github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+	"github.com/sdboyer/gogl/spec"
+)
+
+// Hook gocheck into the go test runner
+func TestHookup(t *testing.T) { TestingT(t) }
+
+func init() {
+	spec.SetUpTestsFromSpec(GraphProperties(G_MUTABLE|G_DIRECTED|G_WEIGHTED|G_SIMPLE), G)
+}
+
+type DenseSuite struct{}
+
+var _ = Suite(&DenseSuite{})
+
+func (s *DenseSuite) TestDegreesAndReuse(c *C) {
+	g := NewDenseDirectedWeighted(2)
+	g.AddArcs(
+		NewWeightedArc("a", "b", 1),
+		NewWeightedArc("c", "b", 2),
+		NewWeightedArc("b", "b", 3),
+		NewWeightedArc("b", "d", 4),
+		NewWeightedArc("a", "d", math.NaN()),
+	)
+
+	in, _ := g.InDegreeOf("b")
+	out, _ := g.OutDegreeOf("b")
+	c.Assert(in, Equals, 3)
+	c.Assert(out, Equals, 2)
+	c.Assert(Size(g), Equals, 4)
+	c.Assert(g.HasArc(NewArc("a", "d")), Equals, false)
+
+	g.RemoveVertex("b")
+	c.Assert(Size(g), Equals, 0)
+	out, _ = g.OutDegreeOf("a")
+	in, _ = g.InDegreeOf("d")
+	c.Assert(out, Equals, 0)
+	c.Assert(in, Equals, 0)
+
+	// The freed id is reused, rather than growing the matrix.
+	rows := len(g.(*denseWeighted).matrix)
+	g.AddArcs(NewWeightedArc("e", "a", 5))
+	c.Assert(len(g.(*denseWeighted).matrix), Equals, rows)
+	c.Assert(g.HasWeightedArc(NewWeightedArc("e", "a", 5)), Equals, true)
+	c.Assert(Order(g), Equals, 4)
+}
+
+func (s *DenseSuite) TestAdjustWeightNaN(c *C) {
+	g := NewDenseDirectedWeighted(2)
+	g.AddArcs(NewWeightedArc("a", "b", math.Inf(1)))
+
+	// Inf + -Inf is NaN, which the matrix cannot hold.
+	w, ok := g.(WeightAdjuster).AdjustWeight("a", "b", math.Inf(-1))
+	c.Assert(ok, Equals, false)
+	c.Assert(w, Equals, math.Inf(1))
+	c.Assert(g.HasWeightedArc(NewWeightedArc("a", "b", math.Inf(1))), Equals, true)
+
+	w, ok = g.(WeightAdjuster).AdjustWeight("a", "b", 1)
+	c.Assert(ok, Equals, true)
+	c.Assert(w, Equals, math.Inf(1))
+}
+
+func (s *DenseSuite) TestMutableWeightedGraph(c *C) {
+	g, ok := NewDenseDirectedWeighted(0).(MutableWeightedGraph)
+	c.Assert(ok, Equals, true)
+
+	g.AddEdges(NewWeightedEdge("a", "b", 1), NewWeightedEdge("b", "c", 2))
+	c.Assert(g.(Digraph).HasArc(NewArc("a", "b")), Equals, true)
+	c.Assert(g.(Digraph).HasArc(NewArc("b", "a")), Equals, false)
+
+	g.RemoveEdges(NewWeightedEdge("a", "b", 1))
+	c.Assert(Size(g), Equals, 1)
+	c.Assert(Order(g), Equals, 3)
+}
+
+func (s *DenseSuite) TestMatchesAdjacencyList(c *C) {
+	r := rand.New(rand.NewSource(1))
+	arcs := WeightedArcList{}
+	for i := 0; i < 400; i++ {
+		arcs = append(arcs, NewWeightedArc(r.Intn(40), r.Intn(40), float64(r.Intn(10))))
+	}
+
+	dense := Spec().Directed().Weighted().Using(arcs).Create(G).(MutableWeightedDigraph)
+	list := Spec().Directed().Weighted().Using(arcs).Create(al.G).(MutableWeightedDigraph)
+	for i := 0; i < 20; i++ {
+		dense.RemoveVertex(i * 2)
+		list.RemoveVertex(i * 2)
+	}
+
+	c.Assert(Equal(dense, list), Equals, true)
+
+	transposed := dense.Transpose().(WeightedDigraph)
+	c.Assert(Size(transposed), Equals, Size(list))
+	list.Arcs(func(a Arc) (terminate bool) {
+		c.Assert(transposed.HasWeightedArc(NewWeightedArc(a.Target(), a.Source(), a.(WeightedArc).Weight())), Equals, true)
+		return
+	})
+
+	list.Vertices(func(v Vertex) (terminate bool) {
+		want, _ := list.InDegreeOf(v)
+		got, _ := dense.InDegreeOf(v)
+		c.Assert(got, Equals, want)
+		return
+	})
+}
+
+// Builds a digraph on n vertices with each possible arc present with 90% probability.
+func denseArcs(n int) WeightedArcList {
+	r := rand.New(rand.NewSource(1))
+	arcs := WeightedArcList{}
+	for u := 0; u < n; u++ {
+		for v := 0; v < n; v++ {
+			if u != v && r.Intn(10) != 0 {
+				arcs = append(arcs, NewWeightedArc(u, v, float64(r.Intn(100))))
+			}
+		}
+	}
+	return arcs
+}
+
+func benchmarkHasArc(b *testing.B, g WeightedDigraph, n int) {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		g.HasArc(NewArc(i%n, (i/n)%n))
+	}
+}
+
+func benchmarkInDegree(b *testing.B, g WeightedDigraph, n int) {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		g.InDegreeOf(i % n)
+	}
+}
+
+func BenchmarkHasArcDense(b *testing.B) {
+	benchmarkHasArc(b, Spec().Directed().Weighted().Using(denseArcs(300)).Create(G).(WeightedDigraph), 300)
+}
+
+func BenchmarkHasArcAdjacencyList(b *testing.B) {
+	benchmarkHasArc(b, Spec().Directed().Weighted().Using(denseArcs(300)).Create(al.G).(WeightedDigraph), 300)
+}
+
+func BenchmarkInDegreeDense(b *testing.B) {
+	benchmarkInDegree(b, Spec().Directed().Weighted().Using(denseArcs(300)).Create(G).(WeightedDigraph), 300)
+}
+
+func BenchmarkInDegreeAdjacencyList(b *testing.B) {
+	benchmarkInDegree(b, Spec().Directed().Weighted().Using(denseArcs(300)).Create(al.G).(WeightedDigraph), 300)
+}
+
+func BenchmarkOutDegreeDense(b *testing.B) {
+	g := Spec().Directed().Weighted().Using(denseArcs(300)).Create(G).(WeightedDigraph)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		g.OutDegreeOf(i % 300)
+	}
+}
+
+func BenchmarkOutDegreeAdjacencyList(b *testing.B) {
+	g := Spec().Directed().Weighted().Using(denseArcs(300)).Create(al.G).(WeightedDigraph)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		g.OutDegreeOf(i % 300)
+	}
+}
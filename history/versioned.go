@@ -0,0 +1,220 @@
+// Contains a graph wrapper that records the history of its mutations, allowing earlier
+// states of the graph to be reconstructed.
+package history
+
+import (
+	"sync"
+
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+type opKind int
+
+const (
+	ensureVertex opKind = iota
+	removeVertex
+	addEdges
+	removeEdges
+)
+
+// A single recorded mutation.
+type op struct {
+	kind     opKind
+	vertices []gogl.Vertex
+	edges    []gogl.Edge
+}
+
+// A copy of the graph as of some version.
+type snapshot struct {
+	version int
+	g       gogl.Graph
+}
+
+// A VersionedGraph wraps a mutable graph, recording every mutation made through it so that
+// the graph's state as of any earlier version can be reconstructed with AsOf.
+//
+// The wrapped graph is at version 0 when the VersionedGraph is created, and each call to
+// one of the mutation methods advances the version by one, however many vertices or edges
+// it touches. Mutations must be made through the VersionedGraph; changes made directly to
+// the wrapped graph go unrecorded. Read methods are those of the wrapped graph, and always
+// reflect the current version; the wrapped graph itself is available as the Graph field,
+// for type assertion to richer read interfaces such as Digraph.
+//
+// To bound the cost of reconstruction, a full copy of the graph is taken every so many
+// versions; AsOf starts from the latest copy at or before the requested version and
+// replays the mutations since. Memory use is thus proportional to the number of
+// mutations recorded, plus one copy of the graph per interval.
+//
+// All methods are safe for concurrent use.
+type VersionedGraph struct {
+	gogl.Graph
+	mu        sync.RWMutex
+	every     int
+	log       []op
+	snapshots []snapshot
+}
+
+// Creates a VersionedGraph wrapping the given graph, taking a full snapshot every
+// snapshotEvery versions. If snapshotEvery is less than 1, only the initial state is
+// snapshotted, and every reconstruction replays from the beginning.
+//
+// Panics if the graph cannot be mutated (see gogl.CanMutate).
+func NewVersionedGraph(g gogl.Graph, snapshotEvery int) *VersionedGraph {
+	if !gogl.CanMutate(g) {
+		panic("VersionedGraph requires a mutable graph.")
+	}
+
+	return &VersionedGraph{
+		Graph:     g,
+		every:     snapshotEvery,
+		snapshots: []snapshot{{0, clone(g)}},
+	}
+}
+
+// Returns the current version: the number of mutations recorded so far.
+func (vg *VersionedGraph) Version() int {
+	vg.mu.RLock()
+	defer vg.mu.RUnlock()
+	return len(vg.log)
+}
+
+// Returns a copy of the graph as it was at the given version, where version 0 is its state
+// when wrapped. The copy is a new graph of the same kind, and is independent of the
+// VersionedGraph. Panics if the version is negative or greater than the current version.
+func (vg *VersionedGraph) AsOf(version int) gogl.Graph {
+	vg.mu.RLock()
+	defer vg.mu.RUnlock()
+
+	if version < 0 || version > len(vg.log) {
+		panic("Requested version is out of range.")
+	}
+
+	snap := vg.snapshots[0]
+	for _, s := range vg.snapshots {
+		if s.version > version {
+			break
+		}
+		snap = s
+	}
+
+	g := clone(snap.g)
+	for _, o := range vg.log[snap.version:version] {
+		apply(g, o)
+	}
+	return g
+}
+
+// Adds the provided vertices to the graph, as a single version.
+func (vg *VersionedGraph) EnsureVertex(vertices ...gogl.Vertex) {
+	vg.record(op{kind: ensureVertex, vertices: vertices})
+}
+
+// Removes the provided vertices, and any edges incident to them, as a single version.
+func (vg *VersionedGraph) RemoveVertex(vertices ...gogl.Vertex) {
+	vg.record(op{kind: removeVertex, vertices: vertices})
+}
+
+// Adds the provided edges to the graph, as a single version. The edges must be of the
+// kind the wrapped graph takes - arcs for a digraph, weighted edges for a weighted graph,
+// and so on.
+func (vg *VersionedGraph) AddEdges(edges ...gogl.Edge) {
+	vg.record(op{kind: addEdges, edges: edges})
+}
+
+// Removes the provided edges from the graph, as a single version. As with AddEdges, the
+// edges must be of the kind the wrapped graph takes.
+func (vg *VersionedGraph) RemoveEdges(edges ...gogl.Edge) {
+	vg.record(op{kind: removeEdges, edges: edges})
+}
+
+// Applies the given mutation to the current graph and logs it, snapshotting if due.
+func (vg *VersionedGraph) record(o op) {
+	vg.mu.Lock()
+	defer vg.mu.Unlock()
+
+	// Copy the caller's slices, as they may be reused.
+	o.vertices = append([]gogl.Vertex(nil), o.vertices...)
+	o.edges = append([]gogl.Edge(nil), o.edges...)
+
+	apply(vg.Graph, o)
+	vg.log = append(vg.log, o)
+
+	if vg.every > 0 && len(vg.log)%vg.every == 0 {
+		vg.snapshots = append(vg.snapshots, snapshot{len(vg.log), clone(vg.Graph)})
+	}
+}
+
+// Creates an independent copy of the given graph, of the same kind.
+func clone(g gogl.Graph) gogl.Graph {
+	return gogl.SpecOf(g).Using(g).Create(al.G)
+}
+
+// Applies a recorded mutation to the given graph.
+func apply(g gogl.Graph, o op) {
+	switch o.kind {
+	case ensureVertex:
+		g.(gogl.VertexSetMutator).EnsureVertex(o.vertices...)
+	case removeVertex:
+		g.(gogl.VertexSetMutator).RemoveVertex(o.vertices...)
+	case addEdges, removeEdges:
+		add := o.kind == addEdges
+		for _, e := range o.edges {
+			mutateEdge(g, e, add)
+		}
+	}
+}
+
+// Adds or removes a single edge, via whichever edge or arc mutator the graph implements.
+func mutateEdge(g gogl.Graph, e gogl.Edge, add bool) {
+	switch m := g.(type) {
+	case gogl.WeightedArcSetMutator:
+		if add {
+			m.AddArcs(e.(gogl.WeightedArc))
+		} else {
+			m.RemoveArcs(e.(gogl.WeightedArc))
+		}
+	case gogl.WeightedEdgeSetMutator:
+		if add {
+			m.AddEdges(e.(gogl.WeightedEdge))
+		} else {
+			m.RemoveEdges(e.(gogl.WeightedEdge))
+		}
+	case gogl.LabeledArcSetMutator:
+		if add {
+			m.AddArcs(e.(gogl.LabeledArc))
+		} else {
+			m.RemoveArcs(e.(gogl.LabeledArc))
+		}
+	case gogl.LabeledEdgeSetMutator:
+		if add {
+			m.AddEdges(e.(gogl.LabeledEdge))
+		} else {
+			m.RemoveEdges(e.(gogl.LabeledEdge))
+		}
+	case gogl.DataArcSetMutator:
+		if add {
+			m.AddArcs(e.(gogl.DataArc))
+		} else {
+			m.RemoveArcs(e.(gogl.DataArc))
+		}
+	case gogl.DataEdgeSetMutator:
+		if add {
+			m.AddEdges(e.(gogl.DataEdge))
+		} else {
+			m.RemoveEdges(e.(gogl.DataEdge))
+		}
+	case gogl.ArcSetMutator:
+		if add {
+			m.AddArcs(e.(gogl.Arc))
+		} else {
+			m.RemoveArcs(e.(gogl.Arc))
+		}
+	case gogl.EdgeSetMutator:
+		if add {
+			m.AddEdges(e)
+		} else {
+			m.RemoveEdges(e)
+		}
+	}
+}
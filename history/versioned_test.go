@@ -0,0 +1,81 @@
+package history
+
+import (
+	"testing"
+
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+// Hook gocheck into the go test runner
+func Test(t *testing.T) { TestingT(t) }
+
+type VersionedSuite struct{}
+
+var _ = Suite(&VersionedSuite{})
+
+func (s *VersionedSuite) TestAsOf(c *C) {
+	base := gogl.Spec().Using(gogl.EdgeList{gogl.NewEdge("a", "b")}).Create(al.G)
+	vg := NewVersionedGraph(base, 2)
+	c.Assert(vg.Version(), Equals, 0)
+
+	vg.AddEdges(gogl.NewEdge("b", "c"))    // 1
+	vg.EnsureVertex("z")                   // 2
+	vg.AddEdges(gogl.NewEdge("c", "d"))    // 3
+	vg.RemoveEdges(gogl.NewEdge("a", "b")) // 4
+	vg.RemoveVertex("c")                   // 5
+	c.Assert(vg.Version(), Equals, 5)
+
+	c.Assert(gogl.Equal(vg.AsOf(0), gogl.Spec().Using(gogl.EdgeList{
+		gogl.NewEdge("a", "b"),
+	}).Create(al.G)), Equals, true)
+
+	// Before and after c-d was added.
+	c.Assert(vg.AsOf(2).HasEdge(gogl.NewEdge("c", "d")), Equals, false)
+	c.Assert(vg.AsOf(2).HasVertex("z"), Equals, true)
+	c.Assert(vg.AsOf(3).HasEdge(gogl.NewEdge("c", "d")), Equals, true)
+	c.Assert(gogl.Size(vg.AsOf(3)), Equals, 3)
+
+	c.Assert(gogl.Equal(vg.AsOf(5), vg.Graph), Equals, true)
+	c.Assert(vg.HasVertex("c"), Equals, false)
+	c.Assert(gogl.Size(vg), Equals, 0)
+
+	// Reconstructions are independent copies.
+	old := vg.AsOf(1)
+	old.(gogl.MutableGraph).AddEdges(gogl.NewEdge("x", "y"))
+	c.Assert(vg.AsOf(1).HasVertex("x"), Equals, false)
+
+	c.Assert(func() { vg.AsOf(6) }, PanicMatches, "Requested version is out of range.")
+	c.Assert(func() { vg.AsOf(-1) }, PanicMatches, "Requested version is out of range.")
+}
+
+func (s *VersionedSuite) TestSnapshotsAgreeWithReplay(c *C) {
+	spec := gogl.Spec().Directed().Weighted()
+	snapshotted := NewVersionedGraph(spec.Create(al.G), 3)
+	replayed := NewVersionedGraph(spec.Create(al.G), 0)
+
+	for i := 0; i < 20; i++ {
+		for _, vg := range []*VersionedGraph{snapshotted, replayed} {
+			vg.AddEdges(gogl.NewWeightedArc(i, i+1, float64(i)))
+			if i%4 == 3 {
+				vg.RemoveVertex(i - 2)
+			}
+		}
+	}
+
+	c.Assert(snapshotted.snapshots, HasLen, 9)
+	c.Assert(replayed.snapshots, HasLen, 1)
+	for v := 0; v <= snapshotted.Version(); v++ {
+		g := snapshotted.AsOf(v)
+		c.Assert(gogl.Equal(g, replayed.AsOf(v)), Equals, true)
+		_, directed := g.(gogl.WeightedDigraph)
+		c.Assert(directed, Equals, true)
+	}
+	c.Assert(snapshotted.AsOf(1).(gogl.WeightedDigraph).HasWeightedArc(gogl.NewWeightedArc(0, 1, 0)), Equals, true)
+}
+
+func (s *VersionedSuite) TestImmutable(c *C) {
+	c.Assert(func() { NewVersionedGraph(gogl.Spec().Immutable().Directed().Create(al.G), 1) },
+		PanicMatches, "VersionedGraph requires a mutable graph.")
+}
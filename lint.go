@@ -0,0 +1,119 @@
+package gogl
+
+import (
+	"fmt"
+	"sort"
+)
+
+// A WarningKind identifies a type of structural anomaly reported by Lint.
+type WarningKind int
+
+const (
+	// A vertex with no incident edges. In digraphs, a vertex with both zero in-degree
+	// and zero out-degree.
+	LintIsolatedVertex WarningKind = iota
+	// A loop in a graph that reports itself as simple.
+	LintSelfLoop
+	// In an undirected graph, a vertex that is reported as adjacent to another, but not
+	// the other way around.
+	LintAsymmetricAdjacency
+)
+
+func (k WarningKind) String() string {
+	switch k {
+	case LintIsolatedVertex:
+		return "isolated vertex"
+	case LintSelfLoop:
+		return "self-loop in simple graph"
+	case LintAsymmetricAdjacency:
+		return "asymmetric adjacency"
+	}
+	return fmt.Sprintf("WarningKind(%d)", int(k))
+}
+
+// A Warning describes a single anomaly found by Lint, along with the offending element.
+// Vertex is set for isolated vertices; Edge is set for loops and asymmetric adjacencies.
+// For the latter, the edge runs from the vertex that reports the adjacency to the vertex
+// that does not reciprocate it.
+type Warning struct {
+	Kind   WarningKind
+	Vertex Vertex
+	Edge   Edge
+}
+
+func (w Warning) String() string {
+	if w.Edge != nil {
+		u, v := w.Edge.Both()
+		return fmt.Sprintf("%s: %v-%v", w.Kind, u, v)
+	}
+	return fmt.Sprintf("%s: %v", w.Kind, w.Vertex)
+}
+
+// Inspects the given graph for structural oddities, returning a Warning for each one
+// found. Nothing reported is necessarily an error, but each is worth a look before
+// processing imported data:
+//
+//   - Vertices with no incident edges (in digraphs, with neither in- nor out-arcs).
+//   - Loops, if the graph implements SimpleGraph.
+//   - In undirected graphs, adjacencies that are not reported from both endpoints, which
+//     indicates a broken Graph implementation.
+//
+// Warnings are grouped by kind, in the above order, and sorted within each group. A
+// clean graph yields an empty result.
+func Lint(g Graph) []Warning {
+	warnings := []Warning{}
+
+	dg, directed := g.(Digraph)
+	VerticesSorted(g, func(v Vertex) (terminate bool) {
+		var degree int
+		if directed {
+			in, _ := dg.InDegreeOf(v)
+			out, _ := dg.OutDegreeOf(v)
+			degree = in + out
+		} else {
+			degree, _ = g.DegreeOf(v)
+		}
+
+		if degree == 0 {
+			warnings = append(warnings, Warning{Kind: LintIsolatedVertex, Vertex: v})
+		}
+		return
+	})
+
+	if _, simple := g.(SimpleGraph); simple {
+		EdgesSorted(g, func(e Edge) (terminate bool) {
+			if u, v := e.Both(); u == v {
+				warnings = append(warnings, Warning{Kind: LintSelfLoop, Edge: e})
+			}
+			return
+		})
+	}
+
+	if !directed {
+		VerticesSorted(g, func(v Vertex) (terminate bool) {
+			var adjacent []Vertex
+			g.AdjacentTo(v, func(w Vertex) (terminate bool) {
+				adjacent = append(adjacent, w)
+				return
+			})
+			sort.Slice(adjacent, func(i, j int) bool {
+				return VertexLess(adjacent[i], adjacent[j])
+			})
+
+			for _, w := range adjacent {
+				var reciprocated bool
+				g.AdjacentTo(w, func(x Vertex) (terminate bool) {
+					reciprocated = x == v
+					return reciprocated
+				})
+
+				if !reciprocated {
+					warnings = append(warnings, Warning{Kind: LintAsymmetricAdjacency, Edge: NewEdge(v, w)})
+				}
+			}
+			return
+		})
+	}
+
+	return warnings
+}
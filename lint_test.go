@@ -0,0 +1,74 @@
+package gogl_test
+
+import (
+	. "github.com/sdboyer/gocheck"
+	. "github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+type LintSuite struct{}
+
+var _ = Suite(&LintSuite{})
+
+// Wraps a graph, hiding the adjacency from one vertex to another.
+type lopsided struct {
+	SimpleGraph
+	from, to Vertex
+}
+
+func (g lopsided) AdjacentTo(v Vertex, f VertexStep) {
+	g.SimpleGraph.AdjacentTo(v, func(w Vertex) (terminate bool) {
+		if v == g.from && w == g.to {
+			return
+		}
+		return f(w)
+	})
+}
+
+func (s *LintSuite) TestClean(c *C) {
+	g := Spec().Using(EdgeList{
+		NewEdge("a", "b"),
+		NewEdge("b", "c"),
+	}).Create(al.G)
+	c.Assert(Lint(g), DeepEquals, []Warning{})
+
+	dg := Spec().Directed().Using(ArcList{
+		NewArc("a", "b"),
+		NewArc("c", "b"),
+	}).Create(al.G)
+	c.Assert(Lint(dg), DeepEquals, []Warning{})
+}
+
+func (s *LintSuite) TestMalformed(c *C) {
+	base := Spec().Using(WithIsolates(EdgeList{
+		NewEdge("a", "b"),
+		NewEdge("b", "c"),
+		NewEdge("c", "c"),
+	}, "z")).Create(al.G).(SimpleGraph)
+
+	w := Lint(lopsided{base, "b", "a"})
+	c.Assert(w, HasLen, 3)
+
+	c.Assert(w[0].Kind, Equals, LintIsolatedVertex)
+	c.Assert(w[0].Vertex, Equals, "z")
+
+	c.Assert(w[1].Kind, Equals, LintSelfLoop)
+	u, v := w[1].Edge.Both()
+	c.Assert([]Vertex{u, v}, DeepEquals, []Vertex{"c", "c"})
+
+	// a reports b as adjacent, but b does not reciprocate.
+	c.Assert(w[2].Kind, Equals, LintAsymmetricAdjacency)
+	u, v = w[2].Edge.Both()
+	c.Assert([]Vertex{u, v}, DeepEquals, []Vertex{"a", "b"})
+	c.Assert(w[2].String(), Equals, "asymmetric adjacency: a-b")
+}
+
+func (s *LintSuite) TestDirectedIsolated(c *C) {
+	// b has an in-arc and c an out-arc, so only d is isolated.
+	g := Spec().Directed().Using(WithIsolates(ArcList{
+		NewArc("a", "b"),
+		NewArc("c", "a"),
+	}, "d")).Create(al.G)
+
+	c.Assert(Lint(g), DeepEquals, []Warning{{Kind: LintIsolatedVertex, Vertex: "d"}})
+}
@@ -0,0 +1,168 @@
+// Contains algos for finding minimum spanning trees and their directed analogues.
+package mst
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/sdboyer/gogl"
+)
+
+// An arc in the working graph of Edmonds' algorithm, with vertices renumbered to ints.
+// orig is the index of the arc this one derives from, one level up the contraction.
+type earc struct {
+	u, v int
+	w    float64
+	orig int
+}
+
+// Finds a minimum spanning arborescence of the given digraph rooted at the given vertex:
+// the set of arcs of least total weight such that every vertex is reachable from root
+// by exactly one path. This is the directed analogue of a minimum spanning tree.
+//
+// Chu-Liu/Edmonds' algorithm is used, running in O(VE) time. The returned arcs are those
+// from the graph itself. An error is returned if root is not present in the graph, or if
+// any vertex is unreachable from it.
+func MinimumArborescence(g gogl.WeightedDigraph, root gogl.Vertex) (gogl.WeightedArcList, float64, error) {
+	if !g.HasVertex(root) {
+		return nil, 0, errors.New("Root vertex is not present in the graph.")
+	}
+
+	vertices := gogl.CollectVertices(g)
+	index := make(map[gogl.Vertex]int, len(vertices))
+	for i, v := range vertices {
+		index[v] = i
+	}
+
+	var arcs []gogl.WeightedArc
+	var earcs []earc
+	g.Arcs(func(a gogl.Arc) (terminate bool) {
+		u, v := a.Both()
+		if u != v {
+			wa := a.(gogl.WeightedArc)
+			earcs = append(earcs, earc{index[u], index[v], wa.Weight(), len(arcs)})
+			arcs = append(arcs, wa)
+		}
+		return
+	})
+
+	chosen, unreached := edmonds(len(vertices), index[root], earcs)
+	if unreached >= 0 {
+		return nil, 0, fmt.Errorf("Vertex %v is unreachable from root %v.", vertices[unreached], root)
+	}
+
+	result := make(gogl.WeightedArcList, 0, len(chosen))
+	var total float64
+	for _, k := range chosen {
+		result = append(result, arcs[k])
+		total += arcs[k].Weight()
+	}
+
+	return result, total, nil
+}
+
+// Runs one level of Edmonds' algorithm over n vertices, returning the indices (into
+// arcs) of the arborescence's arcs. If some vertex is unreachable from the root, it is
+// returned as the second value (which is otherwise -1).
+func edmonds(n, root int, arcs []earc) (chosen []int, unreached int) {
+	// Cheapest incoming arc for each vertex
+	in := make([]int, n)
+	for v := range in {
+		in[v] = -1
+	}
+	for k, a := range arcs {
+		if a.v != root && a.u != a.v && (in[a.v] == -1 || a.w < arcs[in[a.v]].w) {
+			in[a.v] = k
+		}
+	}
+	for v := range in {
+		if v != root && in[v] == -1 {
+			return nil, v
+		}
+	}
+
+	// Look for cycles among the cheapest incoming arcs, numbering each cycle as a
+	// single new vertex and every other vertex on its own.
+	comp := make([]int, n)
+	mark := make([]int, n)
+	for v := range comp {
+		comp[v], mark[v] = -1, -1
+	}
+
+	var ncomp int
+	var cycles bool
+	for s := 0; s < n; s++ {
+		v := s
+		for v != root && mark[v] == -1 && comp[v] == -1 {
+			mark[v] = s
+			v = arcs[in[v]].u
+		}
+		// Walking back hit a vertex first marked on this very walk: a new cycle.
+		if v != root && mark[v] == s && comp[v] == -1 {
+			cycles = true
+			for x := arcs[in[v]].u; x != v; x = arcs[in[x]].u {
+				comp[x] = ncomp
+			}
+			comp[v] = ncomp
+			ncomp++
+		}
+	}
+
+	if !cycles {
+		for v := range in {
+			if v != root {
+				chosen = append(chosen, in[v])
+			}
+		}
+		return chosen, -1
+	}
+
+	for v := range comp {
+		if comp[v] == -1 {
+			comp[v] = ncomp
+			ncomp++
+		}
+	}
+
+	// Contract the cycles, reducing the weight of each arc entering a cycle by the weight
+	// of the cycle arc it would displace.
+	var contracted []earc
+	for k, a := range arcs {
+		cu, cv := comp[a.u], comp[a.v]
+		if cu == cv {
+			continue
+		}
+		contracted = append(contracted, earc{cu, cv, a.w - arcs[in[a.v]].w, k})
+	}
+
+	sub, unreached := edmonds(ncomp, comp[root], contracted)
+	if unreached >= 0 {
+		// A cycle with no arc entering it from outside; report one of its members.
+		for v := range comp {
+			if comp[v] == unreached {
+				return nil, v
+			}
+		}
+	}
+
+	// Expand: take each chosen arc, and for each cycle, every cycle arc except the one
+	// into the vertex where the chosen arc enters.
+	entered := make([]bool, n)
+	for _, k := range sub {
+		orig := contracted[k].orig
+		chosen = append(chosen, orig)
+		entered[arcs[orig].v] = true
+	}
+
+	for v := range in {
+		if v == root || entered[v] {
+			continue
+		}
+		// Vertices outside cycles got their arc from the contracted graph already.
+		if comp[arcs[in[v]].u] == comp[v] {
+			chosen = append(chosen, in[v])
+		}
+	}
+
+	return chosen, -1
+}
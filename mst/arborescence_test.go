@@ -0,0 +1,138 @@
+package mst
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+// Hook gocheck into the go test runner
+func TestHookup(t *testing.T) { TestingT(t) }
+
+type ArborescenceSuite struct{}
+
+var _ = Suite(&ArborescenceSuite{})
+
+func wdg(arcs gogl.WeightedArcList) gogl.WeightedDigraph {
+	return gogl.Spec().Directed().Weighted().Using(arcs).Create(al.G).(gogl.WeightedDigraph)
+}
+
+func (s *ArborescenceSuite) TestKnownOptimum(c *C) {
+	// The cheap 1-2-3 cycle must be broken where it is cheapest to enter.
+	g := wdg(gogl.WeightedArcList{
+		gogl.NewWeightedArc(0, 1, 10),
+		gogl.NewWeightedArc(0, 2, 3),
+		gogl.NewWeightedArc(0, 3, 12),
+		gogl.NewWeightedArc(1, 2, 1),
+		gogl.NewWeightedArc(2, 3, 1),
+		gogl.NewWeightedArc(3, 1, 1),
+		gogl.NewWeightedArc(3, 4, 5),
+		gogl.NewWeightedArc(1, 4, 9),
+	})
+
+	arcs, total, err := MinimumArborescence(g, 0)
+	c.Assert(err, IsNil)
+	c.Assert(total, Equals, 10.0)
+	c.Assert(arcs, HasLen, 4)
+
+	for _, a := range arcs {
+		c.Assert(g.HasWeightedArc(a.(gogl.WeightedArc)), Equals, true)
+	}
+
+	tree := gogl.Spec().Directed().Using(arcs).Create(al.G).(gogl.Digraph)
+	c.Assert(tree.HasArc(gogl.NewArc(0, 2)), Equals, true)
+	c.Assert(tree.HasArc(gogl.NewArc(2, 3)), Equals, true)
+	c.Assert(tree.HasArc(gogl.NewArc(3, 1)), Equals, true)
+	c.Assert(tree.HasArc(gogl.NewArc(3, 4)), Equals, true)
+}
+
+func (s *ArborescenceSuite) TestErrors(c *C) {
+	g := wdg(gogl.WeightedArcList{
+		gogl.NewWeightedArc(0, 1, 1),
+		gogl.NewWeightedArc(2, 3, 1),
+		gogl.NewWeightedArc(3, 2, 1),
+	})
+
+	_, _, err := MinimumArborescence(g, "nope")
+	c.Assert(err, NotNil)
+
+	// 2 and 3 form a cycle that nothing enters
+	_, _, err = MinimumArborescence(g, 0)
+	c.Assert(err, ErrorMatches, "Vertex [23] is unreachable from root 0.")
+}
+
+// Brute force: try every choice of one incoming arc per non-root vertex, keeping the
+// cheapest choice in which every vertex leads back to the root.
+func bruteForce(n int, arcs gogl.WeightedArcList) float64 {
+	in := make([][]gogl.WeightedArc, n)
+	for _, a := range arcs {
+		wa := a.(gogl.WeightedArc)
+		u, v := wa.Both()
+		if v.(int) != 0 && u != v {
+			in[v.(int)] = append(in[v.(int)], wa)
+		}
+	}
+
+	best := math.Inf(1)
+	pick := make([]gogl.WeightedArc, n)
+	var try func(v int)
+	try = func(v int) {
+		if v == n {
+			var total float64
+			for x := 1; x < n; x++ {
+				total += pick[x].Weight()
+				// follow parents; must reach the root within n steps
+				y, steps := x, 0
+				for y != 0 && steps <= n {
+					y = pick[y].Source().(int)
+					steps++
+				}
+				if y != 0 {
+					return
+				}
+			}
+			if total < best {
+				best = total
+			}
+			return
+		}
+		for _, a := range in[v] {
+			pick[v] = a
+			try(v + 1)
+		}
+	}
+	try(1)
+
+	return best
+}
+
+func (s *ArborescenceSuite) TestAgainstBruteForce(c *C) {
+	r := rand.New(rand.NewSource(7))
+	for trial := 0; trial < 30; trial++ {
+		n := 5
+		arcs := gogl.WeightedArcList{}
+		for u := 0; u < n; u++ {
+			for v := 1; v < n; v++ {
+				if u != v && r.Intn(2) == 0 {
+					arcs = append(arcs, gogl.NewWeightedArc(u, v, float64(r.Intn(20))))
+				}
+			}
+		}
+
+		expect := bruteForce(n, arcs)
+		g := gogl.Spec().Directed().Weighted().Using(gogl.WithIsolates(arcs, 0, 1, 2, 3, 4)).Create(al.G).(gogl.WeightedDigraph)
+		result, total, err := MinimumArborescence(g, 0)
+		if math.IsInf(expect, 1) {
+			c.Assert(err, NotNil)
+			continue
+		}
+
+		c.Assert(err, IsNil)
+		c.Assert(total, Equals, expect)
+		c.Assert(result, HasLen, n-1)
+	}
+}
@@ -0,0 +1,124 @@
+package mst
+
+import (
+	"errors"
+	"math/rand"
+	"sort"
+
+	"github.com/sdboyer/gogl"
+)
+
+// A step available to the random walk: the edge taken, where it leads, and its weight.
+type step struct {
+	e  gogl.Edge
+	to gogl.Vertex
+	w  float64
+}
+
+// Samples a spanning tree of the given graph using Wilson's algorithm (loop-erased random
+// walks), returning its edges (from the graph itself).
+//
+// For unweighted graphs the tree is drawn uniformly at random from all spanning trees. If
+// the graph is a WeightedGraph, walks follow each edge with probability proportional to its
+// weight, so each tree is drawn with probability proportional to the product of its edge
+// weights; edges with non-positive weight are never used. Arc direction is disregarded in
+// digraphs.
+//
+// All randomness comes from the provided source, so a given seed and graph always yield the
+// same tree. An error is returned if the graph is not connected.
+func RandomSpanningTree(g gogl.Graph, r *rand.Rand) (gogl.EdgeList, error) {
+	var vertices []gogl.Vertex
+	gogl.VerticesSorted(g, func(v gogl.Vertex) (terminate bool) {
+		vertices = append(vertices, v)
+		return
+	})
+
+	if len(vertices) == 0 {
+		return gogl.EdgeList{}, nil
+	}
+
+	_, weighted := g.(gogl.WeightedGraph)
+	steps := make(map[gogl.Vertex][]step, len(vertices))
+	for _, v := range vertices {
+		var s []step
+		g.IncidentTo(v, func(e gogl.Edge) (terminate bool) {
+			u, w := e.Both()
+			if u == w {
+				return
+			}
+			if u == v {
+				u = w
+			}
+
+			st := step{e: e, to: u, w: 1}
+			if weighted {
+				st.w = e.(gogl.WeightedEdge).Weight()
+				if st.w <= 0 {
+					return
+				}
+			}
+			s = append(s, st)
+			return
+		})
+		// Incident edges arrive in map order; sort so the walk depends only on the seed.
+		sort.Slice(s, func(i, j int) bool { return gogl.VertexLess(s[i].to, s[j].to) })
+		steps[v] = s
+	}
+
+	if !stepsConnected(vertices, steps) {
+		return nil, errors.New("Graph is not connected, so it has no spanning tree.")
+	}
+
+	inTree := map[gogl.Vertex]bool{vertices[0]: true}
+	next := make(map[gogl.Vertex]step, len(vertices))
+	tree := make(gogl.EdgeList, 0, len(vertices)-1)
+
+	for _, v := range vertices[1:] {
+		// Walk until hitting the tree. Overwriting next[u] on each visit erases loops.
+		for u := v; !inTree[u]; u = next[u].to {
+			next[u] = pickStep(steps[u], r)
+		}
+
+		for u := v; !inTree[u]; u = next[u].to {
+			inTree[u] = true
+			tree = append(tree, next[u].e)
+		}
+	}
+
+	return tree, nil
+}
+
+// Picks one of the given steps at random, with probability proportional to its weight.
+func pickStep(s []step, r *rand.Rand) step {
+	var total float64
+	for _, st := range s {
+		total += st.w
+	}
+
+	x := r.Float64() * total
+	for _, st := range s {
+		if x < st.w {
+			return st
+		}
+		x -= st.w
+	}
+	// Only reachable through floating point error.
+	return s[len(s)-1]
+}
+
+// Indicates whether all the given vertices are reachable from the first via the steps.
+func stepsConnected(vertices []gogl.Vertex, steps map[gogl.Vertex][]step) bool {
+	seen := map[gogl.Vertex]bool{vertices[0]: true}
+	queue := []gogl.Vertex{vertices[0]}
+	for len(queue) > 0 {
+		v := queue[0]
+		queue = queue[1:]
+		for _, st := range steps[v] {
+			if !seen[st.to] {
+				seen[st.to] = true
+				queue = append(queue, st.to)
+			}
+		}
+	}
+	return len(seen) == len(vertices)
+}
@@ -0,0 +1,97 @@
+package mst
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+type RandomSpanningSuite struct{}
+
+var _ = Suite(&RandomSpanningSuite{})
+
+// The complete graph on 4 vertices, which has 16 spanning trees, plus a loop.
+var k4 = gogl.EdgeList{
+	gogl.NewEdge(1, 2),
+	gogl.NewEdge(1, 3),
+	gogl.NewEdge(1, 4),
+	gogl.NewEdge(2, 3),
+	gogl.NewEdge(2, 4),
+	gogl.NewEdge(3, 4),
+	gogl.NewEdge(4, 4),
+}
+
+func (s *RandomSpanningSuite) TestValidTrees(c *C) {
+	g := gogl.Spec().Using(k4).Create(al.G)
+
+	distinct := make(map[string]bool)
+	for seed := int64(0); seed < 300; seed++ {
+		tree, err := RandomSpanningTree(g, rand.New(rand.NewSource(seed)))
+		c.Assert(err, IsNil)
+		c.Assert(tree, HasLen, gogl.Order(g)-1)
+
+		for _, e := range tree {
+			c.Assert(g.HasEdge(e), Equals, true)
+		}
+
+		tg := gogl.Spec().Using(gogl.WithIsolates(tree, 1, 2, 3, 4)).Create(al.G)
+		c.Assert(isForest(tg), Equals, true)
+
+		distinct[treeKey(tree)] = true
+	}
+
+	c.Assert(distinct, HasLen, 16)
+}
+
+func (s *RandomSpanningSuite) TestReproducible(c *C) {
+	g := gogl.Spec().Using(k4).Create(al.G)
+
+	a, _ := RandomSpanningTree(g, rand.New(rand.NewSource(42)))
+	b, _ := RandomSpanningTree(g, rand.New(rand.NewSource(42)))
+	c.Assert(a, DeepEquals, b)
+}
+
+func (s *RandomSpanningSuite) TestWeighted(c *C) {
+	// A zero-weight edge is never used.
+	el := gogl.WeightedEdgeList{
+		gogl.NewWeightedEdge("a", "b", 1),
+		gogl.NewWeightedEdge("b", "c", 1),
+		gogl.NewWeightedEdge("a", "c", 0),
+	}
+	g := gogl.Spec().Weighted().Using(el).Create(al.G)
+
+	for seed := int64(0); seed < 20; seed++ {
+		tree, err := RandomSpanningTree(g, rand.New(rand.NewSource(seed)))
+		c.Assert(err, IsNil)
+		c.Assert(tree, HasLen, 2)
+		for _, e := range tree {
+			c.Assert(e.(gogl.WeightedEdge).Weight(), Equals, 1.0)
+		}
+	}
+}
+
+func (s *RandomSpanningSuite) TestDisconnected(c *C) {
+	g := gogl.Spec().Using(gogl.EdgeList{gogl.NewEdge(1, 2), gogl.NewEdge(3, 4)}).Create(al.G)
+
+	_, err := RandomSpanningTree(g, rand.New(rand.NewSource(1)))
+	c.Assert(err, ErrorMatches, ".*not connected.*")
+
+	tree, err := RandomSpanningTree(gogl.Spec().Create(al.G), rand.New(rand.NewSource(1)))
+	c.Assert(err, IsNil)
+	c.Assert(tree, HasLen, 0)
+}
+
+// Renders a set of edges in a canonical form, for comparison.
+func treeKey(edges gogl.EdgeList) string {
+	keys := make([]string, 0, len(edges))
+	for _, e := range edges {
+		u, v := orderedEnds(e)
+		keys = append(keys, fmt.Sprintf("%v-%v", u, v))
+	}
+	sort.Strings(keys)
+	return fmt.Sprint(keys)
+}
@@ -0,0 +1,81 @@
+package mst
+
+import (
+	"github.com/sdboyer/gogl"
+)
+
+// Traces the number of connected components in the given graph as the edges in order are
+// removed from it, one at a time. The returned slice is aligned with order: its i'th
+// element is the component count after the first i+1 removals. The graph itself is not
+// modified.
+//
+// Rather than recomputing components after every removal, the trace is built offline, in
+// reverse: starting from the graph with every listed edge removed, the edges are added
+// back in reverse order into a union-find structure. The component count is always the
+// order of the graph less the size of this spanning forest, so the whole trace costs
+// near-linear time.
+//
+// Edges in order that are not present in the graph, or that were already removed earlier
+// in the order, leave the count unchanged. For digraphs, weakly connected components are
+// counted, and each edge is taken as an arc from the first vertex returned by Both() to
+// the second.
+func RemovalComponentTrace(g gogl.Graph, order gogl.EdgeList) []int {
+	dg, directed := g.(gogl.Digraph)
+	key := func(e gogl.Edge) [2]gogl.Vertex {
+		u, v := e.Both()
+		if !directed && gogl.VertexLess(v, u) {
+			u, v = v, u
+		}
+		return [2]gogl.Vertex{u, v}
+	}
+	present := func(e gogl.Edge) bool {
+		if directed {
+			u, v := e.Both()
+			return dg.HasArc(gogl.NewArc(u, v))
+		}
+		return g.HasEdge(e)
+	}
+
+	// Note which positions in the order actually remove an edge.
+	removed := make(map[[2]gogl.Vertex]bool)
+	effective := make([]bool, len(order))
+	for i, e := range order {
+		k := key(e)
+		if !removed[k] && present(e) {
+			removed[k] = true
+			effective[i] = true
+		}
+	}
+
+	uf := newUnionFind()
+	components := gogl.Order(g)
+	survivor := func(e gogl.Edge) (terminate bool) {
+		if !removed[key(e)] {
+			u, v := e.Both()
+			if uf.union(u, v) {
+				components--
+			}
+		}
+		return
+	}
+	if directed {
+		dg.Arcs(func(a gogl.Arc) (terminate bool) {
+			return survivor(a)
+		})
+	} else {
+		g.Edges(survivor)
+	}
+
+	trace := make([]int, len(order))
+	for i := len(order) - 1; i >= 0; i-- {
+		trace[i] = components
+		if effective[i] {
+			u, v := order[i].Both()
+			if uf.union(u, v) {
+				components--
+			}
+		}
+	}
+
+	return trace
+}
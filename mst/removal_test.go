@@ -0,0 +1,104 @@
+package mst
+
+import (
+	"math/rand"
+
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+type RemovalTraceSuite struct{}
+
+var _ = Suite(&RemovalTraceSuite{})
+
+// Counts connected components from scratch, disregarding arc direction.
+func countComponents(g gogl.Graph) int {
+	seen := make(map[gogl.Vertex]bool)
+	var count int
+	g.Vertices(func(v gogl.Vertex) (terminate bool) {
+		if seen[v] {
+			return
+		}
+		count++
+		queue := []gogl.Vertex{v}
+		seen[v] = true
+		for len(queue) > 0 {
+			u := queue[0]
+			queue = queue[1:]
+			g.AdjacentTo(u, func(w gogl.Vertex) (terminate bool) {
+				if !seen[w] {
+					seen[w] = true
+					queue = append(queue, w)
+				}
+				return
+			})
+		}
+		return
+	})
+	return count
+}
+
+func (s *RemovalTraceSuite) TestSmall(c *C) {
+	// A triangle with a pendant vertex, plus an isolate.
+	el := gogl.EdgeList{
+		gogl.NewEdge("a", "b"),
+		gogl.NewEdge("b", "c"),
+		gogl.NewEdge("c", "a"),
+		gogl.NewEdge("c", "d"),
+	}
+	g := gogl.Spec().Using(gogl.WithIsolates(el, "e")).Create(al.G)
+
+	order := gogl.EdgeList{
+		gogl.NewEdge("b", "a"), // within the cycle: no change
+		gogl.NewEdge("x", "y"), // absent: no change
+		gogl.NewEdge("c", "d"), // d splits off
+		gogl.NewEdge("a", "b"), // already removed
+		gogl.NewEdge("a", "c"), // a splits off
+		gogl.NewEdge("b", "c"),
+	}
+	c.Assert(RemovalComponentTrace(g, order), DeepEquals, []int{2, 2, 3, 3, 4, 5})
+	c.Assert(gogl.Size(g), Equals, 4)
+}
+
+func (s *RemovalTraceSuite) TestMatchesNaive(c *C) {
+	r := rand.New(rand.NewSource(17))
+	for _, directed := range []bool{false, true} {
+		arcs := gogl.ArcList{}
+		for i := 0; i < 60; i++ {
+			arcs = append(arcs, gogl.NewArc(r.Intn(30), r.Intn(30)))
+		}
+
+		var g gogl.Graph
+		if directed {
+			g = gogl.Spec().Directed().Using(arcs).Create(al.G)
+		} else {
+			el := gogl.EdgeList{}
+			for _, a := range arcs {
+				el = append(el, a)
+			}
+			g = gogl.Spec().Using(el).Create(al.G)
+		}
+
+		order := gogl.EdgeList{}
+		for _, i := range r.Perm(len(arcs)) {
+			order = append(order, arcs[i])
+		}
+		trace := RemovalComponentTrace(g, order)
+
+		var m gogl.Graph
+		if directed {
+			m = gogl.Spec().Directed().Using(arcs).Create(al.G)
+		} else {
+			m = gogl.Spec().Using(g).Create(al.G)
+		}
+		for i, e := range order {
+			if directed {
+				m.(gogl.MutableDigraph).RemoveArcs(e.(gogl.Arc))
+			} else {
+				m.(gogl.MutableGraph).RemoveEdges(e)
+			}
+			c.Assert(trace[i], Equals, countComponents(m), Commentf("after removing %v", e))
+		}
+	}
+}
@@ -0,0 +1,182 @@
+package mst
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/sdboyer/gogl"
+)
+
+// Finds a minimum spanning tree of the given undirected graph using Kruskal's algorithm,
+// returning its edges (from the graph itself) and their total weight. Edges are sorted by
+// weight, then added in turn unless a union-find structure shows their endpoints are
+// already connected, for O(E log E) time overall.
+//
+// If the graph is not connected, the minimum spanning forest - a minimum spanning tree of
+// each component - is returned along with its weight, and with an error noting that it
+// is not a spanning tree. Minimum spanning trees are not defined by arc direction, so an
+// error is returned, and no edges, for a Digraph; see MinimumArborescence for the directed
+// analogue.
+func MinimumSpanningTree(g gogl.WeightedGraph) (gogl.WeightedEdgeList, float64, error) {
+	if _, directed := g.(gogl.Digraph); directed {
+		return nil, 0, errors.New("Minimum spanning trees require an undirected graph.")
+	}
+
+	tree, _ := kruskal(g, func(a, b float64) bool { return a < b })
+	if order := gogl.Order(g); order > 0 && len(tree) < order-1 {
+		return tree, totalWeight(tree), errors.New("Graph is not connected; result is a minimum spanning forest.")
+	}
+	return tree, totalWeight(tree), nil
+}
+
+// Finds a maximum-weight spanning forest of the given graph using Kruskal's algorithm,
+// returning its edges (from the graph itself) and their total weight. If the graph is
+// connected, this is a spanning tree.
+//
+// Arc direction is disregarded in digraphs; the forest spans the underlying undirected
+// graph.
+func MaxWeightSpanningTree(g gogl.WeightedGraph) (gogl.WeightedEdgeList, float64) {
+	tree, _ := kruskal(g, func(a, b float64) bool { return a > b })
+	return tree, totalWeight(tree)
+}
+
+// Finds a minimum bottleneck spanning tree of the given undirected graph: a spanning tree
+// whose heaviest edge is as light as possible. Returns its edges (from the graph itself)
+// and that bottleneck weight, which is 0 if there are no edges.
+//
+// Every minimum spanning tree is also a minimum bottleneck spanning tree, so this is
+// computed by Kruskal's algorithm; the point is to report the bottleneck directly, which
+// is what matters when minimizing worst-case rather than total load. As with
+// MinimumSpanningTree, if the graph is not connected the spanning forest is returned,
+// with the heaviest edge across all its trees as the bottleneck, and with an error noting
+// that it is not a spanning tree; and an error is returned, and no edges, for a Digraph.
+func MinimumBottleneckSpanningTree(g gogl.WeightedGraph) (gogl.WeightedEdgeList, float64, error) {
+	if _, directed := g.(gogl.Digraph); directed {
+		return nil, 0, errors.New("Minimum bottleneck spanning trees require an undirected graph.")
+	}
+
+	tree, _ := kruskal(g, func(a, b float64) bool { return a < b })
+
+	var bottleneck float64
+	for k, e := range tree {
+		if k == 0 || e.Weight() > bottleneck {
+			bottleneck = e.Weight()
+		}
+	}
+
+	if order := gogl.Order(g); order > 0 && len(tree) < order-1 {
+		return tree, bottleneck, errors.New("Graph is not connected; result is a minimum bottleneck spanning forest.")
+	}
+	return tree, bottleneck, nil
+}
+
+// Finds a minimum-weight feedback edge set of the given graph: the set of edges of least
+// total weight whose removal leaves the graph acyclic (a forest). Returns the edges (from
+// the graph itself) and their total weight.
+//
+// The set is exactly the complement of a maximum-weight spanning forest (see
+// MaxWeightSpanningTree), so the two partition the graph's edges. Loops are always part
+// of the feedback set. As with MaxWeightSpanningTree, arc direction is disregarded.
+func MinWeightFeedbackEdgeSet(g gogl.WeightedGraph) (gogl.WeightedEdgeList, float64) {
+	_, rest := kruskal(g, func(a, b float64) bool { return a > b })
+	return rest, totalWeight(rest)
+}
+
+// Runs Kruskal's algorithm, considering edges in the order their weights are sorted by
+// the given func. Returns the edges of the resulting spanning forest, and all others.
+func kruskal(g gogl.WeightedGraph, before func(a, b float64) bool) (tree, rest gogl.WeightedEdgeList) {
+	var edges gogl.WeightedEdgeList
+	g.Edges(func(e gogl.Edge) (terminate bool) {
+		edges = append(edges, e.(gogl.WeightedEdge))
+		return
+	})
+
+	// Ties are broken by endpoints, for reproducible results.
+	sort.SliceStable(edges, func(i, j int) bool {
+		wi, wj := edges[i].Weight(), edges[j].Weight()
+		if wi != wj {
+			return before(wi, wj)
+		}
+		iu, iv := orderedEnds(edges[i])
+		ju, jv := orderedEnds(edges[j])
+		if iu != ju {
+			return gogl.VertexLess(iu, ju)
+		}
+		return gogl.VertexLess(iv, jv)
+	})
+
+	uf := newUnionFind()
+	for _, e := range edges {
+		u, v := e.Both()
+		if uf.union(u, v) {
+			tree = append(tree, e)
+		} else {
+			rest = append(rest, e)
+		}
+	}
+
+	return
+}
+
+// Returns an edge's endpoints, lowest first per gogl.VertexLess.
+func orderedEnds(e gogl.Edge) (gogl.Vertex, gogl.Vertex) {
+	u, v := e.Both()
+	if gogl.VertexLess(v, u) {
+		return v, u
+	}
+	return u, v
+}
+
+func totalWeight(edges gogl.WeightedEdgeList) (total float64) {
+	for _, e := range edges {
+		total += e.Weight()
+	}
+	return
+}
+
+// A disjoint-set forest over vertices, with path compression and union by rank.
+type unionFind struct {
+	parent map[gogl.Vertex]gogl.Vertex
+	rank   map[gogl.Vertex]int
+}
+
+func newUnionFind() *unionFind {
+	return &unionFind{
+		parent: make(map[gogl.Vertex]gogl.Vertex),
+		rank:   make(map[gogl.Vertex]int),
+	}
+}
+
+func (uf *unionFind) find(v gogl.Vertex) gogl.Vertex {
+	p, exists := uf.parent[v]
+	if !exists {
+		uf.parent[v] = v
+		return v
+	}
+	if p == v {
+		return v
+	}
+
+	root := uf.find(p)
+	uf.parent[v] = root
+	return root
+}
+
+// Merges the sets containing u and v. Returns false if they were already the same set.
+func (uf *unionFind) union(u, v gogl.Vertex) bool {
+	ru, rv := uf.find(u), uf.find(v)
+	if ru == rv {
+		return false
+	}
+
+	switch {
+	case uf.rank[ru] < uf.rank[rv]:
+		uf.parent[ru] = rv
+	case uf.rank[ru] > uf.rank[rv]:
+		uf.parent[rv] = ru
+	default:
+		uf.parent[rv] = ru
+		uf.rank[ru]++
+	}
+	return true
+}
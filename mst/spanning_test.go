@@ -0,0 +1,152 @@
+package mst
+
+import (
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+type SpanningSuite struct{}
+
+var _ = Suite(&SpanningSuite{})
+
+// Two triangles sharing vertex c, a pendant, a loop, and a separate component.
+var spanningFixture = gogl.WeightedEdgeList{
+	gogl.NewWeightedEdge("a", "b", 4),
+	gogl.NewWeightedEdge("b", "c", 1),
+	gogl.NewWeightedEdge("a", "c", 3),
+	gogl.NewWeightedEdge("c", "d", 2),
+	gogl.NewWeightedEdge("d", "e", 5),
+	gogl.NewWeightedEdge("c", "e", 6),
+	gogl.NewWeightedEdge("e", "f", 1),
+	gogl.NewWeightedEdge("f", "f", 9),
+	gogl.NewWeightedEdge("x", "y", 2),
+}
+
+func (s *SpanningSuite) TestMaxWeightSpanningTree(c *C) {
+	g := gogl.Spec().Weighted().Using(spanningFixture).Create(al.G).(gogl.WeightedGraph)
+
+	tree, total := MaxWeightSpanningTree(g)
+	// a-b 4, a-c 3, c-e 6, d-e 5, e-f 1, x-y 2
+	c.Assert(tree, HasLen, 6)
+	c.Assert(total, Equals, 21.0)
+}
+
+func (s *SpanningSuite) TestMinimumSpanningTree(c *C) {
+	g := gogl.Spec().Weighted().Using(spanningFixture[:8]).Create(al.G).(gogl.WeightedGraph)
+
+	tree, total, err := MinimumSpanningTree(g)
+	c.Assert(err, IsNil)
+	// b-c 1, e-f 1, c-d 2, a-c 3, d-e 5
+	c.Assert(tree, HasLen, 5)
+	c.Assert(total, Equals, 12.0)
+	c.Assert(isForest(gogl.Spec().Weighted().Using(tree).Create(al.G)), Equals, true)
+
+	// With the separate x-y component, a forest is returned.
+	g = gogl.Spec().Weighted().Using(spanningFixture).Create(al.G).(gogl.WeightedGraph)
+	tree, total, err = MinimumSpanningTree(g)
+	c.Assert(err, ErrorMatches, "Graph is not connected.*")
+	c.Assert(tree, HasLen, 6)
+	c.Assert(total, Equals, 14.0)
+
+	dg := gogl.Spec().Directed().Weighted().Using(gogl.WeightedArcList{
+		gogl.NewWeightedArc("a", "b", 1),
+	}).Create(al.G).(gogl.WeightedGraph)
+	tree, _, err = MinimumSpanningTree(dg)
+	c.Assert(err, ErrorMatches, ".*require an undirected graph.*")
+	c.Assert(tree, IsNil)
+}
+
+func (s *SpanningSuite) TestMinimumBottleneckSpanningTree(c *C) {
+	g := gogl.Spec().Weighted().Using(spanningFixture[:8]).Create(al.G).(gogl.WeightedGraph)
+
+	tree, bottleneck, err := MinimumBottleneckSpanningTree(g)
+	c.Assert(err, IsNil)
+	c.Assert(tree, HasLen, 5)
+	c.Assert(bottleneck, Equals, 5.0)
+
+	mstEdges, _, err := MinimumSpanningTree(g)
+	c.Assert(err, IsNil)
+	var heaviest float64
+	for _, e := range mstEdges {
+		if e.Weight() > heaviest {
+			heaviest = e.Weight()
+		}
+	}
+	c.Assert(bottleneck, Equals, heaviest)
+
+	// The forest of a disconnected graph, and negative weights.
+	g = gogl.Spec().Weighted().Using(gogl.WeightedEdgeList{
+		gogl.NewWeightedEdge("a", "b", -4),
+		gogl.NewWeightedEdge("b", "c", -1),
+		gogl.NewWeightedEdge("a", "c", -2),
+		gogl.NewWeightedEdge("x", "y", -3),
+	}).Create(al.G).(gogl.WeightedGraph)
+	tree, bottleneck, err = MinimumBottleneckSpanningTree(g)
+	c.Assert(err, ErrorMatches, "Graph is not connected.*")
+	c.Assert(tree, HasLen, 3)
+	c.Assert(bottleneck, Equals, -2.0)
+
+	tree, bottleneck, err = MinimumBottleneckSpanningTree(gogl.Spec().Weighted().Create(al.G).(gogl.WeightedGraph))
+	c.Assert(err, IsNil)
+	c.Assert(tree, HasLen, 0)
+	c.Assert(bottleneck, Equals, 0.0)
+
+	dg := gogl.Spec().Directed().Weighted().Using(gogl.WeightedArcList{
+		gogl.NewWeightedArc("a", "b", 1),
+	}).Create(al.G).(gogl.WeightedGraph)
+	tree, _, err = MinimumBottleneckSpanningTree(dg)
+	c.Assert(err, ErrorMatches, ".*require an undirected graph.*")
+	c.Assert(tree, IsNil)
+}
+
+func (s *SpanningSuite) TestFeedbackEdgeSetPartitions(c *C) {
+	g := gogl.Spec().Weighted().Using(spanningFixture).Create(al.G).(gogl.WeightedGraph)
+
+	tree, _ := MaxWeightSpanningTree(g)
+	fes, total := MinWeightFeedbackEdgeSet(g)
+	c.Assert(total, Equals, 12.0) // b-c 1, c-d 2, f-f 9
+
+	c.Assert(len(tree)+len(fes), Equals, gogl.Size(g))
+	seen := make(map[[2]gogl.Vertex]bool)
+	for _, e := range append(append(gogl.WeightedEdgeList{}, tree...), fes...) {
+		u, v := orderedEnds(e)
+		c.Assert(seen[[2]gogl.Vertex{u, v}], Equals, false)
+		seen[[2]gogl.Vertex{u, v}] = true
+		c.Assert(g.HasWeightedEdge(e), Equals, true)
+	}
+
+	// removing the feedback set leaves a forest
+	m := gogl.Spec().Weighted().Using(spanningFixture).Create(al.G).(gogl.MutableWeightedGraph)
+	m.RemoveEdges(fes...)
+	c.Assert(gogl.Size(m), Equals, len(tree))
+	c.Assert(isForest(m), Equals, true)
+}
+
+// An undirected graph is a forest iff each component has one fewer edge than it has
+// vertices.
+func isForest(g gogl.Graph) bool {
+	components := 0
+	seen := make(map[gogl.Vertex]bool)
+	g.Vertices(func(v gogl.Vertex) (terminate bool) {
+		if seen[v] {
+			return
+		}
+		components++
+		seen[v] = true
+		queue := []gogl.Vertex{v}
+		for len(queue) > 0 {
+			u := queue[0]
+			queue = queue[1:]
+			g.AdjacentTo(u, func(w gogl.Vertex) (terminate bool) {
+				if !seen[w] {
+					seen[w] = true
+					queue = append(queue, w)
+				}
+				return
+			})
+		}
+		return
+	})
+	return gogl.Size(g) == gogl.Order(g)-components
+}
@@ -64,6 +64,11 @@ func (g nullGraph) HasDataEdge(e DataEdge) bool {
 	return false
 }
 
+// The null graph implements Digraph, so it reports itself as directed.
+func (g nullGraph) IsDirected() bool {
+	return true
+}
+
 func (g nullGraph) Density() float64 {
 	return math.NaN()
 }
@@ -70,3 +70,7 @@ func (s NullGraphSuite) TestSizingOps(c *C) {
 func (s NullGraphSuite) TestTranspose(c *C) {
 	c.Assert(NullGraph.Transpose(), Equals, NullGraph)
 }
+
+func (s NullGraphSuite) TestIsDirected(c *C) {
+	c.Assert(NullGraph.IsDirected(), Equals, true) // because it implements Digraph
+}
@@ -0,0 +1,111 @@
+package gogl
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// Enumerates a graph's vertices in the order given by the provided comparator, rather
+// than whatever order the graph happens to produce them in.
+//
+// All vertices are collected and sorted before the step function is first called, so this
+// is best suited to cases where reproducibility matters more than speed: tests, exports,
+// layout, and the like.
+func VerticesOrdered(g VertexEnumerator, less func(a, b Vertex) bool, f VertexStep) {
+	vertices := CollectVertices(g)
+	sort.SliceStable(vertices, func(i, j int) bool {
+		return less(vertices[i], vertices[j])
+	})
+
+	for _, v := range vertices {
+		if f(v) {
+			return
+		}
+	}
+}
+
+// Enumerates a graph's vertices in their natural order (see VertexLess).
+func VerticesSorted(g VertexEnumerator, f VertexStep) {
+	VerticesOrdered(g, VertexLess, f)
+}
+
+// Enumerates a graph's edges in the order given by the provided comparator, rather than
+// whatever order the graph happens to produce them in.
+//
+// All edges are collected and sorted before the step function is first called, so this is
+// best suited to cases where reproducibility matters more than speed: tests, exports, and
+// the like.
+func EdgesOrdered(g EdgeEnumerator, less func(a, b Edge) bool, f EdgeStep) {
+	edges := CollectEdges(g)
+	sort.SliceStable(edges, func(i, j int) bool {
+		return less(edges[i], edges[j])
+	})
+
+	for _, e := range edges {
+		if f(e) {
+			return
+		}
+	}
+}
+
+// Enumerates a graph's edges sorted by their endpoints, in the natural order of the
+// vertices (see VertexLess).
+//
+// The endpoints of an undirected edge are compared lowest-first, so the order is stable
+// regardless of which way round the graph reports each edge. Note that the edges are
+// still passed to the step function exactly as the graph reports them.
+func EdgesSorted(g EdgeEnumerator, f EdgeStep) {
+	undirected := false
+	if dc, ok := g.(DirectednessChecker); ok {
+		undirected = !dc.IsDirected()
+	}
+
+	ends := func(e Edge) (Vertex, Vertex) {
+		u, v := e.Both()
+		if undirected && VertexLess(v, u) {
+			return v, u
+		}
+		return u, v
+	}
+
+	EdgesOrdered(g, func(a, b Edge) bool {
+		au, av := ends(a)
+		bu, bv := ends(b)
+		if VertexLess(au, bu) {
+			return true
+		}
+		if VertexLess(bu, au) {
+			return false
+		}
+		return VertexLess(av, bv)
+	}, f)
+}
+
+// VertexLess imposes a natural order on vertices. Vertices of the same numeric or string
+// kind are compared by value; bools sort false first. All others (including vertices of
+// differing kinds) are compared by their string representation (via %v), then by the
+// name of their type.
+func VertexLess(a, b Vertex) bool {
+	ra, rb := reflect.ValueOf(a), reflect.ValueOf(b)
+	if ra.IsValid() && rb.IsValid() && ra.Kind() == rb.Kind() {
+		switch ra.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return ra.Int() < rb.Int()
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			return ra.Uint() < rb.Uint()
+		case reflect.Float32, reflect.Float64:
+			return ra.Float() < rb.Float()
+		case reflect.String:
+			return ra.String() < rb.String()
+		case reflect.Bool:
+			return !ra.Bool() && rb.Bool()
+		}
+	}
+
+	sa, sb := fmt.Sprintf("%v", a), fmt.Sprintf("%v", b)
+	if sa != sb {
+		return sa < sb
+	}
+	return fmt.Sprintf("%T", a) < fmt.Sprintf("%T", b)
+}
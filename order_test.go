@@ -0,0 +1,128 @@
+package gogl_test
+
+import (
+	. "github.com/sdboyer/gocheck"
+	. "github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+type OrderSuite struct{}
+
+var _ = Suite(&OrderSuite{})
+
+func (s *OrderSuite) TestVertexLess(c *C) {
+	c.Assert(VertexLess(2, 10), Equals, true)
+	c.Assert(VertexLess(10, 2), Equals, false)
+	c.Assert(VertexLess("bar", "foo"), Equals, true)
+	c.Assert(VertexLess(1.5, 0.5), Equals, false)
+	c.Assert(VertexLess(false, true), Equals, true)
+	c.Assert(VertexLess("a", "a"), Equals, false)
+
+	// mixed kinds fall back on string representation, then type
+	c.Assert(VertexLess(10, "9"), Equals, true)
+	c.Assert(VertexLess(1, "1") != VertexLess("1", 1), Equals, true)
+}
+
+func (s *OrderSuite) TestVerticesOrdered(c *C) {
+	g := Spec().Using(EdgeList{
+		NewEdge(10, 2),
+		NewEdge(2, 33),
+		NewEdge(1, 4),
+	}).Create(al.G)
+
+	collect := func(less func(a, b Vertex) bool) (vertices []Vertex) {
+		VerticesOrdered(g, less, func(v Vertex) (terminate bool) {
+			vertices = append(vertices, v)
+			return
+		})
+		return
+	}
+
+	desc := func(a, b Vertex) bool { return a.(int) > b.(int) }
+	first := collect(desc)
+	c.Assert(first, DeepEquals, []Vertex{33, 10, 4, 2, 1})
+	for i := 0; i < 10; i++ {
+		c.Assert(collect(desc), DeepEquals, first)
+	}
+
+	var sorted []Vertex
+	VerticesSorted(g, func(v Vertex) (terminate bool) {
+		sorted = append(sorted, v)
+		return len(sorted) == 4
+	})
+	c.Assert(sorted, DeepEquals, []Vertex{1, 2, 4, 10})
+}
+
+func collectOrdered(g EdgeEnumerator, less func(a, b Edge) bool) (edges [][2]Vertex) {
+	EdgesOrdered(g, less, func(e Edge) (terminate bool) {
+		u, v := e.Both()
+		edges = append(edges, [2]Vertex{u, v})
+		return
+	})
+	return
+}
+
+func (s *OrderSuite) TestEdgesOrdered(c *C) {
+	g := Spec().Directed().Weighted().Using(WeightedArcList{
+		NewWeightedArc(1, 2, 3),
+		NewWeightedArc(2, 3, 1),
+		NewWeightedArc(3, 1, 2),
+		NewWeightedArc(1, 3, 4),
+	}).Create(al.G)
+
+	byWeight := func(a, b Edge) bool {
+		return a.(WeightedEdge).Weight() < b.(WeightedEdge).Weight()
+	}
+
+	first := collectOrdered(g, byWeight)
+	c.Assert(first, DeepEquals, [][2]Vertex{{2, 3}, {3, 1}, {1, 2}, {1, 3}})
+	for i := 0; i < 10; i++ {
+		c.Assert(collectOrdered(g, byWeight), DeepEquals, first)
+	}
+
+	var n int
+	EdgesOrdered(g, byWeight, func(e Edge) (terminate bool) {
+		n++
+		return true
+	})
+	c.Assert(n, Equals, 1)
+}
+
+func (s *OrderSuite) TestEdgesSorted(c *C) {
+	g := Spec().Using(EdgeList{
+		NewEdge("foo", "bar"),
+		NewEdge("baz", "qux"),
+		NewEdge("bar", "baz"),
+	}).Create(al.G)
+
+	var first []Edge
+	EdgesSorted(g, func(e Edge) (terminate bool) {
+		first = append(first, e)
+		return
+	})
+	c.Assert(first, HasLen, 3)
+
+	// undirected edges are ordered by their lowest endpoint first
+	expect := [][2]Vertex{{"bar", "baz"}, {"bar", "foo"}, {"baz", "qux"}}
+	for k, e := range first {
+		u, v := e.Both()
+		if VertexLess(v, u) {
+			u, v = v, u
+		}
+		c.Assert([2]Vertex{u, v}, Equals, expect[k])
+	}
+
+	d := Spec().Directed().Using(ArcList{
+		NewArc(2, 1),
+		NewArc(10, 1),
+		NewArc(1, 2),
+	}).Create(al.G)
+
+	var arcs [][2]Vertex
+	EdgesSorted(d, func(e Edge) (terminate bool) {
+		u, v := e.Both()
+		arcs = append(arcs, [2]Vertex{u, v})
+		return
+	})
+	c.Assert(arcs, DeepEquals, [][2]Vertex{{1, 2}, {2, 1}, {10, 1}})
+}
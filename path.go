@@ -0,0 +1,99 @@
+package gogl
+
+// A Path is a sequence of edges describing a walk through a graph, from the first
+// edge's source to the last edge's target.
+type Path []Edge
+
+// Sums the weights of the edges along the given path, as they are currently recorded in
+// the graph; any weights carried by the path's own edges are disregarded. The second
+// return value is false if any edge in the path is not present in the graph.
+//
+// In directed graphs, each edge of the path must be present as an arc from its source
+// to its target. In undirected graphs, edges are matched regardless of orientation.
+func PathWeight(g WeightedGraph, p Path) (weight float64, exists bool) {
+	for _, e := range p {
+		w, has := edgeWeight(g, e)
+		if !has {
+			return 0, false
+		}
+		weight += w
+	}
+
+	return weight, true
+}
+
+// Looks up the current weight of the given edge in the graph.
+func edgeWeight(g WeightedGraph, e Edge) (weight float64, exists bool) {
+	u, v := e.Both()
+
+	if dg, ok := g.(Digraph); ok {
+		dg.ArcsFrom(u, func(a Arc) (terminate bool) {
+			if a.Target() == v {
+				weight, exists = a.(WeightedEdge).Weight(), true
+			}
+			return exists
+		})
+		return
+	}
+
+	g.IncidentTo(u, func(ie Edge) (terminate bool) {
+		a, b := ie.Both()
+		if (a == u && b == v) || (a == v && b == u) {
+			weight, exists = ie.(WeightedEdge).Weight(), true
+		}
+		return exists
+	})
+	return
+}
+
+// Indicates whether the given path is a genuine walk through the graph: each of its edges
+// must be present in the graph, and each must begin where the previous one ended.
+//
+// In directed graphs, each edge must be present as an arc, and the target of each must be
+// the source of the next. In undirected graphs, edges may be traversed in either
+// orientation, so it suffices that consecutive edges share a vertex in a way that forms a
+// continuous walk. An empty path is trivially valid.
+func IsValidPath(g Graph, p Path) bool {
+	if dg, ok := g.(Digraph); ok {
+		var at Vertex
+		for k, e := range p {
+			u, v := e.Both()
+			if !dg.HasArc(NewArc(u, v)) || (k > 0 && at != u) {
+				return false
+			}
+			at = v
+		}
+		return true
+	}
+
+	// The vertices at which the walk may currently be; an undirected walk's first edge
+	// could be traversed either way, so there may be two.
+	var at []Vertex
+	for k, e := range p {
+		if !g.HasEdge(e) {
+			return false
+		}
+
+		u, v := e.Both()
+		if k == 0 {
+			at = []Vertex{v, u}
+			continue
+		}
+
+		var next []Vertex
+		for _, x := range at {
+			if x == u {
+				next = append(next, v)
+			} else if x == v {
+				next = append(next, u)
+			}
+		}
+
+		if len(next) == 0 {
+			return false
+		}
+		at = next
+	}
+
+	return true
+}
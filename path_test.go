@@ -0,0 +1,85 @@
+package gogl_test
+
+import (
+	. "github.com/sdboyer/gocheck"
+	. "github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+type PathSuite struct{}
+
+var _ = Suite(&PathSuite{})
+
+func (s *PathSuite) TestPathWeight(c *C) {
+	g := Spec().Weighted().Using(WeightedEdgeList{
+		NewWeightedEdge("a", "b", 1.5),
+		NewWeightedEdge("b", "c", 2),
+		NewWeightedEdge("c", "d", -0.5),
+	}).Create(al.G).(WeightedGraph)
+
+	// path edges' own weights are ignored, and orientation doesn't matter
+	w, ok := PathWeight(g, Path{NewEdge("a", "b"), NewWeightedEdge("c", "b", 100), NewEdge("c", "d")})
+	c.Assert(ok, Equals, true)
+	c.Assert(w, Equals, 3.0)
+
+	_, ok = PathWeight(g, Path{NewEdge("a", "b"), NewEdge("b", "d")})
+	c.Assert(ok, Equals, false)
+
+	w, ok = PathWeight(g, Path{})
+	c.Assert(ok, Equals, true)
+	c.Assert(w, Equals, 0.0)
+}
+
+func (s *PathSuite) TestPathWeightDirected(c *C) {
+	g := Spec().Directed().Weighted().Using(WeightedArcList{
+		NewWeightedArc("a", "b", 1.5),
+		NewWeightedArc("b", "c", 2),
+	}).Create(al.G).(WeightedGraph)
+
+	w, ok := PathWeight(g, Path{NewArc("a", "b"), NewArc("b", "c")})
+	c.Assert(ok, Equals, true)
+	c.Assert(w, Equals, 3.5)
+
+	_, ok = PathWeight(g, Path{NewArc("b", "a")})
+	c.Assert(ok, Equals, false)
+}
+
+func (s *PathSuite) TestIsValidPath(c *C) {
+	g := Spec().Using(EdgeList{
+		NewEdge("a", "b"),
+		NewEdge("b", "c"),
+		NewEdge("c", "d"),
+		NewEdge("e", "f"),
+	}).Create(al.G)
+
+	c.Assert(IsValidPath(g, Path{}), Equals, true)
+	c.Assert(IsValidPath(g, Path{NewEdge("a", "b"), NewEdge("b", "c"), NewEdge("c", "d")}), Equals, true)
+	// undirected edges may be given in either orientation
+	c.Assert(IsValidPath(g, Path{NewEdge("b", "a"), NewEdge("c", "b"), NewEdge("c", "d")}), Equals, true)
+	// walks may revisit edges
+	c.Assert(IsValidPath(g, Path{NewEdge("a", "b"), NewEdge("b", "a"), NewEdge("a", "b")}), Equals, true)
+
+	// gap between b-c and e-f
+	c.Assert(IsValidPath(g, Path{NewEdge("b", "c"), NewEdge("e", "f")}), Equals, false)
+	// a-b then c-d; they don't share a vertex
+	c.Assert(IsValidPath(g, Path{NewEdge("a", "b"), NewEdge("c", "d")}), Equals, false)
+	// a-b, b-c, then back to b-a would require being at b after b-c
+	c.Assert(IsValidPath(g, Path{NewEdge("a", "b"), NewEdge("b", "c"), NewEdge("b", "a")}), Equals, false)
+	// nonexistent edge
+	c.Assert(IsValidPath(g, Path{NewEdge("a", "b"), NewEdge("b", "d")}), Equals, false)
+}
+
+func (s *PathSuite) TestIsValidPathDirected(c *C) {
+	g := Spec().Directed().Using(ArcList{
+		NewArc("a", "b"),
+		NewArc("b", "c"),
+		NewArc("d", "c"),
+	}).Create(al.G)
+
+	c.Assert(IsValidPath(g, Path{NewArc("a", "b"), NewArc("b", "c")}), Equals, true)
+	// arcs must be followed in their direction
+	c.Assert(IsValidPath(g, Path{NewArc("b", "a")}), Equals, false)
+	c.Assert(IsValidPath(g, Path{NewArc("b", "c"), NewArc("d", "c")}), Equals, false)
+	// gap
+	c.Assert(IsValidPath(g, Path{NewArc("a", "b"), NewArc("d", "c")}), Equals, false)
+}
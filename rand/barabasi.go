@@ -0,0 +1,59 @@
+package rand
+
+import (
+	stdrand "math/rand"
+
+	"github.com/sdboyer/gogl"
+)
+
+// Generates a random scale-free graph of vertex count n by Barabási-Albert preferential
+// attachment, with vertices numbered 0 through n-1.
+//
+// Generation begins from a complete graph on the first m+1 vertices; each subsequent
+// vertex then attaches to m distinct existing vertices, chosen with probability
+// proportional to their current degree. The resulting degree distribution follows a power
+// law with exponent approaching 3 as n grows. The graph is simple and undirected.
+//
+// m must be at least 1 and less than n, else panic. If no rand source is provided, the
+// stdlib math's global rand source is used.
+func BarabasiAlbert(n, m uint, src stdrand.Source) gogl.GraphSource {
+	if m < 1 || m >= n {
+		panic("m must be in the range [1,n).")
+	}
+
+	intn := stdrand.Intn
+	if src != nil {
+		intn = stdrand.New(src).Intn
+	}
+
+	// Each vertex appears here once per incident edge, so a uniform draw from this list
+	// is a degree-proportional draw of a vertex.
+	var ends []int
+	el := gogl.EdgeList{}
+
+	for u := 0; u <= int(m); u++ {
+		for v := u + 1; v <= int(m); v++ {
+			el = append(el, gogl.NewEdge(u, v))
+			ends = append(ends, u, v)
+		}
+	}
+
+	for u := int(m) + 1; u < int(n); u++ {
+		targets := make(map[int]struct{}, m)
+		order := make([]int, 0, m)
+		for len(targets) < int(m) {
+			v := ends[intn(len(ends))]
+			if _, dup := targets[v]; !dup {
+				targets[v] = struct{}{}
+				order = append(order, v)
+			}
+		}
+
+		for _, v := range order {
+			el = append(el, gogl.NewEdge(u, v))
+			ends = append(ends, u, v)
+		}
+	}
+
+	return el
+}
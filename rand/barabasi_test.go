@@ -0,0 +1,38 @@
+package rand
+
+import (
+	stdrand "math/rand"
+
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+type BarabasiAlbertTest struct{}
+
+var _ = Suite(&BarabasiAlbertTest{})
+
+func (s *BarabasiAlbertTest) TestShape(c *C) {
+	g := gogl.Spec().Using(BarabasiAlbert(500, 3, stdrand.NewSource(1))).Create(al.G)
+
+	c.Assert(gogl.Order(g), Equals, 500)
+	// 6 edges in the initial K4, then 3 for each of the other 496 vertices
+	c.Assert(gogl.Size(g), Equals, 6+3*496)
+
+	// Preferential attachment produces hubs far above the minimum degree of 3.
+	var max int
+	g.Vertices(func(v gogl.Vertex) (terminate bool) {
+		d, _ := g.DegreeOf(v)
+		c.Assert(d >= 3, Equals, true)
+		if d > max {
+			max = d
+		}
+		return
+	})
+	c.Assert(max > 30, Equals, true)
+}
+
+func (s *BarabasiAlbertTest) TestParameterRange(c *C) {
+	c.Assert(func() { BarabasiAlbert(10, 0, nil) }, PanicMatches, ".*range.*")
+	c.Assert(func() { BarabasiAlbert(10, 10, nil) }, PanicMatches, ".*range.*")
+}
@@ -0,0 +1,57 @@
+package rand
+
+import (
+	stdrand "math/rand"
+
+	"github.com/sdboyer/gogl"
+)
+
+// Randomizes the given undirected graph in place by double-edge swaps, preserving the
+// degree of every vertex. This produces degree-matched null models, against which the
+// structure of the original graph can be compared.
+//
+// Each swap picks two edges (a,b) and (c,d) uniformly at random and rewires them to
+// (a,d) and (c,b), or, with equal probability, to (a,c) and (b,d). Swaps that would create
+// a loop or a parallel edge are rejected and retried, up to a limit of 100 attempts per
+// requested swap, so that graphs admitting few or no valid swaps still terminate.
+// Returns the number of swaps actually performed.
+//
+// If r is nil, the math/rand global source is used.
+func DegreePreservingRewire(g gogl.MutableGraph, swaps int, r *stdrand.Rand) int {
+	intn := intnFor(r)
+
+	var edges [][2]gogl.Vertex
+	g.Edges(func(e gogl.Edge) (terminate bool) {
+		u, v := e.Both()
+		edges = append(edges, [2]gogl.Vertex{u, v})
+		return
+	})
+	if len(edges) < 2 {
+		return 0
+	}
+
+	var done int
+	for attempts := 0; done < swaps && attempts < 100*swaps; attempts++ {
+		i, j := intn(len(edges)), intn(len(edges)-1)
+		if j >= i {
+			j++
+		}
+
+		a, b := edges[i][0], edges[i][1]
+		c, d := edges[j][0], edges[j][1]
+		if intn(2) == 0 {
+			c, d = d, c
+		}
+
+		if a == d || c == b || g.HasEdge(gogl.NewEdge(a, d)) || g.HasEdge(gogl.NewEdge(c, b)) {
+			continue
+		}
+
+		g.RemoveEdges(gogl.NewEdge(a, b), gogl.NewEdge(c, d))
+		g.AddEdges(gogl.NewEdge(a, d), gogl.NewEdge(c, b))
+		edges[i], edges[j] = [2]gogl.Vertex{a, d}, [2]gogl.Vertex{c, b}
+		done++
+	}
+
+	return done
+}
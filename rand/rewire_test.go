@@ -0,0 +1,63 @@
+package rand
+
+import (
+	stdrand "math/rand"
+
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+type RewireSuite struct{}
+
+var _ = Suite(&RewireSuite{})
+
+func degrees(g gogl.Graph) map[gogl.Vertex]int {
+	deg := make(map[gogl.Vertex]int)
+	g.Vertices(func(v gogl.Vertex) (terminate bool) {
+		deg[v], _ = g.DegreeOf(v)
+		return
+	})
+	return deg
+}
+
+func (s *RewireSuite) TestPreservesDegrees(c *C) {
+	src := BarabasiAlbert(200, 2, stdrand.NewSource(4))
+	g := gogl.Spec().Using(src).Create(al.G).(gogl.MutableGraph)
+	orig := gogl.Spec().Using(src).Create(al.G)
+
+	done := DegreePreservingRewire(g, 500, stdrand.New(stdrand.NewSource(9)))
+	c.Assert(done, Equals, 500)
+
+	c.Assert(degrees(g), DeepEquals, degrees(orig))
+	c.Assert(gogl.Size(g), Equals, gogl.Size(orig))
+	c.Assert(gogl.Equal(g, orig), Equals, false)
+
+	// Still simple: no loops, and no edges lost to parallels.
+	g.Edges(func(e gogl.Edge) (terminate bool) {
+		u, v := e.Both()
+		c.Assert(u, Not(Equals), v)
+		return
+	})
+
+	var changed int
+	g.Edges(func(e gogl.Edge) (terminate bool) {
+		if !orig.HasEdge(e) {
+			changed++
+		}
+		return
+	})
+	c.Assert(changed > gogl.Size(g)/4, Equals, true)
+}
+
+func (s *RewireSuite) TestNoValidSwaps(c *C) {
+	// Every swap in a triangle would create a loop or a parallel edge.
+	g := gogl.Spec().Using(gogl.EdgeList{
+		gogl.NewEdge(0, 1),
+		gogl.NewEdge(1, 2),
+		gogl.NewEdge(2, 0),
+	}).Create(al.G).(gogl.MutableGraph)
+
+	c.Assert(DegreePreservingRewire(g, 10, stdrand.New(stdrand.NewSource(1))), Equals, 0)
+	c.Assert(gogl.Size(g), Equals, 3)
+}
@@ -0,0 +1,63 @@
+package rand
+
+import (
+	stdrand "math/rand"
+
+	"github.com/sdboyer/gogl"
+)
+
+// Returns a vertex chosen uniformly at random from the given graph, in a single pass over
+// its vertices and without collecting them, by reservoir sampling. The second return value
+// is false if the graph has no vertices.
+//
+// If r is nil, the math/rand global source is used.
+func RandomVertex(g gogl.Graph, r *stdrand.Rand) (gogl.Vertex, bool) {
+	intn := intnFor(r)
+
+	var chosen gogl.Vertex
+	var seen int
+	g.Vertices(func(v gogl.Vertex) (terminate bool) {
+		seen++
+		if intn(seen) == 0 {
+			chosen = v
+		}
+		return
+	})
+
+	return chosen, seen > 0
+}
+
+// Returns an edge chosen uniformly at random from the given graph, in a single pass over
+// its edges and without collecting them, by reservoir sampling. If the graph is a Digraph,
+// the edge is one of its arcs. The second return value is false if the graph has no edges.
+//
+// If r is nil, the math/rand global source is used.
+func RandomEdge(g gogl.Graph, r *stdrand.Rand) (gogl.Edge, bool) {
+	intn := intnFor(r)
+
+	var chosen gogl.Edge
+	var seen int
+	step := func(e gogl.Edge) (terminate bool) {
+		seen++
+		if intn(seen) == 0 {
+			chosen = e
+		}
+		return
+	}
+
+	if dg, ok := g.(gogl.Digraph); ok {
+		dg.Arcs(func(a gogl.Arc) bool { return step(a) })
+	} else {
+		g.Edges(step)
+	}
+
+	return chosen, seen > 0
+}
+
+// Returns r's Intn, or the global one if r is nil.
+func intnFor(r *stdrand.Rand) func(int) int {
+	if r != nil {
+		return r.Intn
+	}
+	return stdrand.Intn
+}
@@ -0,0 +1,67 @@
+package rand
+
+import (
+	stdrand "math/rand"
+
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+type SampleSuite struct{}
+
+var _ = Suite(&SampleSuite{})
+
+// Asserts that each of the k outcomes was drawn within 10% of its expected share of n.
+func checkUniform(c *C, counts map[interface{}]int, k, n int) {
+	c.Assert(counts, HasLen, k)
+	want := float64(n) / float64(k)
+	for item, got := range counts {
+		c.Assert(float64(got) > 0.9*want && float64(got) < 1.1*want, Equals, true,
+			Commentf("%v drawn %d times, expected about %.0f", item, got, want))
+	}
+}
+
+func (s *SampleSuite) TestRandomVertex(c *C) {
+	g := gogl.Spec().Using(gogl.WithIsolates(gogl.EdgeList{
+		gogl.NewEdge(1, 2),
+		gogl.NewEdge(2, 3),
+	}, 4, 5)).Create(al.G)
+	r := stdrand.New(stdrand.NewSource(1))
+
+	const n = 20000
+	counts := make(map[interface{}]int)
+	for i := 0; i < n; i++ {
+		v, ok := RandomVertex(g, r)
+		c.Assert(ok, Equals, true)
+		counts[v]++
+	}
+	checkUniform(c, counts, 5, n)
+
+	_, ok := RandomVertex(gogl.Spec().Create(al.G), r)
+	c.Assert(ok, Equals, false)
+}
+
+func (s *SampleSuite) TestRandomEdge(c *C) {
+	g := gogl.Spec().Directed().Using(gogl.ArcList{
+		gogl.NewArc(1, 2),
+		gogl.NewArc(2, 1),
+		gogl.NewArc(2, 3),
+		gogl.NewArc(3, 4),
+	}).Create(al.G)
+	r := stdrand.New(stdrand.NewSource(2))
+
+	const n = 20000
+	counts := make(map[interface{}]int)
+	for i := 0; i < n; i++ {
+		e, ok := RandomEdge(g, r)
+		c.Assert(ok, Equals, true)
+		c.Assert(g.(gogl.Digraph).HasArc(e.(gogl.Arc)), Equals, true)
+		u, v := e.Both()
+		counts[[2]gogl.Vertex{u, v}]++
+	}
+	checkUniform(c, counts, 4, n)
+
+	_, ok := RandomEdge(gogl.Spec().Using(gogl.WithIsolates(gogl.EdgeList{}, 1)).Create(al.G), r)
+	c.Assert(ok, Equals, false)
+}
@@ -0,0 +1,51 @@
+package gogl
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Named creator functions for immutable graph implementations.
+var immutableGraphs = struct {
+	sync.RWMutex
+	creators map[string]func(GraphSpec) Graph
+}{creators: make(map[string]func(GraphSpec) Graph)}
+
+// Registers a creator function for an immutable graph implementation under the given
+// name, so that it may later be instantiated by name via CreateImmutableGraph. This
+// allows library extensions to make their implementations available without callers
+// needing to import them directly.
+//
+// Creator functions follow the same contract as those passed to GraphSpec.Create: they
+// build a graph satisfying the spec, populated from its Source, if any. An error is
+// returned if the name is already registered, or if the creator is nil.
+func RegisterImmutableGraph(name string, create func(GraphSpec) Graph) error {
+	if create == nil {
+		return fmt.Errorf("Cannot register a nil creator for immutable graph %q.", name)
+	}
+
+	immutableGraphs.Lock()
+	defer immutableGraphs.Unlock()
+
+	if _, exists := immutableGraphs.creators[name]; exists {
+		return fmt.Errorf("An immutable graph is already registered under the name %q.", name)
+	}
+	immutableGraphs.creators[name] = create
+	return nil
+}
+
+// Creates an immutable graph from the provided spec, using the creator function
+// registered under the given name. The spec is marked immutable before it is passed
+// along; any Source it carries will be imported into the new graph.
+//
+// An error is returned if no creator is registered under the name.
+func CreateImmutableGraph(name string, gs GraphSpec) (Graph, error) {
+	immutableGraphs.RLock()
+	create, exists := immutableGraphs.creators[name]
+	immutableGraphs.RUnlock()
+
+	if !exists {
+		return nil, fmt.Errorf("No immutable graph is registered under the name %q.", name)
+	}
+	return create(gs.Immutable()), nil
+}
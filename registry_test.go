@@ -0,0 +1,62 @@
+package gogl_test
+
+import (
+	. "github.com/sdboyer/gocheck"
+	. "github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+type RegistrySuite struct{}
+
+var _ = Suite(&RegistrySuite{})
+
+// A trivial immutable implementation, wrapping an immutable adjacency list digraph.
+type frozenDigraph struct {
+	Digraph
+}
+
+func createFrozen(gs GraphSpec) Graph {
+	return frozenDigraph{gs.Create(al.G).(Digraph)}
+}
+
+func (s *RegistrySuite) TestRegisterAndCreate(c *C) {
+	c.Assert(RegisterImmutableGraph("test-frozen", createFrozen), IsNil)
+
+	g, err := CreateImmutableGraph("test-frozen", Spec().Directed().Using(ArcList{
+		NewArc("a", "b"),
+		NewArc("b", "c"),
+	}))
+	c.Assert(err, IsNil)
+
+	f, ok := g.(frozenDigraph)
+	c.Assert(ok, Equals, true)
+	c.Assert(Size(f), Equals, 2)
+	c.Assert(f.HasArc(NewArc("b", "c")), Equals, true)
+
+	// the wrapped graph was created as immutable
+	_, mutable := f.Digraph.(MutableDigraph)
+	c.Assert(mutable, Equals, false)
+}
+
+func (s *RegistrySuite) TestRegistrationErrors(c *C) {
+	c.Assert(RegisterImmutableGraph("test-dup", createFrozen), IsNil)
+	c.Assert(RegisterImmutableGraph("test-dup", createFrozen), ErrorMatches, ".*already registered.*")
+	c.Assert(RegisterImmutableGraph("test-nil", nil), ErrorMatches, ".*nil creator.*")
+
+	_, err := CreateImmutableGraph("test-unknown", Spec())
+	c.Assert(err, ErrorMatches, "No immutable graph is registered.*")
+}
+
+// Creator funcs build fresh instances without reflection, so every call yields an
+// independent graph, and value (non-pointer) implementations work fine.
+func (s *RegistrySuite) TestIndependentInstances(c *C) {
+	c.Assert(RegisterImmutableGraph("test-independent", createFrozen), IsNil)
+
+	a, err := CreateImmutableGraph("test-independent", Spec().Directed())
+	c.Assert(err, IsNil)
+	c.Assert(Order(a), Equals, 0)
+
+	b, _ := CreateImmutableGraph("test-independent", Spec().Directed().Using(ArcList{NewArc(1, 2)}))
+	c.Assert(Order(b), Equals, 2)
+	c.Assert(Order(a), Equals, 0)
+}
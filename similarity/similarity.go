@@ -0,0 +1,185 @@
+// Contains algos for scoring the structural similarity of vertices, chiefly for use in
+// link prediction.
+package similarity
+
+import (
+	"math"
+	"sort"
+
+	"github.com/sdboyer/gogl"
+	"gopkg.in/fatih/set.v0"
+)
+
+// Returns the Jaccard coefficient of the neighbor sets of the two given vertices: the
+// size of the intersection of their neighborhoods, divided by the size of the union.
+//
+// Neighbors are as reported by AdjacentTo(), so in a digraph both predecessors and
+// successors are included. If neither vertex has any neighbors, the coefficient is 0.
+func JaccardCoefficient(g gogl.Graph, u, v gogl.Vertex) float64 {
+	nu, nv := neighbors(g, u), neighbors(g, v)
+
+	var common int
+	nu.Each(func(item interface{}) bool {
+		if nv.Has(item) {
+			common++
+		}
+		return true
+	})
+
+	union := nu.Size() + nv.Size() - common
+	if union == 0 {
+		return 0
+	}
+	return float64(common) / float64(union)
+}
+
+// Returns the weighted Jaccard coefficient of the weighted neighborhoods of the two given
+// vertices: over every vertex adjacent to either, the sum of the lesser of its edge
+// weights to u and v, divided by the sum of the greater. A vertex adjacent to only one
+// of the two counts as having weight 0 to the other.
+//
+// Neighbors are found via IncidentTo(), so in a digraph both in- and out-arcs count; if a
+// neighbor is connected in both directions, the weights of the two arcs are summed. Edge
+// weights should be non-negative. If neither vertex has any neighbors, or all relevant
+// weights are 0, the coefficient is 0.
+func WeightedNeighborSimilarity(g gogl.WeightedGraph, u, v gogl.Vertex) float64 {
+	wu, wv := weightedNeighbors(g, u), weightedNeighbors(g, v)
+
+	var min, max float64
+	for z, a := range wu {
+		b := wv[z]
+		min += math.Min(a, b)
+		max += math.Max(a, b)
+	}
+	for z, b := range wv {
+		if _, seen := wu[z]; !seen {
+			max += b
+		}
+	}
+
+	if max == 0 {
+		return 0
+	}
+	return min / max
+}
+
+// Returns the Adamic-Adar index of the two given vertices: the sum, over all of their
+// common neighbors, of the inverse logarithm of that neighbor's degree.
+//
+// Neighbors are as reported by AdjacentTo(), but each counts once, even where a digraph
+// connects it by arcs in both directions. Common neighbors with a degree of 1 (which can
+// only arise in degenerate cases, such as u == v) would produce a division by zero, and
+// are skipped.
+func AdamicAdar(g gogl.Graph, u, v gogl.Vertex) float64 {
+	nv := neighbors(g, v)
+
+	var score float64
+	for _, z := range sortedNeighbors(g, u) {
+		if nv.Has(z) {
+			score += invLogDegree(g, z)
+		}
+	}
+
+	return score
+}
+
+// Returns the k highest-scoring vertex pairs that are not already connected by an
+// edge, scored by their Adamic-Adar index.
+//
+// Only pairs with at least one common neighbor - and thus a nonzero score - are
+// considered as candidates, so fewer than k edges may be returned. Each edge runs from
+// the lesser of its vertices to the greater, per gogl.VertexLess, and ties in score are
+// broken in that same order, so the result is reproducible.
+func PredictLinks(g gogl.Graph, k int) []gogl.Edge {
+	type pair struct {
+		u, v gogl.Vertex
+	}
+
+	scores := make(map[pair]float64)
+	gogl.VerticesSorted(g, func(z gogl.Vertex) (terminate bool) {
+		w := invLogDegree(g, z)
+		if w == 0 {
+			return
+		}
+
+		adj := sortedNeighbors(g, z)
+		for i, u := range adj {
+			for _, v := range adj[i+1:] {
+				if !g.HasEdge(gogl.NewEdge(u, v)) {
+					scores[pair{u, v}] += w
+				}
+			}
+		}
+		return
+	})
+
+	candidates := make([]pair, 0, len(scores))
+	for p := range scores {
+		candidates = append(candidates, p)
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if scores[a] != scores[b] {
+			return scores[a] > scores[b]
+		}
+		if a.u != b.u {
+			return gogl.VertexLess(a.u, b.u)
+		}
+		return gogl.VertexLess(a.v, b.v)
+	})
+
+	if k > len(candidates) {
+		k = len(candidates)
+	}
+
+	edges := make([]gogl.Edge, 0, k)
+	for _, p := range candidates[:k] {
+		edges = append(edges, gogl.NewEdge(p.u, p.v))
+	}
+	return edges
+}
+
+// Collects the vertices adjacent to v into a set.
+func neighbors(g gogl.Graph, v gogl.Vertex) *set.SetNonTS {
+	s := set.NewNonTS()
+	g.AdjacentTo(v, func(adj gogl.Vertex) (terminate bool) {
+		s.Add(adj)
+		return
+	})
+	return s
+}
+
+// Returns the distinct vertices adjacent to v, in their natural order (see
+// gogl.VertexLess).
+func sortedNeighbors(g gogl.Graph, v gogl.Vertex) []gogl.Vertex {
+	var adj []gogl.Vertex
+	neighbors(g, v).Each(func(item interface{}) bool {
+		adj = append(adj, item)
+		return true
+	})
+	sort.Slice(adj, func(i, j int) bool { return gogl.VertexLess(adj[i], adj[j]) })
+	return adj
+}
+
+// Collects the vertices adjacent to v, each with the weight of the edge(s) connecting it.
+func weightedNeighbors(g gogl.WeightedGraph, v gogl.Vertex) map[gogl.Vertex]float64 {
+	weights := make(map[gogl.Vertex]float64)
+	g.IncidentTo(v, func(e gogl.Edge) (terminate bool) {
+		a, b := e.Both()
+		if a == v {
+			a = b
+		}
+		weights[a] += e.(gogl.WeightedEdge).Weight()
+		return
+	})
+	return weights
+}
+
+// Returns 1/log(degree) for the given vertex, or 0 if that is undefined.
+func invLogDegree(g gogl.Graph, v gogl.Vertex) float64 {
+	deg, _ := g.DegreeOf(v)
+	if deg < 2 {
+		return 0
+	}
+	return 1 / math.Log(float64(deg))
+}
@@ -0,0 +1,123 @@
+package similarity
+
+import (
+	"math"
+	"testing"
+
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+// Hook gocheck into the go test runner
+func Test(t *testing.T) { TestingT(t) }
+
+// a and b share neighbors c and d; b additionally reaches f through e.
+var commonNeighbors = gogl.EdgeList{
+	gogl.NewEdge("a", "c"),
+	gogl.NewEdge("a", "d"),
+	gogl.NewEdge("b", "c"),
+	gogl.NewEdge("b", "d"),
+	gogl.NewEdge("b", "e"),
+	gogl.NewEdge("e", "f"),
+}
+
+type LinkPredictionSuite struct{}
+
+var _ = Suite(&LinkPredictionSuite{})
+
+func (s *LinkPredictionSuite) TestJaccardCoefficient(c *C) {
+	g := gogl.Spec().Using(commonNeighbors).Create(al.G)
+
+	c.Assert(JaccardCoefficient(g, "a", "b"), Equals, 2.0/3.0)
+	c.Assert(JaccardCoefficient(g, "b", "a"), Equals, 2.0/3.0)
+	c.Assert(JaccardCoefficient(g, "c", "d"), Equals, 1.0)
+	c.Assert(JaccardCoefficient(g, "a", "f"), Equals, 0.0)
+}
+
+func (s *LinkPredictionSuite) TestWeightedNeighborSimilarity(c *C) {
+	g := gogl.Spec().Weighted().Using(gogl.WeightedEdgeList{
+		// a and b have identical weighted neighborhoods
+		gogl.NewWeightedEdge("a", "x", 2),
+		gogl.NewWeightedEdge("a", "y", 1),
+		gogl.NewWeightedEdge("b", "x", 2),
+		gogl.NewWeightedEdge("b", "y", 1),
+		// c shares x, at a different weight, and has z besides
+		gogl.NewWeightedEdge("c", "x", 1),
+		gogl.NewWeightedEdge("c", "z", 3),
+		// d is off on its own
+		gogl.NewWeightedEdge("d", "w", 4),
+	}).Create(al.G).(gogl.WeightedGraph)
+
+	c.Assert(WeightedNeighborSimilarity(g, "a", "b"), Equals, 1.0)
+	c.Assert(WeightedNeighborSimilarity(g, "a", "d"), Equals, 0.0)
+	// min: x 1; max: x 2 + y 1 + z 3
+	c.Assert(WeightedNeighborSimilarity(g, "a", "c"), Equals, 1.0/6.0)
+	c.Assert(WeightedNeighborSimilarity(g, "c", "a"), Equals, 1.0/6.0)
+	c.Assert(WeightedNeighborSimilarity(g, "a", "missing"), Equals, 0.0)
+}
+
+func (s *LinkPredictionSuite) TestAdamicAdar(c *C) {
+	g := gogl.Spec().Using(commonNeighbors).Create(al.G)
+
+	c.Assert(AdamicAdar(g, "a", "b"), Equals, 2/math.Log(2))
+	c.Assert(AdamicAdar(g, "c", "d"), Equals, 1/math.Log(2)+1/math.Log(3))
+	c.Assert(AdamicAdar(g, "b", "f"), Equals, 1/math.Log(2))
+	c.Assert(AdamicAdar(g, "a", "f"), Equals, 0.0)
+}
+
+func (s *LinkPredictionSuite) TestPredictLinks(c *C) {
+	g := gogl.Spec().Using(commonNeighbors).Create(al.G)
+
+	expected := [][2]gogl.Vertex{{"a", "b"}, {"c", "d"}, {"b", "f"}}
+	predicted := PredictLinks(g, 3)
+	c.Assert(len(predicted), Equals, 3)
+
+	for i, e := range predicted {
+		u, v := e.Both()
+		match := (u == expected[i][0] && v == expected[i][1]) || (u == expected[i][1] && v == expected[i][0])
+		c.Assert(match, Equals, true, Commentf("prediction %d was %v", i, e))
+		c.Assert(g.HasEdge(e), Equals, false)
+	}
+
+	// Only five pairs have any common neighbors at all.
+	c.Assert(len(PredictLinks(g, 100)), Equals, 5)
+}
+
+func (s *LinkPredictionSuite) TestReciprocalArcs(c *C) {
+	// x and y share z, and z is joined to each by arcs in both directions.
+	g := gogl.Spec().Directed().Using(gogl.ArcList{
+		gogl.NewArc("x", "z"),
+		gogl.NewArc("z", "x"),
+		gogl.NewArc("y", "z"),
+		gogl.NewArc("z", "y"),
+		gogl.NewArc("y", "w"),
+	}).Create(al.G)
+
+	// z counts once as a common neighbor, not once per arc.
+	c.Assert(AdamicAdar(g, "x", "y"), Equals, invLogDegree(g, "z"))
+
+	// w and z share y, which has the lower degree of the two hubs.
+	var got [][2]gogl.Vertex
+	for _, e := range PredictLinks(g, 10) {
+		u, v := e.Both()
+		got = append(got, [2]gogl.Vertex{u, v})
+	}
+	c.Assert(got, DeepEquals, [][2]gogl.Vertex{{"w", "z"}, {"x", "y"}})
+}
+
+func (s *LinkPredictionSuite) TestPredictLinksTies(c *C) {
+	// A star: every pair of leaves ties, sharing only the hub.
+	el := gogl.EdgeList{}
+	for _, leaf := range []gogl.Vertex{"d", 3, "b", 1, "c", 2} {
+		el = append(el, gogl.NewEdge("hub", leaf))
+	}
+	g := gogl.Spec().Using(el).Create(al.G)
+
+	var got [][2]gogl.Vertex
+	for _, e := range PredictLinks(g, 4) {
+		u, v := e.Both()
+		got = append(got, [2]gogl.Vertex{u, v})
+	}
+	c.Assert(got, DeepEquals, [][2]gogl.Vertex{{1, 2}, {1, 3}, {1, "b"}, {1, "c"}})
+}
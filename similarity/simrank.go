@@ -0,0 +1,83 @@
+package similarity
+
+import (
+	"math"
+
+	"github.com/sdboyer/gogl"
+)
+
+// SimRank iteration halts once no pairwise score changes by more than this amount.
+const simRankTolerance = 1e-9
+
+// Computes SimRank scores for every pair of vertices in the given digraph.
+//
+// SimRank formalizes the notion that "two vertices are similar if they are referenced
+// by similar vertices": a vertex is maximally similar to itself (score 1), and the score
+// of any other pair is the decay-weighted average similarity of all pairs drawn from
+// their respective in-neighbors. Pairs where either vertex has no in-neighbors score 0.
+//
+// Scores are computed iteratively until either no score changes by more than a small
+// tolerance, or maxIter iterations have been performed. decay should be in (0,1); 0.8
+// is the value used in the original paper by Jeh and Widom.
+//
+// Note that SimRank requires O(V^2) memory for both the working state and the returned
+// map, and each iteration costs O(V^2 * d^2) time for average in-degree d. It is not
+// suitable for graphs beyond a few thousand vertices.
+func SimRank(g gogl.Digraph, decay float64, maxIter int) map[gogl.Vertex]map[gogl.Vertex]float64 {
+	vertices := gogl.CollectVertices(g)
+	n := len(vertices)
+
+	index := make(map[gogl.Vertex]int, n)
+	for i, v := range vertices {
+		index[v] = i
+	}
+
+	in := make([][]int, n)
+	for i, v := range vertices {
+		g.PredecessorsOf(v, func(p gogl.Vertex) (terminate bool) {
+			in[i] = append(in[i], index[p])
+			return
+		})
+	}
+
+	sim, next := make([][]float64, n), make([][]float64, n)
+	for i := range sim {
+		sim[i], next[i] = make([]float64, n), make([]float64, n)
+		sim[i][i], next[i][i] = 1, 1
+	}
+
+	for iter := 0; iter < maxIter; iter++ {
+		var delta float64
+		for a := 0; a < n; a++ {
+			for b := a + 1; b < n; b++ {
+				var score float64
+				if len(in[a]) > 0 && len(in[b]) > 0 {
+					for _, i := range in[a] {
+						for _, j := range in[b] {
+							score += sim[i][j]
+						}
+					}
+					score *= decay / float64(len(in[a])*len(in[b]))
+				}
+
+				delta = math.Max(delta, math.Abs(score-sim[a][b]))
+				next[a][b], next[b][a] = score, score
+			}
+		}
+
+		sim, next = next, sim
+		if delta < simRankTolerance {
+			break
+		}
+	}
+
+	scores := make(map[gogl.Vertex]map[gogl.Vertex]float64, n)
+	for i, u := range vertices {
+		scores[u] = make(map[gogl.Vertex]float64, n)
+		for j, v := range vertices {
+			scores[u][v] = sim[i][j]
+		}
+	}
+
+	return scores
+}
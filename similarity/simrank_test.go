@@ -0,0 +1,42 @@
+package similarity
+
+import (
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+type SimRankSuite struct{}
+
+var _ = Suite(&SimRankSuite{})
+
+// Two papers that both cite the same two papers, and a survey citing both citers.
+var citations = gogl.ArcList{
+	gogl.NewArc("p1", "x"),
+	gogl.NewArc("p1", "y"),
+	gogl.NewArc("p2", "x"),
+	gogl.NewArc("p2", "y"),
+	gogl.NewArc("survey", "p1"),
+	gogl.NewArc("survey", "p2"),
+}
+
+func (s *SimRankSuite) TestSimRank(c *C) {
+	g := gogl.Spec().Directed().Using(citations).Create(al.G).(gogl.Digraph)
+	sr := SimRank(g, 0.8, 100)
+
+	g.Vertices(func(u gogl.Vertex) (terminate bool) {
+		c.Assert(sr[u][u], Equals, 1.0)
+		g.Vertices(func(v gogl.Vertex) (terminate bool) {
+			c.Assert(sr[u][v], Equals, sr[v][u])
+			return
+		})
+		return
+	})
+
+	// p1 and p2 are both cited only by the survey.
+	c.Assert(sr["p1"]["p2"], Equals, 0.8)
+	// x and y are cited by p1 and p2: 0.8/4 * (1 + 0.8 + 0.8 + 1)
+	c.Assert(sr["x"]["y"]-0.72 < 1e-12 && 0.72-sr["x"]["y"] < 1e-12, Equals, true)
+	// The survey has no citers, so it resembles nothing but itself.
+	c.Assert(sr["survey"]["p1"], Equals, 0.0)
+}
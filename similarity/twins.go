@@ -0,0 +1,120 @@
+package similarity
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/sdboyer/gogl"
+)
+
+// Groups together the vertices of the given graph that are structurally equivalent
+// ("twins"), having exactly the same neighbors. Only groups with at least two members
+// are returned.
+//
+// If closed is false, vertices are compared by their open neighborhoods, which exclude
+// the vertices themselves; such twins are never adjacent to one another. If closed is
+// true, each vertex's closed neighborhood - its neighbors plus itself - is compared
+// instead, so twins are always adjacent. Isolated vertices all have the same (empty)
+// open neighborhood, so they form a group of open twins.
+//
+// In a digraph, twins must have both the same successors and the same predecessors.
+// Members of each group are in their natural order (per gogl.VertexLess), and groups are
+// ordered by their first member.
+func FindStructuralDuplicates(g gogl.Graph, closed bool) [][]gogl.Vertex {
+	dg, directed := g.(gogl.Digraph)
+
+	// The neighborhood of each vertex, as sorted vertex lists.
+	hoods := make(map[gogl.Vertex][2][]gogl.Vertex)
+	g.Vertices(func(v gogl.Vertex) (terminate bool) {
+		var out, in []gogl.Vertex
+		collect := func(list *[]gogl.Vertex) gogl.VertexStep {
+			return func(adj gogl.Vertex) (terminate bool) {
+				*list = append(*list, adj)
+				return
+			}
+		}
+
+		if directed {
+			dg.SuccessorsOf(v, collect(&out))
+			dg.PredecessorsOf(v, collect(&in))
+		} else {
+			g.AdjacentTo(v, collect(&out))
+		}
+
+		if closed {
+			out = withVertex(out, v)
+			if directed {
+				in = withVertex(in, v)
+			}
+		}
+		hoods[v] = [2][]gogl.Vertex{sortedVertices(out), sortedVertices(in)}
+		return
+	})
+
+	// Bucket by a printed form of the neighborhood, then split each bucket precisely, in
+	// case distinct vertices happen to print identically.
+	buckets := make(map[string][]gogl.Vertex)
+	for v, h := range hoods {
+		key := fmt.Sprintf("%#v", h)
+		buckets[key] = append(buckets[key], v)
+	}
+
+	var groups [][]gogl.Vertex
+	for _, bucket := range buckets {
+		bucket = sortedVertices(bucket)
+		for len(bucket) > 1 {
+			first := bucket[0]
+			group := []gogl.Vertex{first}
+			var remaining []gogl.Vertex
+			for _, v := range bucket[1:] {
+				if sameHood(hoods[first], hoods[v]) {
+					group = append(group, v)
+				} else {
+					remaining = append(remaining, v)
+				}
+			}
+
+			if len(group) > 1 {
+				groups = append(groups, group)
+			}
+			bucket = remaining
+		}
+	}
+
+	sort.Slice(groups, func(i, j int) bool {
+		return gogl.VertexLess(groups[i][0], groups[j][0])
+	})
+	return groups
+}
+
+// Adds v to the list, if not already present.
+func withVertex(list []gogl.Vertex, v gogl.Vertex) []gogl.Vertex {
+	for _, x := range list {
+		if x == v {
+			return list
+		}
+	}
+	return append(list, v)
+}
+
+func sortedVertices(list []gogl.Vertex) []gogl.Vertex {
+	sort.Slice(list, func(i, j int) bool {
+		return gogl.VertexLess(list[i], list[j])
+	})
+	return list
+}
+
+// Indicates whether two neighborhoods, as sorted lists, are identical.
+func sameHood(a, b [2][]gogl.Vertex) bool {
+	for k := range a {
+		if len(a[k]) != len(b[k]) {
+			return false
+		}
+		for i := range a[k] {
+			if a[k][i] != b[k][i] {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,57 @@
+package similarity
+
+import (
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+type TwinsSuite struct{}
+
+var _ = Suite(&TwinsSuite{})
+
+func (s *TwinsSuite) TestOpenTwins(c *C) {
+	// a and b are each connected to exactly x, y and z; c only to x and y.
+	g := gogl.Spec().Using(gogl.EdgeList{
+		gogl.NewEdge("a", "x"),
+		gogl.NewEdge("a", "y"),
+		gogl.NewEdge("a", "z"),
+		gogl.NewEdge("b", "x"),
+		gogl.NewEdge("b", "y"),
+		gogl.NewEdge("b", "z"),
+		gogl.NewEdge("c", "x"),
+		gogl.NewEdge("c", "y"),
+	}).Create(al.G)
+
+	// x and y are twins too: both adjacent to exactly a, b and c.
+	c.Assert(FindStructuralDuplicates(g, false), DeepEquals, [][]gogl.Vertex{
+		{"a", "b"},
+		{"x", "y"},
+	})
+	c.Assert(FindStructuralDuplicates(g, true), HasLen, 0)
+}
+
+func (s *TwinsSuite) TestClosedTwins(c *C) {
+	// In a triangle with a pendant on c, a and b have the same closed neighborhoods.
+	g := gogl.Spec().Using(gogl.EdgeList{
+		gogl.NewEdge("a", "b"),
+		gogl.NewEdge("b", "c"),
+		gogl.NewEdge("a", "c"),
+		gogl.NewEdge("c", "d"),
+	}).Create(al.G)
+
+	c.Assert(FindStructuralDuplicates(g, true), DeepEquals, [][]gogl.Vertex{{"a", "b"}})
+	c.Assert(FindStructuralDuplicates(g, false), HasLen, 0)
+}
+
+func (s *TwinsSuite) TestDirected(c *C) {
+	// 1 and 2 both point at 3, but only 1 is pointed at by 4.
+	g := gogl.Spec().Directed().Using(gogl.ArcList{
+		gogl.NewArc(1, 3),
+		gogl.NewArc(2, 3),
+		gogl.NewArc(4, 1),
+		gogl.NewArc(5, 3),
+	}).Create(al.G)
+
+	c.Assert(FindStructuralDuplicates(g, false), DeepEquals, [][]gogl.Vertex{{2, 5}})
+}
@@ -0,0 +1,59 @@
+package sp
+
+import (
+	"errors"
+	"runtime"
+	"sync"
+
+	"github.com/sdboyer/gogl"
+)
+
+// Computes shortest-path distances between all pairs of vertices in the given graph, by
+// running Dijkstra's algorithm from every vertex. The runs are independent, so they are
+// spread across a pool of workers goroutines; if workers is less than 1, GOMAXPROCS is
+// used. Each run's results are written only to its own source's entry, so the output is
+// the same regardless of the number of workers.
+//
+// The returned map is keyed by source, then by target; only reachable targets are
+// present, including each source itself at distance 0. If the provided graph is a
+// Digraph, paths follow arc direction. The graph must be safe for concurrent reads, as
+// gogl's own implementations are, and must not be mutated while this runs.
+//
+// As with ShortestPath, an error is returned if any edge in the graph has a negative
+// weight; see BellmanFordShortestPath for graphs that have them.
+func AllSourcesShortestPaths(g gogl.WeightedGraph, workers int) (map[gogl.Vertex]map[gogl.Vertex]float64, error) {
+	if hasNegativeWeight(g) {
+		return nil, errors.New("Graph contains a negative edge weight, which Dijkstra's algorithm does not support.")
+	}
+
+	if workers < 1 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	sources := gogl.CollectVertices(g)
+	results := make([]map[gogl.Vertex]float64, len(sources))
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				results[i], _ = dijkstra(g, sources[i])
+			}
+		}()
+	}
+
+	for i := range sources {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	all := make(map[gogl.Vertex]map[gogl.Vertex]float64, len(sources))
+	for i, s := range sources {
+		all[s] = results[i]
+	}
+	return all, nil
+}
@@ -0,0 +1,111 @@
+package sp
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+type AllSourcesSuite struct{}
+
+var _ = Suite(&AllSourcesSuite{})
+
+// Computes all-pairs distances by Floyd-Warshall, as a reference.
+func floydWarshall(g gogl.WeightedGraph) map[gogl.Vertex]map[gogl.Vertex]float64 {
+	vertices := gogl.CollectVertices(g)
+	dist := make(map[gogl.Vertex]map[gogl.Vertex]float64)
+	for _, u := range vertices {
+		dist[u] = map[gogl.Vertex]float64{u: 0}
+		outEdges(g, u, func(e gogl.WeightedEdge, v gogl.Vertex) (terminate bool) {
+			if d, exists := dist[u][v]; !exists || e.Weight() < d {
+				dist[u][v] = e.Weight()
+			}
+			return
+		})
+	}
+
+	for _, k := range vertices {
+		for _, i := range vertices {
+			dik, ok := dist[i][k]
+			if !ok {
+				continue
+			}
+			for j, dkj := range dist[k] {
+				if d, exists := dist[i][j]; !exists || dik+dkj < d {
+					dist[i][j] = dik + dkj
+				}
+			}
+		}
+	}
+	return dist
+}
+
+func randomAPSPGraph(r *rand.Rand, n, m int) gogl.WeightedGraph {
+	arcs := gogl.WeightedArcList{}
+	for i := 0; i < m; i++ {
+		arcs = append(arcs, gogl.NewWeightedArc(r.Intn(n), r.Intn(n), float64(r.Intn(20))))
+	}
+	return gogl.Spec().Directed().Weighted().Using(arcs).Create(al.G).(gogl.WeightedGraph)
+}
+
+func (s *AllSourcesSuite) TestMatchesFloydWarshall(c *C) {
+	r := rand.New(rand.NewSource(2))
+	for _, g := range []gogl.WeightedGraph{
+		randomAPSPGraph(r, 30, 90),
+		gogl.Spec().Weighted().Using(roads).Create(al.G).(gogl.WeightedGraph),
+	} {
+		want := floydWarshall(g)
+		for _, workers := range []int{1, 3, 0} {
+			got, err := AllSourcesShortestPaths(g, workers)
+			c.Assert(err, IsNil)
+			c.Assert(got, HasLen, len(want))
+			for u, row := range want {
+				c.Assert(got[u], HasLen, len(row))
+				for v, d := range row {
+					c.Assert(math.Abs(got[u][v]-d) < 1e-9, Equals, true)
+				}
+			}
+		}
+	}
+}
+
+func (s *AllSourcesSuite) TestDeterministic(c *C) {
+	g := randomAPSPGraph(rand.New(rand.NewSource(6)), 50, 200)
+	parallel, err := AllSourcesShortestPaths(g, 8)
+	c.Assert(err, IsNil)
+	serial, err := AllSourcesShortestPaths(g, 1)
+	c.Assert(err, IsNil)
+	c.Assert(parallel, DeepEquals, serial)
+}
+
+func (s *AllSourcesSuite) TestNegativeWeight(c *C) {
+	g := gogl.Spec().Directed().Weighted().Using(gogl.WeightedArcList{
+		gogl.NewWeightedArc("a", "b", 1),
+		gogl.NewWeightedArc("c", "d", -1),
+	}).Create(al.G).(gogl.WeightedGraph)
+
+	all, err := AllSourcesShortestPaths(g, 2)
+	c.Assert(err, ErrorMatches, ".*negative edge weight.*")
+	c.Assert(all, IsNil)
+}
+
+func benchAllSources(b *testing.B, workers int) {
+	g := randomAPSPGraph(rand.New(rand.NewSource(1)), 400, 4000)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		AllSourcesShortestPaths(g, workers)
+	}
+}
+
+func BenchmarkAllSourcesSerial(b *testing.B) {
+	benchAllSources(b, 1)
+}
+
+func BenchmarkAllSourcesParallel(b *testing.B) {
+	benchAllSources(b, 0)
+}
@@ -0,0 +1,67 @@
+package sp
+
+import (
+	"errors"
+
+	"github.com/sdboyer/gogl"
+)
+
+// Returned by BellmanFordShortestPath when a negative-weight cycle is reachable from the
+// source, in which case shortest paths are undefined.
+var ErrNegativeCycle = errors.New("Graph contains a negative-weight cycle reachable from the source.")
+
+// Computes the distance from the given source to every vertex reachable from it using the
+// Bellman-Ford algorithm, along with the edge by which each non-source vertex is reached
+// on a shortest path. Unlike Dijkstra's algorithm, negative edge weights are supported.
+// Runs in O(VE).
+//
+// Vertices that are unreachable are absent from both maps, as is the source if it is not
+// present in the graph. If a negative-weight cycle is reachable from the source, both maps
+// are nil and ErrNegativeCycle is returned. Cycles elsewhere in the graph do not matter.
+//
+// If the provided graph is a Digraph, paths follow arc direction. In an undirected graph,
+// any reachable edge with a negative weight is itself a negative cycle, as it can be
+// traversed back and forth.
+func BellmanFordShortestPath(g gogl.WeightedGraph, source gogl.Vertex) (map[gogl.Vertex]float64, map[gogl.Vertex]gogl.Edge, error) {
+	dist := make(map[gogl.Vertex]float64)
+	parent := make(map[gogl.Vertex]gogl.Edge)
+	if !g.HasVertex(source) {
+		return dist, parent, nil
+	}
+
+	vertices := gogl.CollectVertices(g)
+	dist[source] = 0
+
+	// Relaxes every edge out of a reached vertex once, reporting whether any distance
+	// improved.
+	relax := func() (changed bool) {
+		for _, u := range vertices {
+			du, reached := dist[u]
+			if !reached {
+				continue
+			}
+			outEdges(g, u, func(e gogl.WeightedEdge, v gogl.Vertex) (terminate bool) {
+				if dv, reached := dist[v]; !reached || du+e.Weight() < dv {
+					dist[v] = du + e.Weight()
+					parent[v] = e
+					changed = true
+				}
+				return
+			})
+		}
+		return
+	}
+
+	for i := 1; i < len(vertices); i++ {
+		if !relax() {
+			return dist, parent, nil
+		}
+	}
+
+	// After V-1 rounds every shortest path has settled; any further improvement can only
+	// come from a negative cycle.
+	if relax() {
+		return nil, nil, ErrNegativeCycle
+	}
+	return dist, parent, nil
+}
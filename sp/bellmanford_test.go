@@ -0,0 +1,59 @@
+package sp
+
+import (
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+type BellmanFordSuite struct{}
+
+var _ = Suite(&BellmanFordSuite{})
+
+func (s *BellmanFordSuite) TestNegativeWeights(c *C) {
+	// The cheapest route to d runs through the negative b->c arc.
+	g := gogl.Spec().Directed().Weighted().Using(gogl.WithIsolates(gogl.WeightedArcList{
+		gogl.NewWeightedArc("a", "b", 4),
+		gogl.NewWeightedArc("a", "c", 2),
+		gogl.NewWeightedArc("b", "c", -3),
+		gogl.NewWeightedArc("c", "d", 2),
+		gogl.NewWeightedArc("d", "b", 1),
+	}, "z")).Create(al.G).(gogl.WeightedGraph)
+
+	dist, parent, err := BellmanFordShortestPath(g, "a")
+	c.Assert(err, IsNil)
+	c.Assert(dist, DeepEquals, map[gogl.Vertex]float64{"a": 0, "b": 4, "c": 1, "d": 3})
+	c.Assert(parent, HasLen, 3)
+	c.Assert(parent["c"], Equals, gogl.NewWeightedArc("b", "c", -3))
+	c.Assert(parent["d"], Equals, gogl.NewWeightedArc("c", "d", 2))
+
+	dist, parent, err = BellmanFordShortestPath(g, "missing")
+	c.Assert(err, IsNil)
+	c.Assert(dist, HasLen, 0)
+	c.Assert(parent, HasLen, 0)
+}
+
+func (s *BellmanFordSuite) TestNegativeCycle(c *C) {
+	g := gogl.Spec().Directed().Weighted().Using(gogl.WeightedArcList{
+		gogl.NewWeightedArc("s", "a", 1),
+		gogl.NewWeightedArc("a", "b", 1),
+		gogl.NewWeightedArc("b", "c", -3),
+		gogl.NewWeightedArc("c", "a", 1),
+		gogl.NewWeightedArc("x", "s", 1),
+	}).Create(al.G).(gogl.WeightedGraph)
+
+	dist, parent, err := BellmanFordShortestPath(g, "s")
+	c.Assert(err, Equals, ErrNegativeCycle)
+	c.Assert(dist, IsNil)
+	c.Assert(parent, IsNil)
+
+	// A cycle that cannot be reached is no obstacle.
+	g2 := gogl.Spec().Directed().Weighted().Using(gogl.WeightedArcList{
+		gogl.NewWeightedArc("s", "t", 2),
+		gogl.NewWeightedArc("a", "b", -1),
+		gogl.NewWeightedArc("b", "a", -1),
+	}).Create(al.G).(gogl.WeightedGraph)
+	dist, _, err = BellmanFordShortestPath(g2, "s")
+	c.Assert(err, IsNil)
+	c.Assert(dist, DeepEquals, map[gogl.Vertex]float64{"s": 0, "t": 2})
+}
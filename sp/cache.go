@@ -0,0 +1,182 @@
+package sp
+
+import (
+	"sync"
+
+	"github.com/sdboyer/gogl"
+)
+
+// A ShortestPathCache wraps a mutable weighted graph, memoizing the results of
+// Dijkstra's algorithm for each source vertex queried. It is intended for long-lived,
+// query-heavy uses where the graph changes only occasionally.
+//
+// Mutations must be made through the cache's own methods, so that it can invalidate
+// entries as needed; changes made directly to the underlying graph go unnoticed. Rather
+// than clearing everything on each change, the cache drops only those source trees that
+// the change could actually affect:
+//
+//   - Removing an edge (or increasing its weight) affects only trees that use the edge.
+//   - Adding an edge (or decreasing its weight) affects only trees in which the edge
+//     would shorten the path to one of its endpoints.
+//   - Removing a vertex affects only trees that reach it.
+//
+// As with ShortestPath, edge weights must be non-negative, both in the graph as given
+// and in every later change; this is not checked, and results are undefined otherwise.
+// All methods are safe for concurrent use.
+type ShortestPathCache struct {
+	g     gogl.MutableWeightedGraph
+	mu    sync.Mutex
+	trees map[gogl.Vertex]*spTree
+	// Number of Dijkstra runs performed; lets tests distinguish cache hits.
+	computations int
+}
+
+type spTree struct {
+	dist   map[gogl.Vertex]float64
+	parent map[gogl.Vertex]gogl.WeightedEdge
+}
+
+// Creates a new ShortestPathCache over the given graph.
+func NewShortestPathCache(g gogl.MutableWeightedGraph) *ShortestPathCache {
+	return &ShortestPathCache{g: g, trees: make(map[gogl.Vertex]*spTree)}
+}
+
+// Returns the shortest path from source to target, along with its total weight. The
+// path is made up of the graph's own edges. The final return value is false if target is
+// unreachable from source, or either is not present in the graph.
+func (c *ShortestPathCache) ShortestPath(source, target gogl.Vertex) (gogl.Path, float64, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	t, cached := c.trees[source]
+	if !cached {
+		dist, parent := dijkstra(c.g, source)
+		t = &spTree{dist, parent}
+		c.trees[source] = t
+		c.computations++
+	}
+
+	d, reachable := t.dist[target]
+	if !reachable {
+		return nil, 0, false
+	}
+
+	return pathTo(t.parent, target), d, true
+}
+
+// Adds the provided vertices to the underlying graph. This never invalidates the cache.
+func (c *ShortestPathCache) EnsureVertex(vertices ...gogl.Vertex) {
+	c.g.EnsureVertex(vertices...)
+}
+
+// Removes the provided vertices from the underlying graph, invalidating any cached
+// results that reach them.
+func (c *ShortestPathCache) RemoveVertex(vertices ...gogl.Vertex) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.g.RemoveVertex(vertices...)
+	for source, t := range c.trees {
+		for _, v := range vertices {
+			if _, reached := t.dist[v]; reached {
+				delete(c.trees, source)
+				break
+			}
+		}
+	}
+}
+
+// Adds the provided edges to the underlying graph, invalidating any cached results that
+// they would improve upon.
+func (c *ShortestPathCache) AddEdges(edges ...gogl.WeightedEdge) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.g.AddEdges(edges...)
+	for _, e := range edges {
+		c.invalidateImproved(e)
+	}
+}
+
+// Removes the provided edges from the underlying graph, invalidating any cached results
+// that make use of them.
+func (c *ShortestPathCache) RemoveEdges(edges ...gogl.WeightedEdge) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.g.RemoveEdges(edges...)
+	for _, e := range edges {
+		c.invalidateUsing(e)
+	}
+}
+
+// Sets the weight of the edge between u and v, adding the edge if it is not already
+// present. Cached results are invalidated if they used the edge, or if the new weight
+// would improve upon them.
+func (c *ShortestPathCache) SetWeight(u, v gogl.Vertex, weight float64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e := gogl.NewWeightedEdge(u, v, weight)
+	c.g.RemoveEdges(e)
+	c.g.AddEdges(e)
+
+	c.invalidateUsing(e)
+	c.invalidateImproved(e)
+}
+
+// Drops all cached trees that route through the given edge.
+func (c *ShortestPathCache) invalidateUsing(e gogl.Edge) {
+	u, v := e.Both()
+	for source, t := range c.trees {
+		if usesEdge(t.parent, u, v) || usesEdge(t.parent, v, u) {
+			delete(c.trees, source)
+		}
+	}
+}
+
+// Drops all cached trees in which the given edge would shorten the path to one of
+// its endpoints.
+func (c *ShortestPathCache) invalidateImproved(e gogl.WeightedEdge) {
+	u, v := e.Both()
+	for source, t := range c.trees {
+		if improves(t.dist, u, v, e.Weight()) || improves(t.dist, v, u, e.Weight()) {
+			delete(c.trees, source)
+		}
+	}
+}
+
+// Indicates whether the tree reaches to by way of an edge from from.
+func usesEdge(parent map[gogl.Vertex]gogl.WeightedEdge, from, to gogl.Vertex) bool {
+	e, exists := parent[to]
+	return exists && other(e, to) == from
+}
+
+// Indicates whether an edge of weight w from from to to would shorten the path to to.
+func improves(dist map[gogl.Vertex]float64, from, to gogl.Vertex, w float64) bool {
+	df, reached := dist[from]
+	if !reached {
+		return false
+	}
+	dt, reached := dist[to]
+	return !reached || df+w < dt
+}
+
+// Reconstructs the path to the given vertex from the parent edges recorded by a
+// shortest-path search. The path to the source itself is empty.
+func pathTo(parent map[gogl.Vertex]gogl.WeightedEdge, target gogl.Vertex) gogl.Path {
+	var path gogl.Path
+	for v := target; ; {
+		e, exists := parent[v]
+		if !exists {
+			break
+		}
+		path = append(path, e)
+		v = other(e, v)
+	}
+
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path
+}
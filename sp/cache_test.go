@@ -0,0 +1,85 @@
+package sp
+
+import (
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+type ShortestPathCacheSuite struct{}
+
+var _ = Suite(&ShortestPathCacheSuite{})
+
+func newRoadCache() *ShortestPathCache {
+	g := gogl.Spec().Weighted().Using(roads).Create(al.G).(gogl.MutableWeightedGraph)
+	return NewShortestPathCache(g)
+}
+
+func (s *ShortestPathCacheSuite) TestMemoizes(c *C) {
+	spc := newRoadCache()
+
+	path, d, ok := spc.ShortestPath("a", "e")
+	c.Assert(ok, Equals, true)
+	c.Assert(d, Equals, 5.0)
+	c.Assert(path, HasLen, 4)
+	c.Assert(gogl.IsValidPath(spc.g, path), Equals, true)
+
+	_, d, _ = spc.ShortestPath("a", "c")
+	c.Assert(d, Equals, 3.0)
+	c.Assert(spc.computations, Equals, 1)
+
+	_, _, ok = spc.ShortestPath("a", "x")
+	c.Assert(ok, Equals, false)
+
+	path, d, ok = spc.ShortestPath("a", "a")
+	c.Assert(ok, Equals, true)
+	c.Assert(d, Equals, 0.0)
+	c.Assert(path, HasLen, 0)
+}
+
+func (s *ShortestPathCacheSuite) TestRelevantChangeRecomputes(c *C) {
+	spc := newRoadCache()
+	spc.ShortestPath("a", "e")
+
+	// b-c is on the cached path; making it expensive must be noticed
+	spc.SetWeight("b", "c", 10)
+	_, d, _ := spc.ShortestPath("a", "e")
+	c.Assert(d, Equals, 6.0)
+	c.Assert(spc.computations, Equals, 2)
+
+	// a new shortcut improves the path to e
+	spc.AddEdges(gogl.NewWeightedEdge("b", "e", 1))
+	_, d, _ = spc.ShortestPath("a", "e")
+	c.Assert(d, Equals, 2.0)
+	c.Assert(spc.computations, Equals, 3)
+
+	// removing the shortcut falls back to the next best
+	spc.RemoveEdges(gogl.NewWeightedEdge("b", "e", 1))
+	_, d, _ = spc.ShortestPath("a", "e")
+	c.Assert(d, Equals, 6.0)
+	c.Assert(spc.computations, Equals, 4)
+
+	spc.RemoveVertex("d")
+	_, d, _ = spc.ShortestPath("a", "e")
+	c.Assert(d, Equals, 7.0)
+	c.Assert(spc.computations, Equals, 5)
+}
+
+func (s *ShortestPathCacheSuite) TestUnrelatedChangeServesFromCache(c *C) {
+	spc := newRoadCache()
+	spc.ShortestPath("a", "e")
+
+	// a-d is not on any shortest path from a; making it dearer changes nothing
+	spc.SetWeight("a", "d", 50)
+	// nor does anything in the unreachable x-y component
+	spc.AddEdges(gogl.NewWeightedEdge("y", "z", 1))
+	spc.RemoveEdges(gogl.NewWeightedEdge("x", "y", 1))
+	spc.RemoveVertex("z")
+	spc.EnsureVertex("q")
+	// nor does a new edge that is no shortcut
+	spc.AddEdges(gogl.NewWeightedEdge("b", "e", 9))
+
+	_, d, _ := spc.ShortestPath("a", "e")
+	c.Assert(d, Equals, 5.0)
+	c.Assert(spc.computations, Equals, 1)
+}
@@ -0,0 +1,177 @@
+// Contains algos for finding shortest paths through weighted graphs.
+package sp
+
+import (
+	"container/heap"
+	"errors"
+
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+// Computes the shortest-path tree rooted at the given source vertex using Dijkstra's
+// algorithm, returning it as a weighted digraph along with a map of each reachable
+// vertex's distance from the source.
+//
+// Each arc in the returned tree points from a vertex's predecessor on its shortest path
+// to the vertex itself, and carries the weight of the edge it was derived from. The tree
+// contains only the source and the vertices reachable from it, and thus has exactly one
+// fewer arc than it has vertices. If the source is not present in the graph, the tree
+// and distance map are both empty.
+//
+// If the provided graph is a Digraph, paths follow arc direction. As with ShortestPath,
+// an error is returned if any edge in the graph has a negative weight.
+func ShortestPathTree(g gogl.WeightedGraph, source gogl.Vertex) (gogl.WeightedDigraph, map[gogl.Vertex]float64, error) {
+	if hasNegativeWeight(g) {
+		return nil, nil, errors.New("Graph contains a negative edge weight, which Dijkstra's algorithm does not support.")
+	}
+
+	dist, parent := dijkstra(g, source)
+
+	tree := gogl.Spec().Directed().Weighted().Mutable().Create(al.G).(gogl.MutableWeightedDigraph)
+	for v := range dist {
+		tree.EnsureVertex(v)
+		if e, exists := parent[v]; exists {
+			tree.AddArcs(gogl.NewWeightedArc(other(e, v), v, e.Weight()))
+		}
+	}
+
+	return tree, dist, nil
+}
+
+// Finds the shortest path from source to target using Dijkstra's algorithm, returning it
+// along with its total weight. The path is made up of the graph's own edges, except that
+// any edge which is not a WeightedEdge is wrapped in one with weight 1, so that it counts
+// as a single hop; the wrapper has the same endpoints, but is not of the graph's own edge
+// type. The path from a vertex to itself is empty. The search runs in O((V+E) log V),
+// using a binary heap keyed on distance from the source.
+//
+// An error is returned if either vertex is not present in the graph, if target is
+// unreachable from source, or if any edge in the graph has a negative weight, as
+// Dijkstra's algorithm cannot account for those. If the provided graph is a Digraph,
+// paths follow arc direction.
+func ShortestPath(g gogl.WeightedGraph, source, target gogl.Vertex) (gogl.Path, float64, error) {
+	if !g.HasVertex(source) {
+		return nil, 0, errors.New("Source vertex is not present in graph.")
+	}
+	if !g.HasVertex(target) {
+		return nil, 0, errors.New("Target vertex is not present in graph.")
+	}
+
+	if hasNegativeWeight(g) {
+		return nil, 0, errors.New("Graph contains a negative edge weight, which Dijkstra's algorithm does not support.")
+	}
+
+	dist, parent := dijkstra(g, source)
+	d, reachable := dist[target]
+	if !reachable {
+		return nil, 0, errors.New("Target vertex is not reachable from source.")
+	}
+
+	return pathTo(parent, target), d, nil
+}
+
+// Reports whether any edge in the graph has a negative weight.
+func hasNegativeWeight(g gogl.WeightedGraph) (negative bool) {
+	g.Vertices(func(v gogl.Vertex) (terminate bool) {
+		outEdges(g, v, func(e gogl.WeightedEdge, to gogl.Vertex) (terminate bool) {
+			negative = e.Weight() < 0
+			return negative
+		})
+		return negative
+	})
+	return
+}
+
+// Runs Dijkstra's algorithm from the given source, returning the distance to every
+// reachable vertex and the edge by which each non-source vertex was reached.
+func dijkstra(g gogl.WeightedGraph, source gogl.Vertex) (dist map[gogl.Vertex]float64, parent map[gogl.Vertex]gogl.WeightedEdge) {
+	dist = make(map[gogl.Vertex]float64)
+	parent = make(map[gogl.Vertex]gogl.WeightedEdge)
+
+	if !g.HasVertex(source) {
+		return
+	}
+
+	done := make(map[gogl.Vertex]bool)
+	pq := &vpq{{v: source}}
+	dist[source] = 0
+
+	for pq.Len() > 0 {
+		item := heap.Pop(pq).(vpqItem)
+		if done[item.v] {
+			continue
+		}
+		done[item.v] = true
+
+		outEdges(g, item.v, func(e gogl.WeightedEdge, to gogl.Vertex) (terminate bool) {
+			if done[to] {
+				return
+			}
+
+			d := item.dist + e.Weight()
+			if known, exists := dist[to]; !exists || d < known {
+				dist[to] = d
+				parent[to] = e
+				heap.Push(pq, vpqItem{v: to, dist: d})
+			}
+			return
+		})
+	}
+
+	return
+}
+
+// Enumerates the weighted edges leading out of the given vertex, passing each along with
+// the vertex at its far end. For digraphs these are the vertex's out-arcs; for undirected
+// graphs, all of its incident edges. Unweighted edges are treated as having weight 1.
+func outEdges(g gogl.WeightedGraph, v gogl.Vertex, f func(e gogl.WeightedEdge, to gogl.Vertex) (terminate bool)) {
+	if dg, ok := g.(gogl.Digraph); ok {
+		dg.ArcsFrom(v, func(a gogl.Arc) bool {
+			return f(weighted(a), a.Target())
+		})
+	} else {
+		g.IncidentTo(v, func(e gogl.Edge) bool {
+			return f(weighted(e), other(e, v))
+		})
+	}
+}
+
+// Ensures the given edge is a WeightedEdge, wrapping it with a weight of 1 (a single hop)
+// if not.
+func weighted(e gogl.Edge) gogl.WeightedEdge {
+	if we, ok := e.(gogl.WeightedEdge); ok {
+		return we
+	}
+	u, v := e.Both()
+	return gogl.NewWeightedEdge(u, v, 1)
+}
+
+// Returns the endpoint of the given edge opposite the provided vertex.
+func other(e gogl.Edge, v gogl.Vertex) gogl.Vertex {
+	u, w := e.Both()
+	if u == v {
+		return w
+	}
+	return u
+}
+
+// A min-priority queue of vertices, keyed on distance, for use with container/heap.
+type vpqItem struct {
+	v    gogl.Vertex
+	dist float64
+}
+
+type vpq []vpqItem
+
+func (q vpq) Len() int            { return len(q) }
+func (q vpq) Less(i, j int) bool  { return q[i].dist < q[j].dist }
+func (q vpq) Swap(i, j int)       { q[i], q[j] = q[j], q[i] }
+func (q *vpq) Push(x interface{}) { *q = append(*q, x.(vpqItem)) }
+
+func (q *vpq) Pop() interface{} {
+	old := *q
+	item := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return item
+}
@@ -0,0 +1,175 @@
+package sp
+
+import (
+	"math/rand"
+	"testing"
+
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+// Hook gocheck into the go test runner
+func Test(t *testing.T) { TestingT(t) }
+
+// A small road network; the direct a-d road is longer than going around through b and c.
+var roads = gogl.WeightedEdgeList{
+	gogl.NewWeightedEdge("a", "b", 1),
+	gogl.NewWeightedEdge("b", "c", 2),
+	gogl.NewWeightedEdge("c", "d", 1),
+	gogl.NewWeightedEdge("a", "d", 5),
+	gogl.NewWeightedEdge("a", "e", 7),
+	gogl.NewWeightedEdge("d", "e", 1),
+	gogl.NewWeightedEdge("x", "y", 1),
+}
+
+type ShortestPathTreeSuite struct{}
+
+var _ = Suite(&ShortestPathTreeSuite{})
+
+func (s *ShortestPathTreeSuite) TestTree(c *C) {
+	g := gogl.Spec().Weighted().Using(roads).Create(al.G).(gogl.WeightedGraph)
+	tree, dist, err := ShortestPathTree(g, "a")
+	c.Assert(err, IsNil)
+
+	c.Assert(dist, DeepEquals, map[gogl.Vertex]float64{"a": 0, "b": 1, "c": 3, "d": 4, "e": 5})
+	c.Assert(gogl.Order(tree), Equals, 5)
+	c.Assert(gogl.Size(tree), Equals, 4)
+	c.Assert(tree.HasVertex("x"), Equals, false)
+
+	tree.Vertices(func(v gogl.Vertex) (terminate bool) {
+		indeg, _ := tree.InDegreeOf(v)
+		if v == "a" {
+			c.Assert(indeg, Equals, 0)
+			return
+		}
+
+		c.Assert(indeg, Equals, 1)
+		tree.ArcsTo(v, func(a gogl.Arc) (terminate bool) {
+			w := a.(gogl.WeightedArc).Weight()
+			c.Assert(dist[a.Source()]+w, Equals, dist[v])
+			c.Assert(g.HasWeightedEdge(gogl.NewWeightedEdge(a.Source(), v, w)), Equals, true)
+			return
+		})
+		return
+	})
+}
+
+func (s *ShortestPathTreeSuite) TestDirected(c *C) {
+	g := gogl.Spec().Directed().Weighted().Using(gogl.WeightedArcList{
+		gogl.NewWeightedArc("a", "b", 1),
+		gogl.NewWeightedArc("c", "a", 1),
+	}).Create(al.G).(gogl.WeightedGraph)
+
+	tree, dist, err := ShortestPathTree(g, "a")
+	c.Assert(err, IsNil)
+	c.Assert(dist, DeepEquals, map[gogl.Vertex]float64{"a": 0, "b": 1})
+	c.Assert(tree.HasArc(gogl.NewArc("a", "b")), Equals, true)
+
+	tree, dist, err = ShortestPathTree(g, "missing")
+	c.Assert(err, IsNil)
+	c.Assert(len(dist), Equals, 0)
+	c.Assert(gogl.Order(tree), Equals, 0)
+}
+
+func (s *ShortestPathTreeSuite) TestNegativeWeight(c *C) {
+	g := gogl.Spec().Directed().Weighted().Using(gogl.WeightedArcList{
+		gogl.NewWeightedArc("a", "b", 1),
+		gogl.NewWeightedArc("c", "d", -1),
+	}).Create(al.G).(gogl.WeightedGraph)
+
+	tree, dist, err := ShortestPathTree(g, "a")
+	c.Assert(err, ErrorMatches, ".*negative edge weight.*")
+	c.Assert(tree, IsNil)
+	c.Assert(dist, IsNil)
+}
+
+type ShortestPathSuite struct{}
+
+var _ = Suite(&ShortestPathSuite{})
+
+func (s *ShortestPathSuite) TestPath(c *C) {
+	g := gogl.Spec().Weighted().Using(roads).Create(al.G).(gogl.WeightedGraph)
+
+	path, d, err := ShortestPath(g, "a", "e")
+	c.Assert(err, IsNil)
+	c.Assert(d, Equals, float64(5))
+	c.Assert(path, HasLen, 4)
+	c.Assert(gogl.IsValidPath(g, path), Equals, true)
+
+	path, d, err = ShortestPath(g, "c", "c")
+	c.Assert(err, IsNil)
+	c.Assert(d, Equals, float64(0))
+	c.Assert(path, HasLen, 0)
+}
+
+func (s *ShortestPathSuite) TestErrors(c *C) {
+	g := gogl.Spec().Weighted().Using(roads).Create(al.G).(gogl.WeightedGraph)
+
+	_, _, err := ShortestPath(g, "a", "x")
+	c.Assert(err, ErrorMatches, "Target vertex is not reachable.*")
+	_, _, err = ShortestPath(g, "missing", "a")
+	c.Assert(err, ErrorMatches, "Source vertex is not present.*")
+	_, _, err = ShortestPath(g, "a", "missing")
+	c.Assert(err, ErrorMatches, "Target vertex is not present.*")
+
+	// A negative weight anywhere is rejected, even off the path.
+	neg := gogl.Spec().Directed().Weighted().Using(gogl.WeightedArcList{
+		gogl.NewWeightedArc("a", "b", 1),
+		gogl.NewWeightedArc("c", "d", -1),
+	}).Create(al.G).(gogl.WeightedGraph)
+	_, _, err = ShortestPath(neg, "a", "b")
+	c.Assert(err, ErrorMatches, ".*negative edge weight.*")
+}
+
+// Presents an unweighted graph as a WeightedGraph, without giving its edges weights.
+type unweighted struct {
+	gogl.Graph
+}
+
+func (g unweighted) HasWeightedEdge(e gogl.WeightedEdge) bool {
+	return false
+}
+
+func (s *ShortestPathSuite) TestUnweightedEdgesCountHops(c *C) {
+	g := unweighted{gogl.Spec().Using(gogl.EdgeList{
+		gogl.NewEdge("a", "b"),
+		gogl.NewEdge("b", "c"),
+		gogl.NewEdge("c", "d"),
+		gogl.NewEdge("a", "c"),
+	}).Create(al.G)}
+
+	path, d, err := ShortestPath(g, "a", "d")
+	c.Assert(err, IsNil)
+	c.Assert(d, Equals, float64(2))
+	c.Assert(path, HasLen, 2)
+	for _, e := range path {
+		c.Assert(e.(gogl.WeightedEdge).Weight(), Equals, float64(1))
+		c.Assert(g.HasEdge(e), Equals, true)
+	}
+}
+
+// A random sparse graph with n vertices and average degree around 8.
+func benchGraph(n int) gogl.WeightedGraph {
+	r := rand.New(rand.NewSource(1))
+	el := gogl.WeightedEdgeList{}
+	for i := 0; i < n; i++ {
+		el = append(el, gogl.NewWeightedEdge(i, (i+1)%n, float64(r.Intn(100))))
+		for k := 0; k < 3; k++ {
+			el = append(el, gogl.NewWeightedEdge(i, r.Intn(n), float64(r.Intn(100))))
+		}
+	}
+	return gogl.Spec().Weighted().Using(el).Create(al.G).(gogl.WeightedGraph)
+}
+
+func benchmarkShortestPath(b *testing.B, n int) {
+	g := benchGraph(n)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ShortestPath(g, 0, n/2)
+	}
+}
+
+func BenchmarkShortestPath1000(b *testing.B)  { benchmarkShortestPath(b, 1000) }
+func BenchmarkShortestPath4000(b *testing.B)  { benchmarkShortestPath(b, 4000) }
+func BenchmarkShortestPath16000(b *testing.B) { benchmarkShortestPath(b, 16000) }
@@ -0,0 +1,157 @@
+package sp
+
+import (
+	"container/heap"
+	"errors"
+
+	"github.com/sdboyer/gogl"
+)
+
+// A DynamicSSSP maintains shortest paths from a single source vertex through a mutable
+// weighted graph whose edge weights only ever decrease, as with continuously updated
+// travel times on a road network.
+//
+// Decreasing an edge's weight can only shorten paths, so rather than rerunning
+// Dijkstra's algorithm, the new weight is relaxed and improvements propagated outward
+// from the edge; only vertices whose distance actually changes are revisited.
+//
+// Weight changes must be made through DecreaseWeight; changes made directly to the
+// underlying graph go unnoticed. A DynamicSSSP is not safe for concurrent use.
+type DynamicSSSP struct {
+	g      gogl.WeightedGraph
+	source gogl.Vertex
+	dist   map[gogl.Vertex]float64
+	parent map[gogl.Vertex]gogl.WeightedEdge
+	// Number of vertices whose distance has been updated by decreases; lets tests
+	// verify that work is limited to the affected region.
+	updated int
+}
+
+// Creates a new DynamicSSSP over the given graph, computing initial shortest paths from
+// the given source. The graph must be either a MutableWeightedGraph or a
+// MutableWeightedDigraph; this function panics otherwise.
+//
+// An error is returned if any edge in the graph has a negative weight. Those would make
+// Dijkstra's algorithm unsound, and in undirected graphs a single negative edge is a
+// negative cycle, through which decreases would propagate forever.
+func NewDynamicSSSP(g gogl.WeightedGraph, source gogl.Vertex) (*DynamicSSSP, error) {
+	switch g.(type) {
+	case gogl.MutableWeightedGraph, gogl.MutableWeightedDigraph:
+	default:
+		panic("DynamicSSSP requires a mutable weighted graph.")
+	}
+
+	if hasNegativeWeight(g) {
+		return nil, errors.New("Graph contains a negative edge weight, which DynamicSSSP does not support.")
+	}
+
+	dist, parent := dijkstra(g, source)
+	return &DynamicSSSP{g: g, source: source, dist: dist, parent: parent}, nil
+}
+
+// Returns the distance from the source to the given vertex. The second return value is
+// false if the vertex is unreachable.
+func (d *DynamicSSSP) Distance(v gogl.Vertex) (float64, bool) {
+	dist, reachable := d.dist[v]
+	return dist, reachable
+}
+
+// Returns the shortest path from the source to the given vertex, along with its total
+// weight. The final return value is false if the vertex is unreachable.
+func (d *DynamicSSSP) ShortestPath(target gogl.Vertex) (gogl.Path, float64, bool) {
+	dist, reachable := d.dist[target]
+	if !reachable {
+		return nil, 0, false
+	}
+	return pathTo(d.parent, target), dist, true
+}
+
+// Lowers the weight of the given edge in the underlying graph to the provided value,
+// then updates all affected shortest paths. In digraphs, the edge is taken as an arc
+// from the first vertex returned by Both() to the second.
+//
+// Returns an error, leaving everything untouched, if the new weight is negative, if the
+// edge is not present in the graph, or if the new weight is greater than its current
+// weight.
+func (d *DynamicSSSP) DecreaseWeight(e gogl.Edge, weight float64) error {
+	if weight < 0 {
+		return errors.New("Negative edge weights are not supported.")
+	}
+
+	u, v := e.Both()
+	current, exists := d.weightOf(u, v)
+	if !exists {
+		return errors.New("Edge is not present in the graph.")
+	}
+	if weight > current {
+		return errors.New("New weight exceeds the edge's current weight; only decreases are supported.")
+	}
+
+	we := gogl.NewWeightedArc(u, v, weight)
+	if dg, ok := d.g.(gogl.MutableWeightedDigraph); ok {
+		dg.RemoveArcs(we)
+		dg.AddArcs(we)
+	} else {
+		mg := d.g.(gogl.MutableWeightedGraph)
+		mg.RemoveEdges(we)
+		mg.AddEdges(we)
+	}
+
+	// Point the tree at the new edge object wherever it used the old one, so that paths
+	// carry the new weight even if the decrease improves nothing.
+	if pe, exists := d.parent[v]; exists && other(pe, v) == u {
+		d.parent[v] = we
+	}
+	if pe, exists := d.parent[u]; exists && other(pe, u) == v && !d.g.IsDirected() {
+		d.parent[u] = we
+	}
+
+	pq := &vpq{}
+	d.relax(we, u, v, pq)
+	if !d.g.IsDirected() {
+		d.relax(we, v, u, pq)
+	}
+
+	for pq.Len() > 0 {
+		item := heap.Pop(pq).(vpqItem)
+		if item.dist > d.dist[item.v] {
+			continue
+		}
+
+		outEdges(d.g, item.v, func(oe gogl.WeightedEdge, to gogl.Vertex) (terminate bool) {
+			d.relax(oe, item.v, to, pq)
+			return
+		})
+	}
+
+	return nil
+}
+
+// Checks whether the edge e, running from from to to, shortens the path to to; if so,
+// records the improvement and queues to for propagation.
+func (d *DynamicSSSP) relax(e gogl.WeightedEdge, from, to gogl.Vertex, pq *vpq) {
+	df, reached := d.dist[from]
+	if !reached {
+		return
+	}
+
+	nd := df + e.Weight()
+	if dt, reached := d.dist[to]; !reached || nd < dt {
+		d.dist[to] = nd
+		d.parent[to] = e
+		d.updated++
+		heap.Push(pq, vpqItem{v: to, dist: nd})
+	}
+}
+
+// Returns the current weight of the edge (or, in a digraph, arc) from u to v.
+func (d *DynamicSSSP) weightOf(u, v gogl.Vertex) (w float64, exists bool) {
+	outEdges(d.g, u, func(e gogl.WeightedEdge, to gogl.Vertex) (terminate bool) {
+		if to == v {
+			w, exists = e.Weight(), true
+			return true
+		}
+		return
+	})
+	return
+}
@@ -0,0 +1,121 @@
+package sp
+
+import (
+	"math/rand"
+
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+type DynamicSSSPSuite struct{}
+
+var _ = Suite(&DynamicSSSPSuite{})
+
+func randomWeighted(r *rand.Rand, directed bool) gogl.WeightedGraph {
+	if directed {
+		arcs := gogl.WeightedArcList{}
+		for i := 0; i < 150; i++ {
+			arcs = append(arcs, gogl.NewWeightedArc(r.Intn(40), r.Intn(40), float64(1+r.Intn(50))))
+		}
+		return gogl.Spec().Directed().Weighted().Using(arcs).Create(al.G).(gogl.WeightedGraph)
+	}
+
+	el := gogl.WeightedEdgeList{}
+	for i := 0; i < 100; i++ {
+		el = append(el, gogl.NewWeightedEdge(r.Intn(40), r.Intn(40), float64(1+r.Intn(50))))
+	}
+	return gogl.Spec().Weighted().Using(el).Create(al.G).(gogl.MutableWeightedGraph)
+}
+
+func (s *DynamicSSSPSuite) TestMatchesDijkstra(c *C) {
+	r := rand.New(rand.NewSource(31))
+	for _, directed := range []bool{false, true} {
+		g := randomWeighted(r, directed)
+		d, err := NewDynamicSSSP(g, 0)
+		c.Assert(err, IsNil)
+
+		for i := 0; i < 200; i++ {
+			edges := gogl.CollectEdges(g)
+			if dg, ok := g.(gogl.Digraph); ok {
+				edges = edges[:0]
+				dg.Arcs(func(a gogl.Arc) (terminate bool) {
+					edges = append(edges, a)
+					return
+				})
+			}
+
+			e := edges[r.Intn(len(edges))].(gogl.WeightedEdge)
+			c.Assert(d.DecreaseWeight(e, e.Weight()*r.Float64()), IsNil)
+
+			dist, _ := dijkstra(g, 0)
+			c.Assert(d.dist, DeepEquals, dist)
+		}
+
+		for v, dist := range d.dist {
+			path, pd, ok := d.ShortestPath(v)
+			c.Assert(ok, Equals, true)
+			c.Assert(pd, Equals, dist)
+			c.Assert(gogl.IsValidPath(g, path), Equals, true)
+
+			var sum float64
+			for _, e := range path {
+				sum += e.(gogl.WeightedEdge).Weight()
+			}
+			c.Assert(sum-dist < 1e-9 && dist-sum < 1e-9, Equals, true)
+		}
+	}
+}
+
+func (s *DynamicSSSPSuite) TestLocalUpdates(c *C) {
+	g := gogl.Spec().Weighted().Using(roads).Create(al.G).(gogl.MutableWeightedGraph)
+	d, err := NewDynamicSSSP(g, "a")
+	c.Assert(err, IsNil)
+
+	dist, _ := d.Distance("e")
+	c.Assert(dist, Equals, 5.0)
+
+	// A decrease on an edge that no shortest path can use touches nothing.
+	c.Assert(d.DecreaseWeight(gogl.NewEdge("a", "d"), 4.5), IsNil)
+	c.Assert(d.updated, Equals, 0)
+
+	c.Assert(d.DecreaseWeight(gogl.NewEdge("d", "e"), 0), IsNil)
+	dist, _ = d.Distance("e")
+	c.Assert(dist, Equals, 4.0)
+	c.Assert(d.updated, Equals, 1)
+}
+
+func (s *DynamicSSSPSuite) TestRejects(c *C) {
+	g := gogl.Spec().Weighted().Using(roads).Create(al.G).(gogl.MutableWeightedGraph)
+	d, err := NewDynamicSSSP(g, "a")
+	c.Assert(err, IsNil)
+
+	c.Assert(d.DecreaseWeight(gogl.NewEdge("a", "b"), 100), ErrorMatches, ".*only decreases.*")
+	c.Assert(d.DecreaseWeight(gogl.NewEdge("a", "x"), 1), ErrorMatches, ".*not present.*")
+
+	frozen := struct{ gogl.WeightedGraph }{g}
+	c.Assert(func() { NewDynamicSSSP(frozen, "a") }, PanicMatches, ".*mutable.*")
+
+	_, ok := d.Distance("x")
+	c.Assert(ok, Equals, false)
+}
+
+func (s *DynamicSSSPSuite) TestRejectsNegativeWeights(c *C) {
+	g := gogl.Spec().Weighted().Using(roads).Create(al.G).(gogl.MutableWeightedGraph)
+	d, err := NewDynamicSSSP(g, "a")
+	c.Assert(err, IsNil)
+
+	// In an undirected graph, a negative edge would be a negative cycle.
+	c.Assert(d.DecreaseWeight(gogl.NewEdge("a", "b"), -1), ErrorMatches, "Negative edge weights.*")
+	c.Assert(g.HasWeightedEdge(gogl.NewWeightedEdge("a", "b", 1)), Equals, true)
+	dist, _ := d.Distance("b")
+	c.Assert(dist, Equals, 1.0)
+
+	neg := gogl.Spec().Directed().Weighted().Using(gogl.WeightedArcList{
+		gogl.NewWeightedArc("a", "b", 1),
+		gogl.NewWeightedArc("b", "a", -2),
+	}).Create(al.G).(gogl.WeightedGraph)
+	d, err = NewDynamicSSSP(neg, "a")
+	c.Assert(err, ErrorMatches, ".*negative edge weight.*")
+	c.Assert(d, IsNil)
+}
@@ -0,0 +1,123 @@
+package sp
+
+import (
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+)
+
+// A minimal undirected weighted multigraph, backed by a list of edges. gogl has no
+// multigraph implementation of its own, but algorithms must not assume that a pair of
+// vertices identifies a single edge.
+type multigraph gogl.WeightedEdgeList
+
+func (m multigraph) Vertices(f gogl.VertexStep) {
+	gogl.WeightedEdgeList(m).Vertices(f)
+}
+
+func (m multigraph) Edges(f gogl.EdgeStep) {
+	gogl.WeightedEdgeList(m).Edges(f)
+}
+
+func (m multigraph) IncidentTo(v gogl.Vertex, f gogl.EdgeStep) {
+	for _, e := range m {
+		if a, b := e.Both(); a == v || b == v {
+			if f(e) {
+				return
+			}
+		}
+	}
+}
+
+func (m multigraph) AdjacentTo(v gogl.Vertex, f gogl.VertexStep) {
+	m.IncidentTo(v, func(e gogl.Edge) bool {
+		return f(other(e, v))
+	})
+}
+
+func (m multigraph) HasVertex(v gogl.Vertex) (exists bool) {
+	m.IncidentTo(v, func(gogl.Edge) bool {
+		exists = true
+		return true
+	})
+	return
+}
+
+func (m multigraph) HasEdge(e gogl.Edge) (exists bool) {
+	u, v := e.Both()
+	m.IncidentTo(u, func(ie gogl.Edge) bool {
+		exists = other(ie, u) == v
+		return exists
+	})
+	return
+}
+
+func (m multigraph) HasWeightedEdge(e gogl.WeightedEdge) bool {
+	u, v := e.Both()
+	for _, me := range m {
+		a, b := me.Both()
+		if me.Weight() == e.Weight() && ((a == u && b == v) || (a == v && b == u)) {
+			return true
+		}
+	}
+	return false
+}
+
+func (m multigraph) DegreeOf(v gogl.Vertex) (degree int, exists bool) {
+	m.IncidentTo(v, func(gogl.Edge) bool {
+		degree++
+		return false
+	})
+	return degree, degree > 0
+}
+
+func (m multigraph) IsDirected() bool {
+	return false
+}
+
+type MultigraphSuite struct{}
+
+var _ = Suite(&MultigraphSuite{})
+
+// a and b are joined by a heavy and a light parallel edge; b and c by a light and a heavy.
+var heavy, light = gogl.NewWeightedEdge("a", "b", 5), gogl.NewWeightedEdge("a", "b", 1)
+var parallels = multigraph{
+	heavy,
+	light,
+	gogl.NewWeightedEdge("b", "c", 2),
+	gogl.NewWeightedEdge("c", "b", 7),
+}
+
+func (s *MultigraphSuite) TestDijkstraPicksLighterEdge(c *C) {
+	dist, parent := dijkstra(parallels, "a")
+	c.Assert(dist, DeepEquals, map[gogl.Vertex]float64{"a": 0, "b": 1, "c": 3})
+
+	path := pathTo(parent, "c")
+	c.Assert(path, DeepEquals, gogl.Path{light, parallels[2]})
+
+	tree, _, err := ShortestPathTree(parallels, "a")
+	c.Assert(err, IsNil)
+	c.Assert(tree.HasWeightedArc(gogl.NewWeightedArc("a", "b", 1)), Equals, true)
+}
+
+func (s *MultigraphSuite) TestSuurballeUsesBothParallels(c *C) {
+	paths, weight, ok := Suurballe(parallels, "a", "b")
+	c.Assert(ok, Equals, true)
+	c.Assert(weight, Equals, 6.0)
+	c.Assert(paths, DeepEquals, [2]gogl.Path{{light}, {heavy}})
+
+	paths, weight, ok = Suurballe(parallels, "a", "c")
+	c.Assert(ok, Equals, true)
+	c.Assert(weight, Equals, 15.0)
+
+	// Either pairing of the parallels is optimal, but every edge must be used, as is.
+	used := make(map[gogl.Edge]int)
+	for _, p := range paths {
+		c.Assert(p, HasLen, 2)
+		for _, e := range p {
+			used[e]++
+		}
+	}
+	for _, e := range parallels {
+		c.Assert(used[e], Equals, 1)
+	}
+}
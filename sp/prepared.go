@@ -0,0 +1,120 @@
+package sp
+
+import (
+	"math"
+
+	"github.com/sdboyer/gogl"
+)
+
+// A PreparedAPSP holds precomputed shortest paths between every pair of vertices in a
+// graph, so that distance queries are O(1) and path queries O(path length), with no
+// search performed at query time. It is intended for query-heavy uses over a graph that
+// does not change.
+//
+// The result is a snapshot: later changes to the graph it was prepared from are not
+// reflected. A PreparedAPSP is never modified after creation, so it is safe for
+// concurrent use.
+type PreparedAPSP struct {
+	index map[gogl.Vertex]int
+	n     int
+	dist  []float64
+	// The first edge on the shortest path from i to j, at i*n+j.
+	next []gogl.WeightedEdge
+}
+
+// Prepares all-pairs shortest paths for the given graph using the Floyd-Warshall
+// algorithm. This takes O(V^3) time and O(V^2) space, so it is best suited to graphs of
+// modest order.
+//
+// If the provided graph is a Digraph, paths follow arc direction. Unlike Dijkstra's
+// algorithm, negative arc weights are supported, provided there are no negative cycles;
+// results are undefined if any are present. (In an undirected graph, a single negative
+// edge is itself a negative cycle.)
+func PrepareAPSP(g gogl.WeightedGraph) *PreparedAPSP {
+	vertices := gogl.CollectVertices(g)
+	n := len(vertices)
+	p := &PreparedAPSP{
+		index: make(map[gogl.Vertex]int, n),
+		n:     n,
+		dist:  make([]float64, n*n),
+		next:  make([]gogl.WeightedEdge, n*n),
+	}
+
+	for i, v := range vertices {
+		p.index[v] = i
+	}
+	for k := range p.dist {
+		p.dist[k] = math.Inf(1)
+	}
+
+	for i, u := range vertices {
+		p.dist[i*n+i] = 0
+		outEdges(g, u, func(e gogl.WeightedEdge, v gogl.Vertex) (terminate bool) {
+			if ij := i*n + p.index[v]; e.Weight() < p.dist[ij] {
+				p.dist[ij] = e.Weight()
+				p.next[ij] = e
+			}
+			return
+		})
+	}
+
+	for k := 0; k < n; k++ {
+		for i := 0; i < n; i++ {
+			dik := p.dist[i*n+k]
+			if math.IsInf(dik, 1) {
+				continue
+			}
+			for j := 0; j < n; j++ {
+				if d := dik + p.dist[k*n+j]; d < p.dist[i*n+j] {
+					p.dist[i*n+j] = d
+					p.next[i*n+j] = p.next[i*n+k]
+				}
+			}
+		}
+	}
+
+	return p
+}
+
+// Returns the length of the shortest path from u to v. The second return value is false
+// if v is unreachable from u, or either is not present in the prepared graph.
+func (p *PreparedAPSP) Distance(u, v gogl.Vertex) (float64, bool) {
+	i, j, ok := p.pair(u, v)
+	if !ok {
+		return 0, false
+	}
+
+	d := p.dist[i*p.n+j]
+	if math.IsInf(d, 1) {
+		return 0, false
+	}
+	return d, true
+}
+
+// Returns the shortest path from u to v, made up of the graph's own edges. The path from
+// a vertex to itself is empty. The second return value is false if v is unreachable from
+// u, or either is not present in the prepared graph.
+func (p *PreparedAPSP) Path(u, v gogl.Vertex) (gogl.Path, bool) {
+	i, j, ok := p.pair(u, v)
+	if !ok || math.IsInf(p.dist[i*p.n+j], 1) {
+		return nil, false
+	}
+
+	path := gogl.Path{}
+	for i != j {
+		e := p.next[i*p.n+j]
+		path = append(path, e)
+		u = other(e, u)
+		i = p.index[u]
+	}
+	return path, true
+}
+
+// Looks up the indices of the given pair of vertices.
+func (p *PreparedAPSP) pair(u, v gogl.Vertex) (i, j int, ok bool) {
+	if i, ok = p.index[u]; !ok {
+		return
+	}
+	j, ok = p.index[v]
+	return
+}
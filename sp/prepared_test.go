@@ -0,0 +1,73 @@
+package sp
+
+import (
+	"math"
+	"math/rand"
+
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+type PreparedAPSPSuite struct{}
+
+var _ = Suite(&PreparedAPSPSuite{})
+
+func (s *PreparedAPSPSuite) TestMatchesDijkstra(c *C) {
+	r := rand.New(rand.NewSource(7))
+	for _, g := range []gogl.WeightedGraph{
+		randomAPSPGraph(r, 25, 70),
+		gogl.Spec().Weighted().Using(roads).Create(al.G).(gogl.WeightedGraph),
+	} {
+		p := PrepareAPSP(g)
+		vertices := gogl.CollectVertices(g)
+
+		for _, u := range vertices {
+			dist, _ := dijkstra(g, u)
+			for _, v := range vertices {
+				want, reachable := dist[v]
+				d, ok := p.Distance(u, v)
+				c.Assert(ok, Equals, reachable)
+
+				path, ok := p.Path(u, v)
+				c.Assert(ok, Equals, reachable)
+				if !reachable {
+					c.Assert(path, IsNil)
+					continue
+				}
+
+				c.Assert(math.Abs(d-want) < 1e-9, Equals, true)
+				c.Assert(gogl.IsValidPath(g, path), Equals, true)
+
+				var total float64
+				for _, e := range path {
+					total += e.(gogl.WeightedEdge).Weight()
+				}
+				c.Assert(math.Abs(total-want) < 1e-9, Equals, true)
+			}
+		}
+	}
+}
+
+func (s *PreparedAPSPSuite) TestUnreachable(c *C) {
+	g := gogl.Spec().Directed().Weighted().Using(gogl.WithIsolates(gogl.WeightedArcList{
+		gogl.NewWeightedArc("a", "b", 2),
+		gogl.NewWeightedArc("b", "c", -1),
+	}, "z")).Create(al.G).(gogl.WeightedGraph)
+	p := PrepareAPSP(g)
+
+	d, ok := p.Distance("a", "c")
+	c.Assert(ok, Equals, true)
+	c.Assert(d, Equals, float64(1))
+
+	path, ok := p.Path("a", "a")
+	c.Assert(ok, Equals, true)
+	c.Assert(path, HasLen, 0)
+
+	for _, pair := range [][2]gogl.Vertex{{"c", "a"}, {"a", "z"}, {"z", "a"}, {"a", "missing"}, {"missing", "a"}} {
+		_, ok = p.Distance(pair[0], pair[1])
+		c.Assert(ok, Equals, false)
+		_, ok = p.Path(pair[0], pair[1])
+		c.Assert(ok, Equals, false)
+	}
+}
@@ -0,0 +1,33 @@
+package sp
+
+import (
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+// Materializes budgeted reachability in the given graph as a weighted digraph: there is
+// an arc from u to v iff v is reachable from u by a path with a total weight of at most
+// budget, and the arc's weight is the least such total. This answers "what can be
+// reached within a given time or fuel budget" for every vertex at once.
+//
+// Every vertex of the original graph is present in the result. Trivial paths are not
+// represented, so the result has no loops. If the provided graph is a Digraph, paths
+// follow arc direction; otherwise the result is symmetric. As with Dijkstra's algorithm,
+// weights must be non-negative.
+func CostReachability(g gogl.WeightedGraph, budget float64) gogl.WeightedDigraph {
+	arcs := gogl.WeightedArcList{}
+	var vertices []gogl.Vertex
+	gogl.VerticesSorted(g, func(u gogl.Vertex) (terminate bool) {
+		vertices = append(vertices, u)
+
+		dist, _ := dijkstra(g, u)
+		for v, d := range dist {
+			if v != u && d <= budget {
+				arcs = append(arcs, gogl.NewWeightedArc(u, v, d))
+			}
+		}
+		return
+	})
+
+	return gogl.Spec().Directed().Weighted().Using(gogl.WithIsolates(arcs, vertices...)).Create(al.G).(gogl.WeightedDigraph)
+}
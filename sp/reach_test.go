@@ -0,0 +1,57 @@
+package sp
+
+import (
+	"math/rand"
+
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+type CostReachabilitySuite struct{}
+
+var _ = Suite(&CostReachabilitySuite{})
+
+func (s *CostReachabilitySuite) TestRoads(c *C) {
+	g := gogl.Spec().Weighted().Using(roads).Create(al.G).(gogl.WeightedGraph)
+
+	r := CostReachability(g, 3)
+	c.Assert(gogl.Order(r), Equals, gogl.Order(g))
+	c.Assert(r.HasWeightedArc(gogl.NewWeightedArc("a", "c", 3)), Equals, true)
+	c.Assert(r.HasWeightedArc(gogl.NewWeightedArc("c", "a", 3)), Equals, true)
+	c.Assert(r.HasArc(gogl.NewArc("a", "d")), Equals, false)
+	c.Assert(r.HasArc(gogl.NewArc("a", "x")), Equals, false)
+	c.Assert(r.HasArc(gogl.NewArc("a", "a")), Equals, false)
+
+	c.Assert(gogl.Size(CostReachability(g, 0)), Equals, 0)
+}
+
+func (s *CostReachabilitySuite) TestMonotoneAndExact(c *C) {
+	rnd := rand.New(rand.NewSource(8))
+	arcs := gogl.WeightedArcList{}
+	for i := 0; i < 80; i++ {
+		arcs = append(arcs, gogl.NewWeightedArc(rnd.Intn(25), rnd.Intn(25), float64(rnd.Intn(10))))
+	}
+	g := gogl.Spec().Directed().Weighted().Using(arcs).Create(al.G).(gogl.WeightedGraph)
+
+	prev := CostReachability(g, 0)
+	for _, budget := range []float64{2, 5, 10, 20, 50} {
+		r := CostReachability(g, budget)
+		c.Assert(gogl.Size(r) >= gogl.Size(prev), Equals, true)
+
+		// Every arc of the smaller budget survives, with the same cost.
+		prev.Arcs(func(a gogl.Arc) (terminate bool) {
+			c.Assert(r.HasWeightedArc(a.(gogl.WeightedArc)), Equals, true)
+			return
+		})
+
+		r.Arcs(func(a gogl.Arc) (terminate bool) {
+			dist, _ := dijkstra(g, a.Source())
+			w := a.(gogl.WeightedArc).Weight()
+			c.Assert(w, Equals, dist[a.Target()])
+			c.Assert(w <= budget, Equals, true)
+			return
+		})
+		prev = r
+	}
+}
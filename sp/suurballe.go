@@ -0,0 +1,192 @@
+package sp
+
+import (
+	"container/heap"
+	"math"
+
+	"github.com/sdboyer/gogl"
+)
+
+// An arc in the residual network used by Suurballe. Arcs are stored in pairs, so the
+// residual partner of arc i is always arc i^1.
+type sarc struct {
+	to, cap int
+	cost    float64
+	edge    gogl.WeightedEdge
+}
+
+// Finds a pair of edge-disjoint paths from source to target with the least possible
+// combined weight, using Suurballe's algorithm. Returns the two paths, made up of the
+// graph's own edges, and their combined weight; the lighter path comes first. The final
+// return value is false, and the paths are empty, if no two edge-disjoint paths exist.
+//
+// The first path is found with Dijkstra's algorithm; the graph is then reweighted by the
+// resulting shortest-path distances so that all weights remain non-negative once that
+// path's arcs are reversed, and the second search runs over that residual graph. Where
+// the two searches traverse an edge in opposite directions, the traversals cancel out
+// and the paths are recombined.
+//
+// If the provided graph is a Digraph, paths follow arc direction. As with Dijkstra's
+// algorithm, negative edge weights are not supported.
+func Suurballe(g gogl.WeightedGraph, source, target gogl.Vertex) ([2]gogl.Path, float64, bool) {
+	var none [2]gogl.Path
+	if source == target || !g.HasVertex(source) || !g.HasVertex(target) {
+		return none, 0, false
+	}
+
+	index := make(map[gogl.Vertex]int)
+	gogl.VerticesSorted(g, func(v gogl.Vertex) (terminate bool) {
+		index[v] = len(index)
+		return
+	})
+
+	adj := make([][]int, len(index))
+	var arcs []sarc
+	add := func(u, v int, e gogl.WeightedEdge) {
+		adj[u] = append(adj[u], len(arcs))
+		arcs = append(arcs, sarc{to: v, cap: 1, cost: e.Weight(), edge: e})
+		adj[v] = append(adj[v], len(arcs))
+		arcs = append(arcs, sarc{to: u, cap: 0, cost: -e.Weight(), edge: e})
+	}
+
+	dg, directed := g.(gogl.Digraph)
+	if directed {
+		dg.Arcs(func(a gogl.Arc) (terminate bool) {
+			if a.Source() != a.Target() {
+				add(index[a.Source()], index[a.Target()], weighted(a))
+			}
+			return
+		})
+	} else {
+		g.Edges(func(e gogl.Edge) (terminate bool) {
+			if u, v := e.Both(); u != v {
+				add(index[u], index[v], weighted(e))
+				add(index[v], index[u], weighted(e))
+			}
+			return
+		})
+	}
+
+	s, t := index[source], index[target]
+	pot := make([]float64, len(index))
+	for round := 0; round < 2; round++ {
+		dist, via := residualDijkstra(adj, arcs, pot, s)
+		if math.IsInf(dist[t], 1) {
+			return none, 0, false
+		}
+
+		for v := t; v != s; v = arcs[via[v]^1].to {
+			arcs[via[v]].cap--
+			arcs[via[v]^1].cap++
+		}
+
+		// Reweight by the new distances, keeping reduced costs non-negative.
+		for v := range pot {
+			if !math.IsInf(dist[v], 1) {
+				pot[v] += dist[v]
+			}
+		}
+	}
+
+	if !directed {
+		// An undirected edge traversed once in each direction carries no net flow.
+		for i := 0; i < len(arcs); i += 4 {
+			if arcs[i].cap == 0 && arcs[i+2].cap == 0 {
+				arcs[i].cap, arcs[i+1].cap = 1, 0
+				arcs[i+2].cap, arcs[i+3].cap = 1, 0
+			}
+		}
+	}
+
+	var paths [2]gogl.Path
+	var weights [2]float64
+	for k := range paths {
+		paths[k], weights[k] = walkFlow(adj, arcs, s, t)
+	}
+
+	if weights[1] < weights[0] {
+		paths[0], paths[1] = paths[1], paths[0]
+	}
+	return paths, weights[0] + weights[1], true
+}
+
+// Runs Dijkstra's algorithm over the residual network, using reduced costs relative to
+// the given potentials. Returns the reduced distance to each node (+Inf if unreachable)
+// and the arc by which each was reached.
+func residualDijkstra(adj [][]int, arcs []sarc, pot []float64, s int) ([]float64, []int) {
+	dist := make([]float64, len(adj))
+	via := make([]int, len(adj))
+	for v := range dist {
+		dist[v] = math.Inf(1)
+	}
+	dist[s] = 0
+
+	done := make([]bool, len(adj))
+	pq := &vpq{{v: s}}
+	for pq.Len() > 0 {
+		u := heap.Pop(pq).(vpqItem).v.(int)
+		if done[u] {
+			continue
+		}
+		done[u] = true
+
+		for _, i := range adj[u] {
+			a := arcs[i]
+			if a.cap == 0 || done[a.to] {
+				continue
+			}
+
+			d := dist[u] + math.Max(0, a.cost+pot[u]-pot[a.to])
+			if d < dist[a.to] {
+				dist[a.to] = d
+				via[a.to] = i
+				heap.Push(pq, vpqItem{v: a.to, dist: d})
+			}
+		}
+	}
+
+	return dist, via
+}
+
+// Extracts one unit of flow from s to t as a path, consuming it from the network.
+// Returns the path's edges and their total weight. Any cycles encountered are erased.
+func walkFlow(adj [][]int, arcs []sarc, s, t int) (gogl.Path, float64) {
+	pos := map[int]int{s: 0}
+	nodes := []int{s}
+	var used []int
+
+	for u := s; u != t; {
+		next := -1
+		for _, i := range adj[u] {
+			// Forward arcs are the even ones; a forward arc with no capacity left is
+			// carrying flow.
+			if i%2 == 0 && arcs[i].cap == 0 {
+				next = i
+				break
+			}
+		}
+
+		arcs[next].cap, arcs[next^1].cap = 1, 0
+		u = arcs[next].to
+
+		if p, seen := pos[u]; seen {
+			for _, v := range nodes[p+1:] {
+				delete(pos, v)
+			}
+			nodes, used = nodes[:p+1], used[:p]
+			continue
+		}
+
+		pos[u] = len(nodes)
+		nodes = append(nodes, u)
+		used = append(used, next)
+	}
+
+	var path gogl.Path
+	var weight float64
+	for _, i := range used {
+		path = append(path, arcs[i].edge)
+		weight += arcs[i].cost
+	}
+	return path, weight
+}
@@ -0,0 +1,150 @@
+package sp
+
+import (
+	"math"
+	"math/rand"
+
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+type SuurballeSuite struct{}
+
+var _ = Suite(&SuurballeSuite{})
+
+// Enumerates every simple path from s to t.
+func simplePaths(g gogl.WeightedGraph, s, t gogl.Vertex) (paths []gogl.Path) {
+	onPath := map[gogl.Vertex]bool{s: true}
+	var walk func(v gogl.Vertex, p gogl.Path)
+	walk = func(v gogl.Vertex, p gogl.Path) {
+		if v == t {
+			paths = append(paths, append(gogl.Path{}, p...))
+			return
+		}
+		outEdges(g, v, func(e gogl.WeightedEdge, to gogl.Vertex) (terminate bool) {
+			if !onPath[to] {
+				onPath[to] = true
+				walk(to, append(p, e))
+				onPath[to] = false
+			}
+			return
+		})
+	}
+	walk(s, nil)
+	return
+}
+
+// Identifies an edge by its endpoints, disregarding orientation in undirected graphs.
+func edgeKey(g gogl.Graph, e gogl.Edge) [2]gogl.Vertex {
+	u, v := e.Both()
+	if !g.IsDirected() && gogl.VertexLess(v, u) {
+		u, v = v, u
+	}
+	return [2]gogl.Vertex{u, v}
+}
+
+func pathCost(p gogl.Path) (w float64) {
+	for _, e := range p {
+		w += e.(gogl.WeightedEdge).Weight()
+	}
+	return
+}
+
+// Finds the cheapest pair of edge-disjoint paths by exhaustive search.
+func bruteDisjointPair(g gogl.WeightedGraph, s, t gogl.Vertex) (float64, bool) {
+	paths := simplePaths(g, s, t)
+	best, found := math.Inf(1), false
+	for i, p := range paths {
+		used := make(map[[2]gogl.Vertex]bool)
+		for _, e := range p {
+			used[edgeKey(g, e)] = true
+		}
+	next:
+		for _, q := range paths[i+1:] {
+			for _, e := range q {
+				if used[edgeKey(g, e)] {
+					continue next
+				}
+			}
+			if w := pathCost(p) + pathCost(q); w < best {
+				best, found = w, true
+			}
+		}
+	}
+	return best, found
+}
+
+func (s *SuurballeSuite) checkPair(c *C, g gogl.WeightedGraph, src, tgt gogl.Vertex, paths [2]gogl.Path, cost float64) {
+	used := make(map[[2]gogl.Vertex]bool)
+	for _, p := range paths {
+		c.Assert(gogl.IsValidPath(g, p), Equals, true)
+		for _, e := range p {
+			c.Assert(used[edgeKey(g, e)], Equals, false)
+			used[edgeKey(g, e)] = true
+		}
+	}
+	c.Assert(pathCost(paths[0])+pathCost(paths[1]), Equals, cost)
+	c.Assert(pathCost(paths[0]) <= pathCost(paths[1]), Equals, true)
+}
+
+func (s *SuurballeSuite) TestTrap(c *C) {
+	// The shortest path s-a-b-t blocks any disjoint second path, so the optimal pair
+	// avoids it entirely: s-a-t and s-b-t.
+	g := gogl.Spec().Weighted().Using(gogl.WeightedEdgeList{
+		gogl.NewWeightedEdge("s", "a", 1),
+		gogl.NewWeightedEdge("a", "b", 1),
+		gogl.NewWeightedEdge("b", "t", 1),
+		gogl.NewWeightedEdge("s", "b", 3),
+		gogl.NewWeightedEdge("a", "t", 3),
+	}).Create(al.G).(gogl.WeightedGraph)
+
+	paths, cost, ok := Suurballe(g, "s", "t")
+	c.Assert(ok, Equals, true)
+	c.Assert(cost, Equals, 8.0)
+	s.checkPair(c, g, "s", "t", paths, cost)
+	c.Assert(paths[0], HasLen, 2)
+	c.Assert(paths[1], HasLen, 2)
+}
+
+func (s *SuurballeSuite) TestNoPair(c *C) {
+	g := gogl.Spec().Weighted().Using(roads).Create(al.G).(gogl.WeightedGraph)
+
+	// Only one edge reaches x.
+	_, _, ok := Suurballe(g, "a", "x")
+	c.Assert(ok, Equals, false)
+	_, _, ok = Suurballe(g, "x", "y")
+	c.Assert(ok, Equals, false)
+	_, _, ok = Suurballe(g, "a", "a")
+	c.Assert(ok, Equals, false)
+}
+
+func (s *SuurballeSuite) TestMatchesBruteForce(c *C) {
+	for seed := int64(0); seed < 40; seed++ {
+		r := rand.New(rand.NewSource(seed))
+		el := gogl.WeightedEdgeList{}
+		arcs := gogl.WeightedArcList{}
+		for i := 0; i < 16; i++ {
+			u, v, w := r.Intn(7), r.Intn(7), float64(r.Intn(6))
+			el = append(el, gogl.NewWeightedEdge(u, v, w))
+			arcs = append(arcs, gogl.NewWeightedArc(u, v, w))
+		}
+
+		for _, g := range []gogl.WeightedGraph{
+			gogl.Spec().Weighted().Using(el).Create(al.G).(gogl.WeightedGraph),
+			gogl.Spec().Directed().Weighted().Using(arcs).Create(al.G).(gogl.WeightedGraph),
+		} {
+			if !g.HasVertex(0) || !g.HasVertex(1) {
+				continue
+			}
+
+			paths, cost, ok := Suurballe(g, 0, 1)
+			want, exists := bruteDisjointPair(g, 0, 1)
+			c.Assert(ok, Equals, exists)
+			if ok {
+				c.Assert(cost, Equals, want)
+				s.checkPair(c, g, 0, 1, paths, cost)
+			}
+		}
+	}
+}
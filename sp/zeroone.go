@@ -0,0 +1,66 @@
+package sp
+
+import (
+	"container/list"
+	"fmt"
+
+	"github.com/sdboyer/gogl"
+)
+
+// Computes the distance from the given source to every vertex reachable from it, in a
+// graph whose edge weights are all either 0 or 1. Vertices that are unreachable are
+// absent from the returned map, as is the source if it is not present in the graph.
+//
+// This is 0-1 BFS: a breadth-first traversal over a deque, where vertices reached by a
+// 0-weight edge are pushed to the front and those reached by a 1-weight edge to the back.
+// It runs in O(V+E), unlike Dijkstra's algorithm. An error is returned, before any
+// traversal, if any edge has a weight other than 0 or 1.
+//
+// If the provided graph is a Digraph, paths follow arc direction.
+func ZeroOneBFS(g gogl.WeightedGraph, source gogl.Vertex) (map[gogl.Vertex]int, error) {
+	var err error
+	g.Edges(func(e gogl.Edge) (terminate bool) {
+		if w := weighted(e).Weight(); w != 0 && w != 1 {
+			u, v := e.Both()
+			err = fmt.Errorf("Edge %v-%v has weight %v, but 0-1 BFS requires weights of 0 or 1.", u, v, w)
+		}
+		return err != nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	dist := make(map[gogl.Vertex]int)
+	if !g.HasVertex(source) {
+		return dist, nil
+	}
+
+	done := make(map[gogl.Vertex]bool)
+	deque := list.New()
+	deque.PushBack(source)
+	dist[source] = 0
+
+	for deque.Len() > 0 {
+		v := deque.Remove(deque.Front())
+		if done[v] {
+			continue
+		}
+		done[v] = true
+
+		outEdges(g, v, func(e gogl.WeightedEdge, to gogl.Vertex) (terminate bool) {
+			w := int(e.Weight())
+			d := dist[v] + w
+			if known, exists := dist[to]; !exists || d < known {
+				dist[to] = d
+				if w == 0 {
+					deque.PushFront(to)
+				} else {
+					deque.PushBack(to)
+				}
+			}
+			return
+		})
+	}
+
+	return dist, nil
+}
@@ -0,0 +1,61 @@
+package sp
+
+import (
+	"math/rand"
+
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+type ZeroOneBFSSuite struct{}
+
+var _ = Suite(&ZeroOneBFSSuite{})
+
+func (s *ZeroOneBFSSuite) TestMatchesDijkstra(c *C) {
+	for seed := int64(0); seed < 20; seed++ {
+		r := rand.New(rand.NewSource(seed))
+		el := gogl.WeightedEdgeList{}
+		for i := 0; i < 120; i++ {
+			el = append(el, gogl.NewWeightedEdge(r.Intn(40), r.Intn(40), float64(r.Intn(2))))
+		}
+
+		arcs := gogl.WeightedArcList{}
+		for _, e := range el {
+			u, v := e.Both()
+			arcs = append(arcs, gogl.NewWeightedArc(u, v, e.Weight()))
+		}
+
+		for _, g := range []gogl.WeightedGraph{
+			gogl.Spec().Weighted().Using(el).Create(al.G).(gogl.WeightedGraph),
+			gogl.Spec().Directed().Weighted().Using(arcs).Create(al.G).(gogl.WeightedGraph),
+		} {
+			dist, err := ZeroOneBFS(g, 0)
+			c.Assert(err, IsNil)
+
+			want, _ := dijkstra(g, 0)
+			c.Assert(dist, HasLen, len(want))
+			for v, d := range want {
+				c.Assert(float64(dist[v]), Equals, d)
+			}
+		}
+	}
+}
+
+func (s *ZeroOneBFSSuite) TestRejectsOtherWeights(c *C) {
+	g := gogl.Spec().Weighted().Using(roads).Create(al.G).(gogl.WeightedGraph)
+
+	dist, err := ZeroOneBFS(g, "a")
+	c.Assert(dist, IsNil)
+	c.Assert(err, ErrorMatches, "Edge .* has weight .*, but 0-1 BFS requires weights of 0 or 1.")
+}
+
+func (s *ZeroOneBFSSuite) TestMissingSource(c *C) {
+	g := gogl.Spec().Weighted().Using(gogl.WeightedEdgeList{
+		gogl.NewWeightedEdge(1, 2, 0),
+	}).Create(al.G).(gogl.WeightedGraph)
+
+	dist, err := ZeroOneBFS(g, 9)
+	c.Assert(err, IsNil)
+	c.Assert(dist, HasLen, 0)
+}
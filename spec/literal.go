@@ -309,6 +309,10 @@ func (g GraphLiteralFixture) HasArc(a Arc) bool {
 	}
 }
 
+func (g GraphLiteralFixture) IsDirected() bool {
+	return true
+}
+
 func (g GraphLiteralFixture) Density() float64 {
 	return 2 / 12 // 2 edges of maximum 12 in a 4-vertex digraph
 }
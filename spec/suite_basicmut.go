@@ -36,6 +36,14 @@ func (s *VertexSetMutatorSuite) TestGracefulEmptyVariadics(c *C) {
 	c.Assert(Order(g), Equals, 0)
 }
 
+func (s *VertexSetMutatorSuite) TestEnsureNilVertex(c *C) {
+	g := s.Factory(NullGraph)
+	m := g.(VertexSetMutator)
+
+	m.EnsureVertex(nil)
+	c.Assert(Order(g), Equals, 0)
+}
+
 func (s *VertexSetMutatorSuite) TestMultiEnsureVertex(c *C) {
 	g := s.Factory(NullGraph)
 	m := g.(VertexSetMutator)
@@ -118,6 +126,44 @@ func (s *EdgeSetMutatorSuite) TestMultiAddRemoveHasEdge(c *C) {
 	c.Assert(g.HasEdge(NewEdge(2, 3)), Equals, false)
 }
 
+func (s *EdgeSetMutatorSuite) TestNilEndpointsRejected(c *C) {
+	g := s.Factory(NullGraph)
+	m := g.(EdgeSetMutator)
+
+	m.AddEdges(NewEdge(nil, 1), NewEdge(1, nil), NewEdge(nil, nil))
+	c.Assert(Order(g), Equals, 0)
+	c.Assert(Size(g), Equals, 0)
+
+	m.AddEdges(NewEdge(1, 2), NewEdge(2, nil))
+	c.Assert(Order(g), Equals, 2)
+	c.Assert(Size(g), Equals, 1)
+}
+
+func (s *EdgeSetMutatorSuite) TestSelfLoop(c *C) {
+	g := s.Factory(NullGraph)
+	m := g.(EdgeSetMutator)
+
+	m.AddEdges(NewEdge(1, 1), NewEdge(1, 2))
+	c.Assert(Order(g), Equals, 2)
+	c.Assert(Size(g), Equals, 2)
+
+	// A loop contributes two to its vertex's degree.
+	deg, _ := g.DegreeOf(1)
+	c.Assert(deg, Equals, 3)
+
+	if v, ok := g.(VertexSetMutator); ok {
+		v.RemoveVertex(1)
+		c.Assert(Order(g), Equals, 1)
+		c.Assert(Size(g), Equals, 0)
+	}
+
+	m.AddEdges(NewEdge(1, 1))
+	m.RemoveEdges(NewEdge(1, 1))
+	c.Assert(Size(g), Equals, 0)
+	deg, _ = g.DegreeOf(1)
+	c.Assert(deg, Equals, 0)
+}
+
 // Checks to ensure that removal works for both in-edges and out-edges.
 func (s *EdgeSetMutatorSuite) TestVertexRemovalAlsoRemovesConnectedEdges(c *C) {
 	g := s.Factory(NullGraph)
@@ -185,6 +231,19 @@ func (s *ArcSetMutatorSuite) TestMultiAddRemoveHasArc(c *C) {
 	c.Assert(g.HasArc(NewArc(2, 3)), Equals, false)
 }
 
+func (s *ArcSetMutatorSuite) TestNilEndpointsRejected(c *C) {
+	g := s.Factory(NullGraph)
+	m := g.(ArcSetMutator)
+
+	m.AddArcs(NewArc(nil, 1), NewArc(1, nil), NewArc(nil, nil))
+	c.Assert(Order(g), Equals, 0)
+	c.Assert(Size(g), Equals, 0)
+
+	m.AddArcs(NewArc(1, 2), NewArc(2, nil))
+	c.Assert(Order(g), Equals, 2)
+	c.Assert(Size(g), Equals, 1)
+}
+
 // Checks to ensure that removal works for both in-edges and out-edges.
 func (s *ArcSetMutatorSuite) TestVertexRemovalAlsoRemovesConnectedArcs(c *C) {
 	g := s.Factory(NullGraph)
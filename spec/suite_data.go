@@ -123,6 +123,31 @@ func (s *DataEdgeSetMutatorSuite) TestMultiAddRemoveEdge(c *C) {
 	c.Assert(g.HasDataEdge(NewDataEdge(2, 3, "bar")), Equals, false)
 }
 
+func (s *DataEdgeSetMutatorSuite) TestSelfLoop(c *C) {
+	g := s.Factory(NullGraph)
+	m := g.(DataEdgeSetMutator)
+
+	m.AddEdges(NewDataEdge(1, 1, "foo"), NewDataEdge(1, 2, "bar"))
+	c.Assert(Order(g), Equals, 2)
+	c.Assert(Size(g), Equals, 2)
+
+	// A loop contributes two to its vertex's degree.
+	deg, _ := g.DegreeOf(1)
+	c.Assert(deg, Equals, 3)
+
+	if v, ok := g.(VertexSetMutator); ok {
+		v.RemoveVertex(1)
+		c.Assert(Order(g), Equals, 1)
+		c.Assert(Size(g), Equals, 0)
+	}
+
+	m.AddEdges(NewDataEdge(1, 1, "foo"))
+	m.RemoveEdges(NewDataEdge(1, 1, "foo"))
+	c.Assert(Size(g), Equals, 0)
+	deg, _ = g.DegreeOf(1)
+	c.Assert(deg, Equals, 0)
+}
+
 /* DataArcSetMutatorSuite - tests for mutable data graphs */
 
 type DataArcSetMutatorSuite struct {
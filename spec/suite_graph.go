@@ -32,6 +32,22 @@ func (s *GraphSuite) TestHasEdge(c *C) {
 	c.Assert(g.HasEdge(NewEdge("qux", "quark")), Equals, false)
 }
 
+func (s *GraphSuite) TestHasEdges(c *C) {
+	g := s.Factory(GraphFixtures["2e3v"])
+
+	has := HasEdges(g, NewEdge("foo", "bar"), NewEdge("qux", "quark"), NewEdge("baz", "bar"), NewEdge("foo", "baz"))
+	c.Assert(has, DeepEquals, []bool{true, false, true, false})
+	c.Assert(HasEdges(g), HasLen, 0)
+}
+
+func (s *GraphSuite) TestIsDirected(c *C) {
+	g := s.Factory(GraphFixtures["2e3v"])
+	c.Assert(g.IsDirected(), Equals, s.Directed)
+
+	_, isDigraph := g.(Digraph)
+	c.Assert(g.IsDirected(), Equals, isDigraph)
+}
+
 func (s *GraphSuite) TestVertices(c *C) {
 	g := s.Factory(GraphFixtures["2e3v"])
 
@@ -114,6 +130,28 @@ func (s *GraphSuite) TestAdjacentToTermination(c *C) {
 	c.Assert(hit, Equals, 1)
 }
 
+// Stopping after each possible number of neighbors must be honored wherever it falls; in
+// digraphs, bar's neighbors span both its out-arcs and its in-arcs.
+func (s *GraphSuite) TestAdjacentToTerminationMidway(c *C) {
+	g := s.Factory(GraphFixtures["arctest"])
+
+	for limit := 1; limit <= 3; limit++ {
+		var hit int
+		g.AdjacentTo("bar", func(adjacent Vertex) bool {
+			hit++
+			return hit == limit
+		})
+		c.Assert(hit, Equals, limit)
+	}
+
+	var hit int
+	g.AdjacentTo("bar", func(adjacent Vertex) bool {
+		hit++
+		return false
+	})
+	c.Assert(hit, Equals, 3)
+}
+
 func (s *GraphSuite) TestIncidentTo(c *C) {
 	g := s.Factory(GraphFixtures["2e3v"])
 
@@ -123,6 +123,31 @@ func (s *LabeledEdgeSetMutatorSuite) TestMultiAddRemoveEdge(c *C) {
 	c.Assert(g.HasLabeledEdge(NewLabeledEdge(2, 3, "bar")), Equals, false)
 }
 
+func (s *LabeledEdgeSetMutatorSuite) TestSelfLoop(c *C) {
+	g := s.Factory(NullGraph)
+	m := g.(LabeledEdgeSetMutator)
+
+	m.AddEdges(NewLabeledEdge(1, 1, "foo"), NewLabeledEdge(1, 2, "bar"))
+	c.Assert(Order(g), Equals, 2)
+	c.Assert(Size(g), Equals, 2)
+
+	// A loop contributes two to its vertex's degree.
+	deg, _ := g.DegreeOf(1)
+	c.Assert(deg, Equals, 3)
+
+	if v, ok := g.(VertexSetMutator); ok {
+		v.RemoveVertex(1)
+		c.Assert(Order(g), Equals, 1)
+		c.Assert(Size(g), Equals, 0)
+	}
+
+	m.AddEdges(NewLabeledEdge(1, 1, "foo"))
+	m.RemoveEdges(NewLabeledEdge(1, 1, "foo"))
+	c.Assert(Size(g), Equals, 0)
+	deg, _ = g.DegreeOf(1)
+	c.Assert(deg, Equals, 0)
+}
+
 /* LabeledArcSetMutatorSuite - tests for mutable labeled graphs */
 
 type LabeledArcSetMutatorSuite struct {
@@ -123,6 +123,31 @@ func (s *WeightedEdgeSetMutatorSuite) TestMultiAddRemoveEdge(c *C) {
 	c.Assert(g.HasWeightedEdge(NewWeightedEdge(2, 3, 5.821)), Equals, false)
 }
 
+func (s *WeightedEdgeSetMutatorSuite) TestSelfLoop(c *C) {
+	g := s.Factory(NullGraph)
+	m := g.(WeightedEdgeSetMutator)
+
+	m.AddEdges(NewWeightedEdge(1, 1, 5.23), NewWeightedEdge(1, 2, 5.821))
+	c.Assert(Order(g), Equals, 2)
+	c.Assert(Size(g), Equals, 2)
+
+	// A loop contributes two to its vertex's degree.
+	deg, _ := g.DegreeOf(1)
+	c.Assert(deg, Equals, 3)
+
+	if v, ok := g.(VertexSetMutator); ok {
+		v.RemoveVertex(1)
+		c.Assert(Order(g), Equals, 1)
+		c.Assert(Size(g), Equals, 0)
+	}
+
+	m.AddEdges(NewWeightedEdge(1, 1, 5.23))
+	m.RemoveEdges(NewWeightedEdge(1, 1, 5.23))
+	c.Assert(Size(g), Equals, 0)
+	deg, _ = g.DegreeOf(1)
+	c.Assert(deg, Equals, 0)
+}
+
 /* WeightedArcSetMutatorSuite - tests for mutable weighted graphs */
 
 type WeightedArcSetMutatorSuite struct {
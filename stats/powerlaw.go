@@ -0,0 +1,104 @@
+// Contains algos for summarizing graph-wide statistical properties.
+package stats
+
+import (
+	"math"
+	"sort"
+
+	"github.com/sdboyer/gogl"
+)
+
+// Tails with fewer observations than this are not considered when fitting a power law.
+const minTail = 50
+
+// Fits the degree distribution of the given graph to a power law, P(k) ~ k^-alpha for
+// k >= xmin, following the method of Clauset, Shalizi and Newman (2009).
+//
+// For each candidate cutoff xmin, alpha is estimated by maximum likelihood, using the
+// standard approximation for discrete data: alpha = 1 + n / Σ ln(k / (xmin - 1/2)). The
+// cutoff chosen is the one minimizing the Kolmogorov-Smirnov distance between the
+// observed tail and the fitted model. Only cutoffs leaving at least 50 vertices in the
+// tail are considered, and vertices of degree 0 are ignored.
+//
+// CSN assess plausibility with a bootstrapped p-value, which is expensive and random.
+// Instead, ok reports a deterministic, conservative check: the tail must span at least
+// a decade of degrees (max degree >= 10 * xmin), and its KS distance must fall below
+// the 5% critical value, 1.36/sqrt(n). If no cutoff leaves enough of a tail, alpha and
+// xmin are zero and ok is false.
+func FitPowerLaw(g gogl.Graph) (alpha float64, xmin int, ok bool) {
+	var degrees []int
+	g.Vertices(func(v gogl.Vertex) (terminate bool) {
+		if d, _ := g.DegreeOf(v); d > 0 {
+			degrees = append(degrees, d)
+		}
+		return
+	})
+	sort.Ints(degrees)
+
+	best := math.Inf(1)
+	for i := 0; i < len(degrees) && len(degrees)-i >= minTail; i++ {
+		if i > 0 && degrees[i] == degrees[i-1] {
+			continue
+		}
+
+		tail := degrees[i:]
+		a := mleAlpha(tail, degrees[i])
+		if d := ksDistance(tail, degrees[i], a); d < best {
+			best, alpha, xmin = d, a, degrees[i]
+		}
+	}
+
+	if xmin == 0 {
+		return 0, 0, false
+	}
+
+	n := 0
+	for _, d := range degrees {
+		if d >= xmin {
+			n++
+		}
+	}
+	ok = degrees[len(degrees)-1] >= 10*xmin && best < 1.36/math.Sqrt(float64(n))
+	return
+}
+
+// Estimates the power law exponent for the given tail, which must be sorted and contain
+// only values >= xmin.
+func mleAlpha(tail []int, xmin int) float64 {
+	var sum float64
+	shift := float64(xmin) - 0.5
+	for _, k := range tail {
+		sum += math.Log(float64(k) / shift)
+	}
+	if sum == 0 {
+		return math.Inf(1)
+	}
+	return 1 + float64(len(tail))/sum
+}
+
+// Computes the Kolmogorov-Smirnov distance between the empirical distribution of the
+// given sorted tail and a power law with the given exponent and cutoff, using the same
+// continuity correction as mleAlpha for the model's CDF.
+func ksDistance(tail []int, xmin int, alpha float64) float64 {
+	n := float64(len(tail))
+	shift := float64(xmin) - 0.5
+
+	var max float64
+	for i := 0; i < len(tail); {
+		k := tail[i]
+		j := i
+		for j < len(tail) && tail[j] == k {
+			j++
+		}
+
+		// Empirical and model probabilities of a degree <= k.
+		empirical := float64(j) / n
+		model := 1 - math.Pow((float64(k)+0.5)/shift, 1-alpha)
+		if d := math.Abs(empirical - model); d > max {
+			max = d
+		}
+		i = j
+	}
+
+	return max
+}
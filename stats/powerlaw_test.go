@@ -0,0 +1,43 @@
+package stats
+
+import (
+	stdrand "math/rand"
+	"testing"
+
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+	"github.com/sdboyer/gogl/rand"
+)
+
+// Hook gocheck into the go test runner
+func Test(t *testing.T) { TestingT(t) }
+
+type PowerLawSuite struct{}
+
+var _ = Suite(&PowerLawSuite{})
+
+func (s *PowerLawSuite) TestScaleFree(c *C) {
+	g := gogl.Spec().Using(rand.BarabasiAlbert(5000, 3, stdrand.NewSource(7))).Create(al.G)
+
+	alpha, xmin, ok := FitPowerLaw(g)
+	c.Assert(ok, Equals, true)
+	c.Assert(alpha > 2.5 && alpha < 3.5, Equals, true)
+	c.Assert(xmin >= 3, Equals, true)
+}
+
+func (s *PowerLawSuite) TestRandom(c *C) {
+	g := gogl.Spec().Using(rand.BernoulliDistribution(2000, 0.005, false, true, stdrand.NewSource(7))).Create(al.G)
+
+	_, _, ok := FitPowerLaw(g)
+	c.Assert(ok, Equals, false)
+}
+
+func (s *PowerLawSuite) TestTooSmall(c *C) {
+	g := gogl.Spec().Using(gogl.EdgeList{gogl.NewEdge(1, 2), gogl.NewEdge(2, 3)}).Create(al.G)
+
+	alpha, xmin, ok := FitPowerLaw(g)
+	c.Assert(ok, Equals, false)
+	c.Assert(alpha, Equals, 0.0)
+	c.Assert(xmin, Equals, 0)
+}
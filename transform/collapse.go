@@ -0,0 +1,76 @@
+// Contains algos that derive new graphs from the structure of existing ones.
+package transform
+
+import (
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+// Collapses all parallel edges in the provided graph source into single weighted edges,
+// producing a simple weighted graph suitable for use with algorithms that do not
+// understand multigraphs.
+//
+// The weight of each resulting edge is the sum of the weights of the parallel edges it
+// replaces; edges that do not implement WeightedEdge count for a weight of 1. Thus, for
+// an unweighted source, each edge's weight is the number of parallel edges it replaces,
+// and an already-simple unweighted graph comes back with all weights set to 1.
+//
+// Loops are discarded, as they are not permitted in simple graphs, but all vertices are
+// retained. If the source is a DigraphSource, the result is a weighted digraph, and only
+// arcs with the same direction are considered parallel.
+func CollapseParallelEdges(g gogl.GraphSource) gogl.WeightedGraph {
+	type pair struct {
+		u, v gogl.Vertex
+	}
+
+	weights := make(map[pair]float64)
+	collect := func(e gogl.Edge, directed bool) {
+		u, v := e.Both()
+		if u == v {
+			return
+		}
+
+		w := 1.0
+		if we, ok := e.(gogl.WeightedEdge); ok {
+			w = we.Weight()
+		}
+
+		if _, exists := weights[pair{v, u}]; exists && !directed {
+			weights[pair{v, u}] += w
+		} else {
+			weights[pair{u, v}] += w
+		}
+	}
+
+	if dg, ok := g.(gogl.DigraphSource); ok {
+		dg.Arcs(func(a gogl.Arc) (terminate bool) {
+			collect(a, true)
+			return
+		})
+
+		h := gogl.Spec().Directed().Weighted().Mutable().Create(al.G).(gogl.MutableWeightedDigraph)
+		g.Vertices(func(v gogl.Vertex) (terminate bool) {
+			h.EnsureVertex(v)
+			return
+		})
+		for p, w := range weights {
+			h.AddArcs(gogl.NewWeightedArc(p.u, p.v, w))
+		}
+		return h
+	}
+
+	g.Edges(func(e gogl.Edge) (terminate bool) {
+		collect(e, false)
+		return
+	})
+
+	h := gogl.Spec().Weighted().Mutable().Create(al.G).(gogl.MutableWeightedGraph)
+	g.Vertices(func(v gogl.Vertex) (terminate bool) {
+		h.EnsureVertex(v)
+		return
+	})
+	for p, w := range weights {
+		h.AddEdges(gogl.NewWeightedEdge(p.u, p.v, w))
+	}
+	return h
+}
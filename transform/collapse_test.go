@@ -0,0 +1,59 @@
+package transform
+
+import (
+	"testing"
+
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+// Hook gocheck into the go test runner
+func Test(t *testing.T) { TestingT(t) }
+
+type CollapseParallelEdgesSuite struct{}
+
+var _ = Suite(&CollapseParallelEdgesSuite{})
+
+func (s *CollapseParallelEdgesSuite) TestMultigraph(c *C) {
+	g := CollapseParallelEdges(gogl.EdgeList{
+		gogl.NewEdge("foo", "bar"),
+		gogl.NewEdge("bar", "foo"),
+		gogl.NewEdge("foo", "bar"),
+		gogl.NewEdge("bar", "baz"),
+		gogl.NewEdge("qux", "qux"),
+	})
+
+	c.Assert(gogl.Order(g), Equals, 4)
+	c.Assert(gogl.Size(g), Equals, 2)
+	c.Assert(g.HasWeightedEdge(gogl.NewWeightedEdge("foo", "bar", 3)), Equals, true)
+	c.Assert(g.HasWeightedEdge(gogl.NewWeightedEdge("bar", "baz", 1)), Equals, true)
+	c.Assert(g.HasVertex("qux"), Equals, true)
+}
+
+func (s *CollapseParallelEdgesSuite) TestSummedWeights(c *C) {
+	g := CollapseParallelEdges(gogl.WeightedArcList{
+		gogl.NewWeightedArc("foo", "bar", 1.5),
+		gogl.NewWeightedArc("foo", "bar", 2),
+		gogl.NewWeightedArc("bar", "foo", 4),
+	})
+
+	c.Assert(g, Implements, new(gogl.WeightedDigraph))
+	dg := g.(gogl.WeightedDigraph)
+	c.Assert(dg.HasWeightedArc(gogl.NewWeightedArc("foo", "bar", 3.5)), Equals, true)
+	c.Assert(dg.HasWeightedArc(gogl.NewWeightedArc("bar", "foo", 4)), Equals, true)
+}
+
+func (s *CollapseParallelEdgesSuite) TestSimpleGraph(c *C) {
+	simple := gogl.Spec().Using(gogl.EdgeList{
+		gogl.NewEdge("foo", "bar"),
+		gogl.NewEdge("bar", "baz"),
+	}).Create(al.G)
+
+	g := CollapseParallelEdges(simple)
+	c.Assert(gogl.Size(g), Equals, 2)
+	g.Edges(func(e gogl.Edge) (terminate bool) {
+		c.Assert(e.(gogl.WeightedEdge).Weight(), Equals, 1.0)
+		return
+	})
+}
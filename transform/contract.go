@@ -0,0 +1,60 @@
+package transform
+
+import (
+	"github.com/sdboyer/gogl"
+)
+
+// Contracts the given edge in place, merging its two endpoints into one vertex. The
+// merged vertex retains the identity of the edge's first endpoint (per Both()).
+//
+// See ContractEdgeWith for details.
+func ContractEdge(g gogl.MutableGraph, e gogl.Edge) bool {
+	return ContractEdgeWith(g, e, func(keep, drop gogl.Vertex) gogl.Vertex {
+		return keep
+	})
+}
+
+// Contracts the given edge in place, merging its two endpoints into a single vertex
+// whose identity is determined by the provided merge func. merge is passed the edge's
+// endpoints, in the order given by Both(), and returns the merged vertex; it may return
+// either of them, or an entirely new vertex (e.g., a composite for provenance tracking).
+//
+// Both endpoints are removed from the graph, then the merged vertex is added and every
+// edge that was incident to either endpoint is rewired to it. The contracted edge itself
+// disappears, as do any edges that become parallel. Loops at either endpoint become
+// loops at the merged vertex.
+//
+// Returns false, leaving the graph untouched and without calling merge, if the edge is
+// not present in the graph.
+func ContractEdgeWith(g gogl.MutableGraph, e gogl.Edge, merge func(keep, drop gogl.Vertex) gogl.Vertex) bool {
+	if !g.HasEdge(e) {
+		return false
+	}
+
+	u, v := e.Both()
+	var neighbors []gogl.Vertex
+	var loop bool
+	for _, end := range []gogl.Vertex{u, v} {
+		g.AdjacentTo(end, func(adj gogl.Vertex) (terminate bool) {
+			if adj == end {
+				loop = true
+			} else if adj != u && adj != v {
+				neighbors = append(neighbors, adj)
+			}
+			return
+		})
+	}
+
+	m := merge(u, v)
+	g.RemoveVertex(u, v)
+	g.EnsureVertex(m)
+
+	for _, n := range neighbors {
+		g.AddEdges(gogl.NewEdge(m, n))
+	}
+	if loop {
+		g.AddEdges(gogl.NewEdge(m, m))
+	}
+
+	return true
+}
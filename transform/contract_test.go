@@ -0,0 +1,78 @@
+package transform
+
+import (
+	"fmt"
+
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+type ContractSuite struct{}
+
+var _ = Suite(&ContractSuite{})
+
+func contractFixture() gogl.MutableGraph {
+	return gogl.Spec().Using(gogl.EdgeList{
+		gogl.NewEdge("a", "b"),
+		gogl.NewEdge("a", "c"),
+		gogl.NewEdge("b", "c"),
+		gogl.NewEdge("b", "d"),
+		gogl.NewEdge("e", "f"),
+	}).Create(al.G).(gogl.MutableGraph)
+}
+
+func (s *ContractSuite) TestContractEdgeWith(c *C) {
+	g := contractFixture()
+
+	var called bool
+	ok := ContractEdgeWith(g, gogl.NewEdge("a", "b"), func(keep, drop gogl.Vertex) gogl.Vertex {
+		called = true
+		return fmt.Sprint(keep, "+", drop)
+	})
+
+	c.Assert(ok, Equals, true)
+	c.Assert(called, Equals, true)
+	c.Assert(g.HasVertex("a"), Equals, false)
+	c.Assert(g.HasVertex("b"), Equals, false)
+	c.Assert(g.HasVertex("a+b"), Equals, true)
+
+	// a-c and b-c become one edge; b-d rewires
+	c.Assert(g.HasEdge(gogl.NewEdge("a+b", "c")), Equals, true)
+	c.Assert(g.HasEdge(gogl.NewEdge("a+b", "d")), Equals, true)
+	c.Assert(gogl.Order(g), Equals, 5)
+	c.Assert(gogl.Size(g), Equals, 3)
+}
+
+func (s *ContractSuite) TestContractEdge(c *C) {
+	g := contractFixture()
+
+	c.Assert(ContractEdge(g, gogl.NewEdge("b", "d")), Equals, true)
+	c.Assert(g.HasVertex("d"), Equals, false)
+	c.Assert(g.HasEdge(gogl.NewEdge("b", "a")), Equals, true)
+	c.Assert(g.HasEdge(gogl.NewEdge("b", "c")), Equals, true)
+	c.Assert(gogl.Size(g), Equals, 4)
+}
+
+func (s *ContractSuite) TestContractMissingEdge(c *C) {
+	g := contractFixture()
+
+	ok := ContractEdgeWith(g, gogl.NewEdge("a", "f"), func(keep, drop gogl.Vertex) gogl.Vertex {
+		c.Error("merge should not be called for a missing edge")
+		return keep
+	})
+	c.Assert(ok, Equals, false)
+	c.Assert(gogl.Order(g), Equals, 6)
+	c.Assert(gogl.Size(g), Equals, 5)
+}
+
+func (s *ContractSuite) TestContractPreservesLoops(c *C) {
+	g := gogl.Spec().Using(gogl.EdgeList{
+		gogl.NewEdge(1, 2),
+		gogl.NewEdge(2, 2),
+	}).Create(al.G).(gogl.MutableGraph)
+
+	c.Assert(ContractEdge(g, gogl.NewEdge(1, 2)), Equals, true)
+	c.Assert(gogl.Order(g), Equals, 1)
+	c.Assert(g.HasEdge(gogl.NewEdge(1, 1)), Equals, true)
+}
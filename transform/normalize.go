@@ -0,0 +1,97 @@
+package transform
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+// Rescales the weights of all edges in the given graph, in place, according to the mode:
+//
+//	"minmax"  maps weights linearly onto [0,1], the least weight becoming 0 and the
+//	          greatest 1
+//	"sum"     divides each weight by the total of all weights, so they sum to 1
+//	"max"     divides each weight by the greatest weight, so the greatest becomes 1
+//
+// These prepare weights for algorithms sensitive to their scale. "sum" and "max" are
+// intended for non-negative weights.
+//
+// An error is returned, and the graph left untouched, if the mode is not recognized, or
+// if the rescaling is undefined for the graph's weights: a total or maximum of 0 for
+// "sum" and "max", or all weights being equal for "minmax". A graph with no edges is
+// left untouched without error.
+func NormalizeWeights(g gogl.MutableWeightedGraph, mode string) error {
+	var edges gogl.WeightedEdgeList
+	g.Edges(func(e gogl.Edge) (terminate bool) {
+		edges = append(edges, e.(gogl.WeightedEdge))
+		return
+	})
+
+	var f func(w float64) float64
+	min, max, sum := math.Inf(1), math.Inf(-1), 0.0
+	for _, e := range edges {
+		w := e.Weight()
+		min, max, sum = math.Min(min, w), math.Max(max, w), sum+w
+	}
+
+	switch mode {
+	case "minmax":
+		if len(edges) > 0 && max == min {
+			return fmt.Errorf("Cannot minmax-normalize weights that are all equal (%v).", min)
+		}
+		f = func(w float64) float64 { return (w - min) / (max - min) }
+	case "sum":
+		if len(edges) > 0 && sum == 0 {
+			return errors.New("Cannot sum-normalize weights that total 0.")
+		}
+		f = func(w float64) float64 { return w / sum }
+	case "max":
+		if len(edges) > 0 && max == 0 {
+			return errors.New("Cannot max-normalize weights whose maximum is 0.")
+		}
+		f = func(w float64) float64 { return w / max }
+	default:
+		return fmt.Errorf("Unknown normalization mode %q; expected \"minmax\", \"sum\", or \"max\".", mode)
+	}
+
+	// Adding an edge that is already present is a no-op, so each must be removed first.
+	for _, e := range edges {
+		u, v := e.Both()
+		g.RemoveEdges(e)
+		g.AddEdges(gogl.NewWeightedEdge(u, v, f(e.Weight())))
+	}
+
+	return nil
+}
+
+// Returns a copy of the given graph with the weight of every edge negated. Vertices,
+// including isolated ones, and directedness are preserved; the original is untouched.
+//
+// Negation turns longest paths into shortest ones: in a DAG, the shortest path in the
+// negated graph, as found by an algorithm that tolerates negative weights such as
+// Bellman-Ford, is the longest path in the original. Any positive-weight cycle in the
+// original becomes a negative cycle in the copy.
+func NegateWeights(g gogl.WeightedGraph) gogl.WeightedGraph {
+	vertices := gogl.CollectVertices(g)
+	spec := gogl.Spec().Weighted()
+
+	if dg, ok := g.(gogl.Digraph); ok {
+		arcs := gogl.WeightedArcList{}
+		dg.Arcs(func(a gogl.Arc) (terminate bool) {
+			arcs = append(arcs, gogl.NewWeightedArc(a.Source(), a.Target(), -a.(gogl.WeightedArc).Weight()))
+			return
+		})
+		return spec.Directed().Using(gogl.WithIsolates(arcs, vertices...)).Create(al.G).(gogl.WeightedGraph)
+	}
+
+	edges := gogl.WeightedEdgeList{}
+	g.Edges(func(e gogl.Edge) (terminate bool) {
+		u, v := e.Both()
+		edges = append(edges, gogl.NewWeightedEdge(u, v, -e.(gogl.WeightedEdge).Weight()))
+		return
+	})
+	return spec.Using(gogl.WithIsolates(edges, vertices...)).Create(al.G).(gogl.WeightedGraph)
+}
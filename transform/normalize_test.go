@@ -0,0 +1,145 @@
+package transform
+
+import (
+	"math"
+
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+	"github.com/sdboyer/gogl/sp"
+)
+
+type NormalizeWeightsSuite struct{}
+
+var _ = Suite(&NormalizeWeightsSuite{})
+
+func normFixture() gogl.MutableWeightedGraph {
+	return gogl.Spec().Weighted().Using(gogl.WeightedEdgeList{
+		gogl.NewWeightedEdge("a", "b", 2),
+		gogl.NewWeightedEdge("b", "c", 6),
+		gogl.NewWeightedEdge("c", "d", 4),
+		gogl.NewWeightedEdge("d", "a", 8),
+	}).Create(al.G).(gogl.MutableWeightedGraph)
+}
+
+func weights(g gogl.WeightedGraph) map[[2]gogl.Vertex]float64 {
+	ws := make(map[[2]gogl.Vertex]float64)
+	g.Edges(func(e gogl.Edge) (terminate bool) {
+		u, v := e.Both()
+		if gogl.VertexLess(v, u) {
+			u, v = v, u
+		}
+		ws[[2]gogl.Vertex{u, v}] = e.(gogl.WeightedEdge).Weight()
+		return
+	})
+	return ws
+}
+
+func (s *NormalizeWeightsSuite) TestSum(c *C) {
+	g := normFixture()
+	c.Assert(NormalizeWeights(g, "sum"), IsNil)
+
+	var total float64
+	for _, w := range weights(g) {
+		total += w
+	}
+	c.Assert(math.Abs(total-1) < 1e-12, Equals, true)
+	c.Assert(weights(g)[[2]gogl.Vertex{"a", "b"}], Equals, 0.1)
+	c.Assert(gogl.Size(g), Equals, 4)
+}
+
+func (s *NormalizeWeightsSuite) TestMax(c *C) {
+	g := normFixture()
+	c.Assert(NormalizeWeights(g, "max"), IsNil)
+
+	var max float64
+	for _, w := range weights(g) {
+		max = math.Max(max, w)
+	}
+	c.Assert(max, Equals, 1.0)
+	c.Assert(weights(g)[[2]gogl.Vertex{"c", "d"}], Equals, 0.5)
+}
+
+func (s *NormalizeWeightsSuite) TestMinMax(c *C) {
+	g := normFixture()
+	c.Assert(NormalizeWeights(g, "minmax"), IsNil)
+	c.Assert(weights(g), DeepEquals, map[[2]gogl.Vertex]float64{
+		{"a", "b"}: 0,
+		{"b", "c"}: 4.0 / 6.0,
+		{"c", "d"}: 2.0 / 6.0,
+		{"a", "d"}: 1,
+	})
+}
+
+func (s *NormalizeWeightsSuite) TestErrors(c *C) {
+	g := normFixture()
+	c.Assert(NormalizeWeights(g, "median"), ErrorMatches, "Unknown normalization mode.*")
+
+	flat := gogl.Spec().Weighted().Using(gogl.WeightedEdgeList{
+		gogl.NewWeightedEdge(1, 2, 0),
+		gogl.NewWeightedEdge(2, 3, 0),
+	}).Create(al.G).(gogl.MutableWeightedGraph)
+	for _, mode := range []string{"minmax", "sum", "max"} {
+		c.Assert(NormalizeWeights(flat, mode), NotNil)
+	}
+	c.Assert(weights(flat)[[2]gogl.Vertex{1, 2}], Equals, 0.0)
+
+	empty := gogl.Spec().Weighted().Create(al.G).(gogl.MutableWeightedGraph)
+	c.Assert(NormalizeWeights(empty, "sum"), IsNil)
+}
+
+type NegateWeightsSuite struct{}
+
+var _ = Suite(&NegateWeightsSuite{})
+
+func (s *NegateWeightsSuite) TestCopy(c *C) {
+	g := gogl.Spec().Weighted().Using(gogl.WithIsolates(gogl.WeightedEdgeList{
+		gogl.NewWeightedEdge("a", "b", 2),
+		gogl.NewWeightedEdge("b", "c", -3),
+	}, "z")).Create(al.G).(gogl.WeightedGraph)
+
+	neg := NegateWeights(g)
+	c.Assert(weights(neg), DeepEquals, map[[2]gogl.Vertex]float64{{"a", "b"}: -2, {"b", "c"}: 3})
+	c.Assert(neg.HasVertex("z"), Equals, true)
+	c.Assert(weights(g), DeepEquals, map[[2]gogl.Vertex]float64{{"a", "b"}: 2, {"b", "c"}: -3})
+	_, directed := neg.(gogl.Digraph)
+	c.Assert(directed, Equals, false)
+}
+
+func (s *NegateWeightsSuite) TestLongestPathInDAG(c *C) {
+	// Project tasks: the critical path is start-design-build-test-ship, 2+5+4+1 = 12.
+	g := gogl.Spec().Directed().Weighted().Using(gogl.WeightedArcList{
+		gogl.NewWeightedArc("start", "design", 2),
+		gogl.NewWeightedArc("start", "buy", 1),
+		gogl.NewWeightedArc("design", "build", 5),
+		gogl.NewWeightedArc("buy", "build", 3),
+		gogl.NewWeightedArc("design", "docs", 3),
+		gogl.NewWeightedArc("build", "test", 4),
+		gogl.NewWeightedArc("docs", "ship", 2),
+		gogl.NewWeightedArc("test", "ship", 1),
+	}).Create(al.G).(gogl.WeightedGraph)
+
+	neg := NegateWeights(g)
+	_, directed := neg.(gogl.WeightedDigraph)
+	c.Assert(directed, Equals, true)
+
+	dist, parent, err := sp.BellmanFordShortestPath(neg, "start")
+	c.Assert(err, IsNil)
+	c.Assert(-dist["ship"], Equals, 12.0)
+
+	var route []gogl.Vertex
+	for v := gogl.Vertex("ship"); v != "start"; {
+		route = append([]gogl.Vertex{v}, route...)
+		v = parent[v].(gogl.Arc).Source()
+	}
+	c.Assert(route, DeepEquals, []gogl.Vertex{"design", "build", "test", "ship"})
+
+	// The longest path is also the heaviest in the original weights.
+	path := gogl.Path{}
+	for _, a := range [][2]gogl.Vertex{{"start", "design"}, {"design", "build"}, {"build", "test"}, {"test", "ship"}} {
+		path = append(path, gogl.NewArc(a[0], a[1]))
+	}
+	w, ok := gogl.PathWeight(g, path)
+	c.Assert(ok, Equals, true)
+	c.Assert(w, Equals, 12.0)
+}
@@ -0,0 +1,95 @@
+package transform
+
+import (
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+// Computes the k-th power of the given graph: a graph on the same vertices, with an edge
+// from u to v iff v is reachable from u in at most k hops. The first power is thus the
+// graph itself (less any loops), and higher powers are progressively denser. A power of
+// zero or less has no edges at all.
+//
+// If the graph is a WeightedGraph, so is the result, and each edge's weight is the
+// shortest distance between its endpoints over paths of at most k hops. If the graph is
+// a Digraph, so is the result, and hops follow arc direction. Loops are never produced.
+func GraphPower(g gogl.Graph, k int) gogl.Graph {
+	dg, directed := g.(gogl.Digraph)
+	_, weighted := g.(gogl.WeightedGraph)
+
+	var vertices []gogl.Vertex
+	gogl.VerticesSorted(g, func(v gogl.Vertex) (terminate bool) {
+		vertices = append(vertices, v)
+		return
+	})
+
+	// Calls f with each vertex one hop out from v, and the weight of the hop.
+	hops := func(v gogl.Vertex, f func(to gogl.Vertex, w float64)) {
+		step := func(e gogl.Edge) (terminate bool) {
+			a, b := e.Both()
+			if a != v {
+				a, b = b, a
+			}
+			w := 1.0
+			if we, ok := e.(gogl.WeightedEdge); ok {
+				w = we.Weight()
+			}
+			f(b, w)
+			return
+		}
+
+		if directed {
+			dg.ArcsFrom(v, func(a gogl.Arc) bool { return step(a) })
+		} else {
+			g.IncidentTo(v, step)
+		}
+	}
+
+	var edges gogl.WeightedEdgeList
+	var arcs gogl.WeightedArcList
+	for i, u := range vertices {
+		// Bellman-Ford, bounded to k rounds. Each round extends only the distances
+		// found in the previous one, so no path grows by more than one hop per round.
+		dist := map[gogl.Vertex]float64{u: 0}
+		frontier := map[gogl.Vertex]float64{u: 0}
+		for round := 0; round < k && len(frontier) > 0; round++ {
+			next := make(map[gogl.Vertex]float64)
+			for x, dx := range frontier {
+				hops(x, func(y gogl.Vertex, w float64) {
+					d := dx + w
+					if cur, seen := dist[y]; !seen || d < cur {
+						dist[y] = d
+						next[y] = d
+					}
+				})
+			}
+			frontier = next
+		}
+
+		for _, v := range vertices[i+1:] {
+			if d, reached := dist[v]; reached {
+				if directed {
+					arcs = append(arcs, gogl.NewWeightedArc(u, v, d))
+				} else {
+					edges = append(edges, gogl.NewWeightedEdge(u, v, d))
+				}
+			}
+		}
+		if directed {
+			for _, v := range vertices[:i] {
+				if d, reached := dist[v]; reached {
+					arcs = append(arcs, gogl.NewWeightedArc(u, v, d))
+				}
+			}
+		}
+	}
+
+	spec := gogl.Spec()
+	if weighted {
+		spec = spec.Weighted()
+	}
+	if directed {
+		return spec.Directed().Using(gogl.WithIsolates(arcs, vertices...)).Create(al.G)
+	}
+	return spec.Using(gogl.WithIsolates(edges, vertices...)).Create(al.G)
+}
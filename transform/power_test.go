@@ -0,0 +1,66 @@
+package transform
+
+import (
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+type GraphPowerSuite struct{}
+
+var _ = Suite(&GraphPowerSuite{})
+
+func pathGraph(n int) gogl.Graph {
+	el := gogl.EdgeList{}
+	for i := 0; i+1 < n; i++ {
+		el = append(el, gogl.NewEdge(i, i+1))
+	}
+	return gogl.Spec().Using(el).Create(al.G)
+}
+
+func (s *GraphPowerSuite) TestSquarePath(c *C) {
+	g := GraphPower(pathGraph(5), 2)
+
+	c.Assert(gogl.Order(g), Equals, 5)
+	// four original edges, plus three connecting vertices two apart
+	c.Assert(gogl.Size(g), Equals, 7)
+	for i := 0; i+2 < 5; i++ {
+		c.Assert(g.HasEdge(gogl.NewEdge(i, i+2)), Equals, true)
+	}
+	c.Assert(g.HasEdge(gogl.NewEdge(0, 3)), Equals, false)
+}
+
+func (s *GraphPowerSuite) TestFirstPower(c *C) {
+	p := pathGraph(5)
+	c.Assert(gogl.Equal(GraphPower(p, 1), p), Equals, true)
+	c.Assert(gogl.Size(GraphPower(p, 4)), Equals, 10)
+	c.Assert(gogl.Size(GraphPower(p, 0)), Equals, 0)
+	c.Assert(gogl.Order(GraphPower(p, 0)), Equals, 5)
+}
+
+func (s *GraphPowerSuite) TestWeighted(c *C) {
+	g := gogl.Spec().Weighted().Using(gogl.WeightedEdgeList{
+		gogl.NewWeightedEdge("a", "b", 1),
+		gogl.NewWeightedEdge("b", "c", 1),
+		gogl.NewWeightedEdge("a", "c", 5),
+		gogl.NewWeightedEdge("c", "d", 1),
+	}).Create(al.G)
+
+	p := GraphPower(g, 2).(gogl.WeightedGraph)
+	c.Assert(p.HasWeightedEdge(gogl.NewWeightedEdge("a", "c", 2)), Equals, true)
+	// a-b-c-d is three hops; within two, a-c-d is the only way
+	c.Assert(p.HasWeightedEdge(gogl.NewWeightedEdge("a", "d", 6)), Equals, true)
+	c.Assert(GraphPower(g, 3).(gogl.WeightedGraph).HasWeightedEdge(gogl.NewWeightedEdge("a", "d", 3)), Equals, true)
+}
+
+func (s *GraphPowerSuite) TestDirected(c *C) {
+	g := gogl.Spec().Directed().Using(gogl.ArcList{
+		gogl.NewArc(1, 2),
+		gogl.NewArc(2, 3),
+	}).Create(al.G)
+
+	p := GraphPower(g, 2).(gogl.Digraph)
+	c.Assert(p.HasArc(gogl.NewArc(1, 3)), Equals, true)
+	c.Assert(p.HasArc(gogl.NewArc(3, 1)), Equals, false)
+	c.Assert(gogl.Size(p), Equals, 3)
+}
@@ -0,0 +1,60 @@
+package transform
+
+import (
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+// A ProductVertex is a vertex of a graph product, pairing a vertex from each factor.
+type ProductVertex struct {
+	G, H gogl.Vertex
+}
+
+// Computes the Cartesian product of the two given graphs. Its vertices are all pairs
+// (u, x), where u is a vertex of g and x is a vertex of h, represented as ProductVertex
+// values; (u, x) and (v, y) are adjacent iff either u == v and x is adjacent to y in h,
+// or x == y and u is adjacent to v in g. The product of two paths is thus a grid, and
+// repeated products of a single edge yield hypercubes.
+//
+// The result is a Digraph if both g and h are, with arcs following the direction of the
+// factor arcs they derive from; otherwise, arc direction is disregarded. Edge weights,
+// labels, and data are not carried over.
+func CartesianProduct(g, h gogl.Graph) gogl.Graph {
+	gv := gogl.CollectVertices(g)
+	hv := gogl.CollectVertices(h)
+
+	vertices := make([]gogl.Vertex, 0, len(gv)*len(hv))
+	for _, u := range gv {
+		for _, x := range hv {
+			vertices = append(vertices, ProductVertex{u, x})
+		}
+	}
+
+	var edges gogl.ArcList
+	g.Edges(func(e gogl.Edge) (terminate bool) {
+		u, v := e.Both()
+		for _, x := range hv {
+			edges = append(edges, gogl.NewArc(ProductVertex{u, x}, ProductVertex{v, x}))
+		}
+		return
+	})
+	h.Edges(func(e gogl.Edge) (terminate bool) {
+		x, y := e.Both()
+		for _, u := range gv {
+			edges = append(edges, gogl.NewArc(ProductVertex{u, x}, ProductVertex{u, y}))
+		}
+		return
+	})
+
+	_, gd := g.(gogl.Digraph)
+	_, hd := h.(gogl.Digraph)
+	if gd && hd {
+		return gogl.Spec().Directed().Using(gogl.WithIsolates(edges, vertices...)).Create(al.G)
+	}
+
+	el := make(gogl.EdgeList, len(edges))
+	for k, a := range edges {
+		el[k] = a
+	}
+	return gogl.Spec().Using(gogl.WithIsolates(el, vertices...)).Create(al.G)
+}
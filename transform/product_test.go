@@ -0,0 +1,51 @@
+package transform
+
+import (
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+type CartesianProductSuite struct{}
+
+var _ = Suite(&CartesianProductSuite{})
+
+func (s *CartesianProductSuite) TestGrid(c *C) {
+	// P3 x P4 is the 3x4 grid: 12 vertices, 3*3 + 4*2 = 17 edges.
+	g := CartesianProduct(pathGraph(3), pathGraph(4))
+
+	c.Assert(gogl.Order(g), Equals, 12)
+	c.Assert(gogl.Size(g), Equals, 17)
+	c.Assert(g.HasEdge(gogl.NewEdge(ProductVertex{1, 2}, ProductVertex{1, 3})), Equals, true)
+	c.Assert(g.HasEdge(gogl.NewEdge(ProductVertex{1, 2}, ProductVertex{2, 2})), Equals, true)
+	c.Assert(g.HasEdge(gogl.NewEdge(ProductVertex{1, 2}, ProductVertex{2, 3})), Equals, false)
+
+	// Interior vertices have degree 4, corners degree 2.
+	d, _ := g.DegreeOf(ProductVertex{1, 1})
+	c.Assert(d, Equals, 4)
+	d, _ = g.DegreeOf(ProductVertex{0, 0})
+	c.Assert(d, Equals, 2)
+}
+
+func (s *CartesianProductSuite) TestHypercube(c *C) {
+	k2 := pathGraph(2)
+	q3 := CartesianProduct(CartesianProduct(k2, k2), k2)
+
+	c.Assert(gogl.Order(q3), Equals, 8)
+	c.Assert(gogl.Size(q3), Equals, 12)
+}
+
+func (s *CartesianProductSuite) TestDirected(c *C) {
+	arc := gogl.Spec().Directed().Using(gogl.ArcList{gogl.NewArc("a", "b")}).Create(al.G)
+	g := CartesianProduct(arc, arc)
+
+	dg, ok := g.(gogl.Digraph)
+	c.Assert(ok, Equals, true)
+	c.Assert(gogl.Size(dg), Equals, 4)
+	c.Assert(dg.HasArc(gogl.NewArc(ProductVertex{"a", "a"}, ProductVertex{"b", "a"})), Equals, true)
+	c.Assert(dg.HasArc(gogl.NewArc(ProductVertex{"b", "a"}, ProductVertex{"a", "a"})), Equals, false)
+
+	// Mixed directedness yields an undirected product.
+	_, ok = CartesianProduct(arc, pathGraph(2)).(gogl.Digraph)
+	c.Assert(ok, Equals, false)
+}
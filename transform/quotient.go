@@ -0,0 +1,78 @@
+package transform
+
+import (
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+// Returns the quotient of the given graph under the partition defined by classOf: every
+// set of vertices sharing a class key is merged into a single vertex, the key itself.
+// Two classes are joined by an edge if any edge of the original joins members of each.
+// Condensation (see dfs.Condensation) is the quotient by strongly connected component.
+//
+// Edges within a class would become loops, and are dropped; every class is retained as a
+// vertex regardless. If the original is weighted, the result is too, with the weights of
+// all the edges between two classes summed (as by CollapseParallelEdges). Otherwise the
+// result is an unweighted graph; labels and data are not carried over. If the original is
+// a Digraph, so is the result, and arcs between classes keep their direction.
+//
+// classOf is called once per vertex. Its keys become vertices, so they must be valid,
+// comparable, non-nil vertices.
+func Quotient(g gogl.Graph, classOf func(gogl.Vertex) interface{}) gogl.Graph {
+	class := make(map[gogl.Vertex]gogl.Vertex)
+	var classes []gogl.Vertex
+	seen := make(map[gogl.Vertex]struct{})
+	g.Vertices(func(v gogl.Vertex) (terminate bool) {
+		k := classOf(v)
+		class[v] = k
+		if _, exists := seen[k]; !exists {
+			seen[k] = struct{}{}
+			classes = append(classes, k)
+		}
+		return
+	})
+
+	dg, directed := g.(gogl.Digraph)
+	_, weighted := g.(gogl.WeightedGraph)
+
+	if directed {
+		arcs := gogl.ArcList{}
+		dg.Arcs(func(a gogl.Arc) (terminate bool) {
+			cu, cv := class[a.Source()], class[a.Target()]
+			if cu == cv {
+				return
+			}
+			if weighted {
+				arcs = append(arcs, gogl.NewWeightedArc(cu, cv, a.(gogl.WeightedArc).Weight()))
+			} else {
+				arcs = append(arcs, gogl.NewArc(cu, cv))
+			}
+			return
+		})
+
+		if weighted {
+			return CollapseParallelEdges(gogl.WithIsolates(arcs, classes...))
+		}
+		return gogl.Spec().Directed().Using(gogl.WithIsolates(arcs, classes...)).Create(al.G)
+	}
+
+	edges := gogl.EdgeList{}
+	g.Edges(func(e gogl.Edge) (terminate bool) {
+		u, v := e.Both()
+		cu, cv := class[u], class[v]
+		if cu == cv {
+			return
+		}
+		if weighted {
+			edges = append(edges, gogl.NewWeightedEdge(cu, cv, e.(gogl.WeightedEdge).Weight()))
+		} else {
+			edges = append(edges, gogl.NewEdge(cu, cv))
+		}
+		return
+	})
+
+	if weighted {
+		return CollapseParallelEdges(gogl.WithIsolates(edges, classes...))
+	}
+	return gogl.Spec().Using(gogl.WithIsolates(edges, classes...)).Create(al.G)
+}
@@ -0,0 +1,67 @@
+package transform
+
+import (
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+type QuotientSuite struct{}
+
+var _ = Suite(&QuotientSuite{})
+
+// Splits int vertices into "low" (below 10) and "high".
+func lowHigh(v gogl.Vertex) interface{} {
+	if v.(int) < 10 {
+		return "low"
+	}
+	return "high"
+}
+
+func (s *QuotientSuite) TestTwoGroups(c *C) {
+	g := gogl.Spec().Using(gogl.EdgeList{
+		gogl.NewEdge(1, 2),
+		gogl.NewEdge(2, 3),
+		gogl.NewEdge(3, 11),
+		gogl.NewEdge(12, 1),
+		gogl.NewEdge(11, 12),
+	}).Create(al.G)
+
+	q := Quotient(g, lowHigh)
+	c.Assert(gogl.Order(q), Equals, 2)
+	c.Assert(gogl.Size(q), Equals, 1)
+	c.Assert(q.HasEdge(gogl.NewEdge("low", "high")), Equals, true)
+	c.Assert(gogl.CanWeight(q), Equals, false)
+
+	// No edges between the groups leaves them as isolates.
+	q = Quotient(gogl.Spec().Using(gogl.EdgeList{
+		gogl.NewEdge(1, 2),
+		gogl.NewEdge(11, 12),
+	}).Create(al.G), lowHigh)
+	c.Assert(gogl.Order(q), Equals, 2)
+	c.Assert(gogl.Size(q), Equals, 0)
+}
+
+func (s *QuotientSuite) TestWeightedDirected(c *C) {
+	g := gogl.Spec().Directed().Weighted().Using(gogl.WeightedArcList{
+		gogl.NewWeightedArc(1, 11, 2),
+		gogl.NewWeightedArc(2, 12, 3),
+		gogl.NewWeightedArc(12, 1, 4),
+		gogl.NewWeightedArc(1, 2, 100),
+	}).Create(al.G)
+
+	q := Quotient(g, lowHigh).(gogl.WeightedDigraph)
+	c.Assert(gogl.Order(q), Equals, 2)
+	c.Assert(gogl.Size(q), Equals, 2)
+	c.Assert(q.HasWeightedArc(gogl.NewWeightedArc("low", "high", 5)), Equals, true)
+	c.Assert(q.HasWeightedArc(gogl.NewWeightedArc("high", "low", 4)), Equals, true)
+
+	// Undirected weights between two classes sum regardless of orientation.
+	ug := gogl.Spec().Weighted().Using(gogl.WeightedEdgeList{
+		gogl.NewWeightedEdge(1, 11, 2),
+		gogl.NewWeightedEdge(12, 2, 3),
+	}).Create(al.G)
+	uq := Quotient(ug, lowHigh).(gogl.WeightedGraph)
+	c.Assert(gogl.Size(uq), Equals, 1)
+	c.Assert(uq.HasWeightedEdge(gogl.NewWeightedEdge("low", "high", 5)), Equals, true)
+}
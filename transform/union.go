@@ -0,0 +1,75 @@
+package transform
+
+import (
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+// A UnionVertex is a vertex of a disjoint union, tagging a vertex from one of the
+// operands with the side it came from: 0 for the first, 1 for the second.
+type UnionVertex struct {
+	Side int
+	V    gogl.Vertex
+}
+
+// Computes the disjoint union of the two given graphs: both graphs side by side, with no
+// edges between them. So that vertices present in both never collide, every vertex is
+// relabeled as a UnionVertex - g's vertex v becomes UnionVertex{0, v}, and h's vertex x
+// becomes UnionVertex{1, x}.
+//
+// The result is a Digraph if both g and h are; otherwise, arc direction is disregarded.
+// Edge weights, labels, and data are not carried over.
+func DisjointUnion(g, h gogl.Graph) gogl.Graph {
+	return union(g, h, false)
+}
+
+// Computes the join of the two given graphs: their disjoint union (see DisjointUnion,
+// including for the relabeling scheme), plus an edge between every vertex of g and every
+// vertex of h. If the result is a Digraph, those connections are arcs in both directions.
+func Join(g, h gogl.Graph) gogl.Graph {
+	return union(g, h, true)
+}
+
+func union(g, h gogl.Graph, join bool) gogl.Graph {
+	var vertices []gogl.Vertex
+	var arcs gogl.ArcList
+
+	for side, x := range []gogl.Graph{g, h} {
+		side := side
+		x.Vertices(func(v gogl.Vertex) (terminate bool) {
+			vertices = append(vertices, UnionVertex{side, v})
+			return
+		})
+		x.Edges(func(e gogl.Edge) (terminate bool) {
+			u, v := e.Both()
+			arcs = append(arcs, gogl.NewArc(UnionVertex{side, u}, UnionVertex{side, v}))
+			return
+		})
+	}
+
+	_, gd := g.(gogl.Digraph)
+	_, hd := h.(gogl.Digraph)
+	directed := gd && hd
+
+	if join {
+		gv, hv := gogl.CollectVertices(g), gogl.CollectVertices(h)
+		for _, u := range gv {
+			for _, x := range hv {
+				arcs = append(arcs, gogl.NewArc(UnionVertex{0, u}, UnionVertex{1, x}))
+				if directed {
+					arcs = append(arcs, gogl.NewArc(UnionVertex{1, x}, UnionVertex{0, u}))
+				}
+			}
+		}
+	}
+
+	if directed {
+		return gogl.Spec().Directed().Using(gogl.WithIsolates(arcs, vertices...)).Create(al.G)
+	}
+
+	el := make(gogl.EdgeList, len(arcs))
+	for k, a := range arcs {
+		el[k] = a
+	}
+	return gogl.Spec().Using(gogl.WithIsolates(el, vertices...)).Create(al.G)
+}
@@ -0,0 +1,46 @@
+package transform
+
+import (
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+type UnionSuite struct{}
+
+var _ = Suite(&UnionSuite{})
+
+func (s *UnionSuite) TestDisjointUnion(c *C) {
+	// Both operands share vertex names; relabeling keeps them apart.
+	g, h := pathGraph(3), pathGraph(4)
+	u := DisjointUnion(g, h)
+
+	c.Assert(gogl.Order(u), Equals, gogl.Order(g)+gogl.Order(h))
+	c.Assert(gogl.Size(u), Equals, gogl.Size(g)+gogl.Size(h))
+	c.Assert(u.HasEdge(gogl.NewEdge(UnionVertex{0, 1}, UnionVertex{0, 2})), Equals, true)
+	c.Assert(u.HasEdge(gogl.NewEdge(UnionVertex{1, 2}, UnionVertex{1, 3})), Equals, true)
+	c.Assert(u.HasVertex(UnionVertex{0, 3}), Equals, false)
+
+	// Isolated vertices survive.
+	iso := gogl.Spec().Using(gogl.WithIsolates(gogl.EdgeList{}, "x")).Create(al.G)
+	c.Assert(gogl.Order(DisjointUnion(iso, iso)), Equals, 2)
+}
+
+func (s *UnionSuite) TestJoin(c *C) {
+	g, h := pathGraph(3), pathGraph(4)
+	j := Join(g, h)
+
+	c.Assert(gogl.Order(j), Equals, gogl.Order(g)+gogl.Order(h))
+	c.Assert(gogl.Size(j)-gogl.Size(g)-gogl.Size(h), Equals, gogl.Order(g)*gogl.Order(h))
+	c.Assert(j.HasEdge(gogl.NewEdge(UnionVertex{0, 0}, UnionVertex{1, 3})), Equals, true)
+}
+
+func (s *UnionSuite) TestDirectedJoin(c *C) {
+	g := gogl.Spec().Directed().Using(gogl.ArcList{gogl.NewArc(1, 2)}).Create(al.G)
+	j := Join(g, g)
+
+	dg, ok := j.(gogl.Digraph)
+	c.Assert(ok, Equals, true)
+	c.Assert(gogl.Size(dg), Equals, 2+2*4)
+	c.Assert(dg.HasArc(gogl.NewArc(UnionVertex{1, 2}, UnionVertex{0, 1})), Equals, true)
+}
@@ -0,0 +1,46 @@
+// Contains algos for counting triangles, both exactly and approximately over edge streams.
+package triangles
+
+import (
+	"github.com/sdboyer/gogl"
+)
+
+// Counts the triangles in the given graph: sets of three vertices that are pairwise
+// adjacent. Loops are disregarded, as is arc direction in digraphs.
+func CountTriangles(g gogl.Graph) int {
+	adj := make(map[gogl.Vertex]map[gogl.Vertex]struct{})
+	g.Vertices(func(v gogl.Vertex) (terminate bool) {
+		adj[v] = make(map[gogl.Vertex]struct{})
+		return
+	})
+	g.Edges(func(e gogl.Edge) (terminate bool) {
+		if u, v := e.Both(); u != v {
+			adj[u][v] = struct{}{}
+			adj[v][u] = struct{}{}
+		}
+		return
+	})
+
+	var count int
+	for u, nu := range adj {
+		for v := range nu {
+			count += common(adj[u], adj[v])
+		}
+	}
+
+	// Each triangle is seen once from each ordered pair of its vertices.
+	return count / 6
+}
+
+// Counts the vertices present in both neighbor sets.
+func common(a, b map[gogl.Vertex]struct{}) (n int) {
+	if len(b) < len(a) {
+		a, b = b, a
+	}
+	for x := range a {
+		if _, exists := b[x]; exists {
+			n++
+		}
+	}
+	return
+}
@@ -0,0 +1,44 @@
+package triangles
+
+import (
+	"testing"
+
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+// Hook gocheck into the go test runner
+func Test(t *testing.T) { TestingT(t) }
+
+type CountSuite struct{}
+
+var _ = Suite(&CountSuite{})
+
+func complete(n int) gogl.EdgeList {
+	el := gogl.EdgeList{}
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			el = append(el, gogl.NewEdge(i, j))
+		}
+	}
+	return el
+}
+
+func (s *CountSuite) TestComplete(c *C) {
+	for n := 0; n < 8; n++ {
+		g := gogl.Spec().Using(complete(n)).Create(al.G)
+		c.Assert(CountTriangles(g), Equals, n*(n-1)*(n-2)/6)
+	}
+}
+
+func (s *CountSuite) TestLoopsAndDirection(c *C) {
+	g := gogl.Spec().Directed().Using(gogl.ArcList{
+		gogl.NewArc(1, 2),
+		gogl.NewArc(2, 3),
+		gogl.NewArc(1, 3),
+		gogl.NewArc(3, 3),
+		gogl.NewArc(3, 4),
+	}).Create(al.G)
+	c.Assert(CountTriangles(g), Equals, 1)
+}
@@ -0,0 +1,90 @@
+package triangles
+
+import (
+	"math/rand"
+
+	"github.com/sdboyer/gogl"
+)
+
+// A StreamingTriangleEstimator approximates the number of triangles in a graph whose
+// edges arrive one at a time, using memory bounded by a fixed-size reservoir of sampled
+// edges. It implements the improved variant of TRIÈST (De Stefani et al., 2016): each
+// arriving edge's triangles within the sample are counted, weighted by the inverse
+// probability of their other two edges having been sampled, and the edge then enters
+// the reservoir by standard reservoir sampling.
+//
+// While no more edges have arrived than the reservoir holds, the estimate is exact. The
+// stream should not repeat edges, in either orientation; loops are ignored.
+type StreamingTriangleEstimator struct {
+	capacity int
+	seen     int
+	estimate float64
+	r        *rand.Rand
+	sample   []gogl.Edge
+	adj      map[gogl.Vertex]map[gogl.Vertex]struct{}
+}
+
+// Creates a new streaming estimator holding at most the given number of edges, which
+// must be at least 2. All randomness comes from the provided source.
+func NewStreamingTriangleEstimator(reservoir int, r *rand.Rand) *StreamingTriangleEstimator {
+	if reservoir < 2 {
+		panic("Reservoir must hold at least 2 edges.")
+	}
+
+	return &StreamingTriangleEstimator{
+		capacity: reservoir,
+		r:        r,
+		sample:   make([]gogl.Edge, 0, reservoir),
+		adj:      make(map[gogl.Vertex]map[gogl.Vertex]struct{}),
+	}
+}
+
+// Processes the next edge in the stream.
+func (s *StreamingTriangleEstimator) AddEdge(u, v gogl.Vertex) {
+	if u == v {
+		return
+	}
+	s.seen++
+
+	// Both other edges of a triangle closed by this one are in the sample with
+	// probability roughly (M/(t-1))((M-1)/(t-2)); weight by its inverse.
+	t, m := float64(s.seen), float64(s.capacity)
+	eta := (t - 1) * (t - 2) / (m * (m - 1))
+	if eta < 1 {
+		eta = 1
+	}
+	s.estimate += eta * float64(common(s.adj[u], s.adj[v]))
+
+	if len(s.sample) < s.capacity {
+		s.sample = append(s.sample, gogl.NewEdge(u, v))
+		s.link(u, v)
+	} else if s.r.Float64() < m/t {
+		k := s.r.Intn(len(s.sample))
+		s.unlink(s.sample[k].Both())
+		s.sample[k] = gogl.NewEdge(u, v)
+		s.link(u, v)
+	}
+}
+
+// Returns the current estimate of the number of triangles in the stream so far.
+func (s *StreamingTriangleEstimator) Estimate() float64 {
+	return s.estimate
+}
+
+func (s *StreamingTriangleEstimator) link(u, v gogl.Vertex) {
+	for _, p := range [][2]gogl.Vertex{{u, v}, {v, u}} {
+		if s.adj[p[0]] == nil {
+			s.adj[p[0]] = make(map[gogl.Vertex]struct{})
+		}
+		s.adj[p[0]][p[1]] = struct{}{}
+	}
+}
+
+func (s *StreamingTriangleEstimator) unlink(u, v gogl.Vertex) {
+	for _, p := range [][2]gogl.Vertex{{u, v}, {v, u}} {
+		delete(s.adj[p[0]], p[1])
+		if len(s.adj[p[0]]) == 0 {
+			delete(s.adj, p[0])
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package triangles
+
+import (
+	"math"
+	"math/rand"
+
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+type StreamingSuite struct{}
+
+var _ = Suite(&StreamingSuite{})
+
+// A random graph over 30 vertices, streamed in a shuffled order.
+func streamFixture() gogl.EdgeList {
+	r := rand.New(rand.NewSource(3))
+	el := gogl.EdgeList{}
+	for i := 0; i < 30; i++ {
+		for j := i + 1; j < 30; j++ {
+			if r.Float64() < 0.3 {
+				el = append(el, gogl.NewEdge(i, j))
+			}
+		}
+	}
+	r.Shuffle(len(el), func(i, j int) { el[i], el[j] = el[j], el[i] })
+	return el
+}
+
+func estimate(el gogl.EdgeList, reservoir int, seed int64) float64 {
+	est := NewStreamingTriangleEstimator(reservoir, rand.New(rand.NewSource(seed)))
+	for _, e := range el {
+		est.AddEdge(e.Both())
+	}
+	return est.Estimate()
+}
+
+func (s *StreamingSuite) TestExactWhenEverythingFits(c *C) {
+	el := streamFixture()
+	exact := CountTriangles(gogl.Spec().Using(el).Create(al.G))
+	c.Assert(exact > 0, Equals, true)
+	c.Assert(estimate(el, len(el), 1), Equals, float64(exact))
+}
+
+func (s *StreamingSuite) TestConverges(c *C) {
+	el := streamFixture()
+	exact := float64(CountTriangles(gogl.Spec().Using(el).Create(al.G)))
+
+	// Mean relative error over several seeds shrinks as the reservoir grows.
+	errAt := func(reservoir int) (total float64) {
+		for seed := int64(0); seed < 30; seed++ {
+			total += math.Abs(estimate(el, reservoir, seed)-exact) / exact
+		}
+		return total / 30
+	}
+
+	small, medium, large := errAt(len(el)/8), errAt(len(el)/3), errAt(len(el)*3/4)
+	c.Assert(medium < small, Equals, true)
+	c.Assert(large < medium, Equals, true)
+	c.Assert(large < 0.1, Equals, true)
+}
+
+func (s *StreamingSuite) TestTinyReservoir(c *C) {
+	c.Assert(func() { NewStreamingTriangleEstimator(1, rand.New(rand.NewSource(1))) }, PanicMatches, ".*at least 2.*")
+}
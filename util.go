@@ -1,5 +1,7 @@
 package gogl
 
+import "math"
+
 // Returns the number of vertices in a graph.
 //
 // If available, this function will take advantage of the optional optimization Order() method.
@@ -164,3 +166,137 @@ func CollectArcsTo(v Vertex, g IncidentArcEnumerator) (arcs []Arc) {
 
 	return arcs
 }
+
+// Enumerates a graph's vertices from a snapshot taken up front, rather than directly.
+//
+// Graph implementations typically hold a read lock for the duration of an enumeration,
+// so a slow step function, or one that mutates the graph, will contend with (or deadlock
+// against) writers. VerticesSnapshot copies the vertex set out first, so the step function
+// runs with no lock held and is unaffected by any mutations made while it runs. The cost
+// is the copy.
+func VerticesSnapshot(g VertexEnumerator, f VertexStep) {
+	for _, v := range CollectVertices(g) {
+		if f(v) {
+			return
+		}
+	}
+}
+
+// Enumerates a graph's edges from a snapshot taken up front, rather than directly.
+//
+// See VerticesSnapshot for the rationale.
+func EdgesSnapshot(g EdgeEnumerator, f EdgeStep) {
+	for _, e := range CollectEdges(g) {
+		if f(e) {
+			return
+		}
+	}
+}
+
+// Indicates the presence of each of the provided edges in the graph. The returned
+// slice is positionally aligned with the provided edges.
+//
+// If available, this function will take advantage of the optional optimization HasEdges()
+// method. Otherwise, it will call HasEdge() once per edge.
+func HasEdges(g EdgeMembershipChecker, edges ...Edge) []bool {
+	if c, ok := g.(BulkEdgeMembershipChecker); ok {
+		return c.HasEdges(edges...)
+	}
+
+	has := make([]bool, len(edges))
+	for k, e := range edges {
+		has[k] = g.HasEdge(e)
+	}
+
+	return has
+}
+
+// Indicates whether the graph contains an edge between u and v whose weight is within
+// epsilon of the given weight. This is preferable to HasWeightedEdge() when weights may
+// carry rounding noise, as that requires exact equality.
+//
+// In directed graphs, only an arc from u to v matches. In undirected graphs, edges are
+// matched regardless of orientation.
+func HasEdgeApprox(g WeightedGraph, u, v Vertex, weight, epsilon float64) bool {
+	w, exists := edgeWeight(g, NewEdge(u, v))
+	return exists && math.Abs(w-weight) <= epsilon
+}
+
+// Adds delta to the weight of the edge between u and v, returning the new weight, or false
+// if there is no such edge. In undirected graphs the edge may be given in either
+// orientation, and its weight changes as seen from both endpoints; in directed graphs,
+// only an arc from u to v matches.
+//
+// If available, this function will take advantage of the optional WeightAdjuster
+// interface, as all of gogl's weighted graphs implement, making the update atomic under
+// the graph's lock: concurrent adjustments to the same edge all take effect. Otherwise,
+// the edge is read, removed and re-added, which is not safe against concurrent writers.
+func AdjustWeight(g MutableWeightedGraph, u, v Vertex, delta float64) (float64, bool) {
+	if a, ok := g.(WeightAdjuster); ok {
+		return a.AdjustWeight(u, v, delta)
+	}
+
+	w, exists := edgeWeight(g, NewEdge(u, v))
+	if !exists {
+		return 0, false
+	}
+	g.RemoveEdges(NewWeightedEdge(u, v, w))
+	g.AddEdges(NewWeightedEdge(u, v, w+delta))
+	return w + delta, true
+}
+
+// Compacts the given graph in place, if it implements Compacter, releasing memory retained
+// by its internal structures after heavy removal of vertices or edges. Returns false if the
+// graph does not support compaction, in which case it is left untouched.
+//
+// Compaction is O(V+E), and blocks all other access to the graph while it runs. The graph's
+// contents are unaffected.
+func Compact(g Graph) bool {
+	if c, ok := g.(Compacter); ok {
+		c.Compact()
+		return true
+	}
+	return false
+}
+
+// Indicates whether the given graph's edges carry weights; that is, whether it implements
+// WeightedGraph.
+func CanWeight(g Graph) bool {
+	_, ok := g.(WeightedGraph)
+	return ok
+}
+
+// Indicates whether the given graph can be modified: whether it implements
+// VertexSetMutator, along with any one of the edge or arc set mutator interfaces (basic,
+// weighted, labeled, or data). Type assert to the appropriate interface to mutate it.
+func CanMutate(g Graph) bool {
+	if _, ok := g.(VertexSetMutator); !ok {
+		return false
+	}
+
+	switch g.(type) {
+	case EdgeSetMutator, ArcSetMutator,
+		WeightedEdgeSetMutator, WeightedArcSetMutator,
+		LabeledEdgeSetMutator, LabeledArcSetMutator,
+		DataEdgeSetMutator, DataArcSetMutator:
+		return true
+	}
+	return false
+}
+
+// Indicates whether the given graph may contain parallel edges; that is, whether it
+// implements Multigraph.
+func IsMultigraph(g Graph) bool {
+	_, ok := g.(Multigraph)
+	return ok
+}
+
+// Enumerates a graph's edges with a fallible step function. Enumeration stops at the first
+// error returned by the step function, and that error is returned.
+func EdgesE(g EdgeEnumerator, f func(Edge) error) (err error) {
+	g.Edges(func(e Edge) (terminate bool) {
+		err = f(e)
+		return err != nil
+	})
+	return
+}
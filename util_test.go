@@ -1,10 +1,14 @@
 package gogl_test
 
 import (
+	"errors"
+	"fmt"
+	"sync"
 	"testing"
 
 	. "github.com/sdboyer/gocheck"
 	. "github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
 	"github.com/sdboyer/gogl/spec"
 	"gopkg.in/fatih/set.v0"
 )
@@ -150,3 +154,222 @@ func (s *CountingFunctorsSuite) TestSize(c *C) {
 	c.Assert(Size(el), Equals, 4)
 	c.Assert(Size(spec.GraphLiteralFixture(true)), Equals, 2)
 }
+
+type SpecOfSuite struct{}
+
+var _ = Suite(&SpecOfSuite{})
+
+func (s *SpecOfSuite) TestSpecOf(c *C) {
+	c.Assert(SpecOf(Spec().Create(al.G)).Props, Equals, Spec().Props)
+	c.Assert(SpecOf(Spec().Directed().Create(al.G)).Props, Equals, Spec().Directed().Props)
+	c.Assert(SpecOf(Spec().Weighted().Create(al.G)).Props, Equals, Spec().Weighted().Props)
+	c.Assert(SpecOf(Spec().Directed().Labeled().Create(al.G)).Props, Equals, Spec().Directed().Labeled().Props)
+	c.Assert(SpecOf(Spec().DataEdges().Create(al.G)).Props, Equals, Spec().DataEdges().Props)
+}
+
+type SnapshotSuite struct{}
+
+var _ = Suite(&SnapshotSuite{})
+
+func (s *SnapshotSuite) TestVerticesSnapshot(c *C) {
+	g := Spec().Using(spec.GraphFixtures["2e3v"]).Create(al.G)
+	m := g.(MutableGraph)
+
+	var visited int
+	VerticesSnapshot(g, func(v Vertex) (terminate bool) {
+		// would deadlock if the graph's read lock were still held
+		m.RemoveVertex(v)
+		m.EnsureVertex(fmt.Sprint(v, "-new"))
+		visited++
+		return
+	})
+
+	c.Assert(visited, Equals, 3)
+	c.Assert(Order(g), Equals, 3)
+	c.Assert(g.HasVertex("foo-new"), Equals, true)
+
+	visited = 0
+	VerticesSnapshot(g, func(v Vertex) (terminate bool) {
+		visited++
+		return true
+	})
+	c.Assert(visited, Equals, 1)
+}
+
+func (s *SnapshotSuite) TestEdgesSnapshot(c *C) {
+	g := Spec().Using(spec.GraphFixtures["2e3v"]).Create(al.G)
+	m := g.(MutableGraph)
+
+	var visited int
+	EdgesSnapshot(g, func(e Edge) (terminate bool) {
+		m.RemoveEdges(e)
+		m.AddEdges(NewEdge("qux", "quark"))
+		visited++
+		return
+	})
+
+	c.Assert(visited, Equals, 2)
+	c.Assert(Size(g), Equals, 1)
+	c.Assert(g.HasEdge(NewEdge("qux", "quark")), Equals, true)
+}
+
+type HasEdgesSuite struct{}
+
+var _ = Suite(&HasEdgesSuite{})
+
+func (s *HasEdgesSuite) TestHasEdgesFallback(c *C) {
+	// the literal fixture lacks the HasEdges optimization, so HasEdge is used
+	g := spec.GraphLiteralFixture(true)
+	_, ok := interface{}(g).(BulkEdgeMembershipChecker)
+	c.Assert(ok, Equals, false)
+
+	has := HasEdges(g, NewEdge("foo", "bar"), NewEdge("qux", "quark"), NewEdge("bar", "foo"))
+	c.Assert(has, DeepEquals, []bool{true, false, true})
+}
+
+func (s *HasEdgesSuite) TestHasEdgeApprox(c *C) {
+	// Computed at runtime, so it carries the usual float rounding.
+	tenth := 0.1
+	g := Spec().Weighted().Using(WeightedEdgeList{
+		NewWeightedEdge("a", "b", tenth+0.2),
+	}).Create(al.G).(WeightedGraph)
+
+	c.Assert(g.HasWeightedEdge(NewWeightedEdge("a", "b", 0.3)), Equals, false)
+	c.Assert(HasEdgeApprox(g, "a", "b", 0.3, 1e-9), Equals, true)
+	c.Assert(HasEdgeApprox(g, "b", "a", 0.3, 1e-9), Equals, true)
+	c.Assert(HasEdgeApprox(g, "a", "b", 0.31, 0.005), Equals, false)
+	c.Assert(HasEdgeApprox(g, "a", "b", 0.31, 0.02), Equals, true)
+	c.Assert(HasEdgeApprox(g, "a", "c", 0.3, 1), Equals, false)
+
+	dg := Spec().Directed().Weighted().Using(WeightedArcList{
+		NewWeightedArc("a", "b", 2),
+	}).Create(al.G).(WeightedGraph)
+	c.Assert(HasEdgeApprox(dg, "a", "b", 2.0000001, 1e-6), Equals, true)
+	c.Assert(HasEdgeApprox(dg, "b", "a", 2, 1e-6), Equals, false)
+}
+
+// Hides the graph's WeightAdjuster implementation, forcing the fallback.
+type unadjustable struct {
+	MutableWeightedGraph
+}
+
+func (s *HasEdgesSuite) TestAdjustWeight(c *C) {
+	g := Spec().Weighted().Using(WeightedEdgeList{
+		NewWeightedEdge("a", "b", 1),
+	}).Create(al.G).(MutableWeightedGraph)
+
+	for _, mg := range []MutableWeightedGraph{g, unadjustable{g}} {
+		w, exists := AdjustWeight(mg, "b", "a", 2.5)
+		c.Assert(exists, Equals, true)
+		c.Assert(w, Equals, 3.5)
+		c.Assert(g.HasWeightedEdge(NewWeightedEdge("a", "b", 3.5)), Equals, true)
+		c.Assert(Size(g), Equals, 1)
+		AdjustWeight(mg, "a", "b", -2.5)
+
+		_, exists = AdjustWeight(mg, "a", "c", 1)
+		c.Assert(exists, Equals, false)
+		c.Assert(g.HasVertex("c"), Equals, false)
+	}
+
+	dg := Spec().Directed().Weighted().Using(WeightedArcList{
+		NewWeightedArc("a", "b", 1),
+	}).Create(al.G).(WeightAdjuster)
+	_, exists := dg.AdjustWeight("b", "a", 1)
+	c.Assert(exists, Equals, false)
+	w, _ := dg.AdjustWeight("a", "b", 1)
+	c.Assert(w, Equals, 2.0)
+}
+
+// Run under the race detector, this also checks that adjustments are made under the lock.
+func (s *HasEdgesSuite) TestAdjustWeightConcurrent(c *C) {
+	g := Spec().Weighted().Using(WeightedEdgeList{
+		NewWeightedEdge("a", "b", 0),
+	}).Create(al.G).(MutableWeightedGraph)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for k := 0; k < 250; k++ {
+				// Alternate orientations; both name the same edge.
+				if (i+k)%2 == 0 {
+					AdjustWeight(g, "a", "b", 1)
+				} else {
+					AdjustWeight(g, "b", "a", 1)
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	c.Assert(g.HasWeightedEdge(NewWeightedEdge("a", "b", 5000)), Equals, true)
+	c.Assert(g.HasWeightedEdge(NewWeightedEdge("b", "a", 5000)), Equals, true)
+}
+
+type FallibleEnumerationSuite struct{}
+
+var _ = Suite(&FallibleEnumerationSuite{})
+
+func (s *FallibleEnumerationSuite) TestEdgesE(c *C) {
+	g := Spec().Using(spec.GraphFixtures["3e4v"]).Create(al.G)
+
+	var visited int
+	fail := errors.New("second edge")
+	err := EdgesE(g, func(e Edge) error {
+		visited++
+		if visited == 2 {
+			return fail
+		}
+		return nil
+	})
+
+	c.Assert(err, Equals, fail)
+	c.Assert(visited, Equals, 2)
+
+	visited = 0
+	err = EdgesE(g, func(e Edge) error {
+		visited++
+		return nil
+	})
+	c.Assert(err, IsNil)
+	c.Assert(visited, Equals, 3)
+}
+
+type CapabilitySuite struct{}
+
+var _ = Suite(&CapabilitySuite{})
+
+// Wraps a graph to claim parallel edge support.
+type multi struct {
+	Graph
+}
+
+func (m multi) Multiplicity(e Edge) int {
+	if m.HasEdge(e) {
+		return 1
+	}
+	return 0
+}
+
+func (s *CapabilitySuite) TestPredicates(c *C) {
+	for _, tc := range []struct {
+		g                       Graph
+		weighted, mutable, many bool
+	}{
+		{Spec().Create(al.G), false, true, false},
+		{Spec().Directed().Create(al.G), false, true, false},
+		{Spec().Directed().Immutable().Create(al.G), false, false, false},
+		{Spec().Weighted().Create(al.G), true, true, false},
+		{Spec().Directed().Weighted().Create(al.G), true, true, false},
+		{Spec().Directed().Weighted().Create(al.GIndexed), true, true, false},
+		{Spec().Labeled().Create(al.G), false, true, false},
+		{Spec().Directed().DataEdges().Create(al.G), false, true, false},
+		{spec.GraphLiteralFixture(true), false, false, false},
+		{multi{Spec().Create(al.G)}, false, false, true},
+	} {
+		c.Assert(CanWeight(tc.g), Equals, tc.weighted, Commentf("%T", tc.g))
+		c.Assert(CanMutate(tc.g), Equals, tc.mutable, Commentf("%T", tc.g))
+		c.Assert(IsMultigraph(tc.g), Equals, tc.many, Commentf("%T", tc.g))
+	}
+}
@@ -0,0 +1,118 @@
+package viz
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+
+	"github.com/sdboyer/gogl"
+)
+
+// Computes a two-dimensional layout for the provided graph using the force-directed
+// algorithm of Fruchterman and Reingold, returning a map of each vertex's coordinates.
+//
+// The graph is modeled as a physical system in which every pair of vertices repels the
+// other, while each edge acts as a spring that attracts its endpoints. Vertices start
+// at random positions in the unit square and are moved according to the net force upon
+// them for the given number of iterations, with a maximum displacement (temperature) that
+// cools linearly to zero. Coordinates are not clamped to the unit square.
+//
+// Edge direction is ignored. Given the same graph and an identically seeded rand, the
+// layout is deterministic; if r is nil, the math/rand global source is used.
+func ForceDirectedLayout(g gogl.Graph, iterations int, r *rand.Rand) map[gogl.Vertex][2]float64 {
+	vertices := sortedVertices(g)
+	n := len(vertices)
+	pos := make(map[gogl.Vertex][2]float64, n)
+	if n == 0 {
+		return pos
+	}
+
+	float := rand.Float64
+	if r != nil {
+		float = r.Float64
+	}
+
+	index := make(map[gogl.Vertex]int, n)
+	x, y := make([]float64, n), make([]float64, n)
+	for i, v := range vertices {
+		index[v] = i
+		x[i], y[i] = float(), float()
+	}
+
+	// Collect edges as sorted index pairs, so forces are always summed in the same order.
+	var edges [][2]int
+	g.Edges(func(e gogl.Edge) (terminate bool) {
+		u, v := e.Both()
+		if i, j := index[u], index[v]; i < j {
+			edges = append(edges, [2]int{i, j})
+		} else if i > j {
+			edges = append(edges, [2]int{j, i})
+		}
+		return
+	})
+	sort.Slice(edges, func(i, j int) bool {
+		if edges[i][0] == edges[j][0] {
+			return edges[i][1] < edges[j][1]
+		}
+		return edges[i][0] < edges[j][0]
+	})
+
+	k := math.Sqrt(1 / float64(n))
+	dx, dy := make([]float64, n), make([]float64, n)
+	const minDist = 1e-9
+	const t0 = 0.1
+
+	for iter := 0; iter < iterations; iter++ {
+		for i := range dx {
+			dx[i], dy[i] = 0, 0
+		}
+
+		for i := 0; i < n; i++ {
+			for j := i + 1; j < n; j++ {
+				ddx, ddy := x[i]-x[j], y[i]-y[j]
+				d := math.Max(math.Hypot(ddx, ddy), minDist)
+				f := k * k / d
+				dx[i] += ddx / d * f
+				dy[i] += ddy / d * f
+				dx[j] -= ddx / d * f
+				dy[j] -= ddy / d * f
+			}
+		}
+
+		for _, e := range edges {
+			u, v := e[0], e[1]
+			ddx, ddy := x[u]-x[v], y[u]-y[v]
+			d := math.Max(math.Hypot(ddx, ddy), minDist)
+			f := d * d / k
+			dx[u] -= ddx / d * f
+			dy[u] -= ddy / d * f
+			dx[v] += ddx / d * f
+			dy[v] += ddy / d * f
+		}
+
+		t := t0 * (1 - float64(iter)/float64(iterations))
+		for i := 0; i < n; i++ {
+			d := math.Hypot(dx[i], dy[i])
+			if d > 0 {
+				x[i] += dx[i] / d * math.Min(d, t)
+				y[i] += dy[i] / d * math.Min(d, t)
+			}
+		}
+	}
+
+	for i, v := range vertices {
+		pos[v] = [2]float64{x[i], y[i]}
+	}
+	return pos
+}
+
+// Collects the graph's vertices into a slice, in their natural order (see
+// gogl.VertexLess).
+func sortedVertices(g gogl.Graph) []gogl.Vertex {
+	var vertices []gogl.Vertex
+	gogl.VerticesSorted(g, func(v gogl.Vertex) (terminate bool) {
+		vertices = append(vertices, v)
+		return
+	})
+	return vertices
+}
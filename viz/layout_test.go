@@ -0,0 +1,63 @@
+package viz
+
+import (
+	"math"
+	"math/rand"
+
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+type LayoutSuite struct{}
+
+var _ = Suite(&LayoutSuite{})
+
+// Two 4-cliques joined by a single bridge edge.
+func barbell() gogl.Graph {
+	el := gogl.EdgeList{gogl.NewEdge(0, 4)}
+	for _, base := range []int{0, 4} {
+		for i := 0; i < 4; i++ {
+			for j := i + 1; j < 4; j++ {
+				el = append(el, gogl.NewEdge(base+i, base+j))
+			}
+		}
+	}
+	return gogl.Spec().Using(el).Create(al.G)
+}
+
+func (s *LayoutSuite) TestConnectedCloser(c *C) {
+	g := barbell()
+	pos := ForceDirectedLayout(g, 200, rand.New(rand.NewSource(42)))
+	c.Assert(len(pos), Equals, 8)
+
+	dist := func(u, v gogl.Vertex) float64 {
+		return math.Hypot(pos[u][0]-pos[v][0], pos[u][1]-pos[v][1])
+	}
+
+	var adj, nonadj float64
+	var nadj, nnonadj int
+	vertices := gogl.CollectVertices(g)
+	for i, u := range vertices {
+		for _, v := range vertices[i+1:] {
+			if g.HasEdge(gogl.NewEdge(u, v)) {
+				adj += dist(u, v)
+				nadj++
+			} else {
+				nonadj += dist(u, v)
+				nnonadj++
+			}
+		}
+	}
+
+	c.Assert(adj/float64(nadj) < nonadj/float64(nnonadj), Equals, true)
+}
+
+func (s *LayoutSuite) TestDeterministic(c *C) {
+	g := barbell()
+	a := ForceDirectedLayout(g, 50, rand.New(rand.NewSource(7)))
+	b := ForceDirectedLayout(g, 50, rand.New(rand.NewSource(7)))
+	c.Assert(a, DeepEquals, b)
+
+	c.Assert(len(ForceDirectedLayout(gogl.NullGraph, 10, nil)), Equals, 0)
+}
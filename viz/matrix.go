@@ -0,0 +1,90 @@
+// Contains helpers for visualizing graphs.
+package viz
+
+import (
+	"errors"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"math"
+
+	"github.com/sdboyer/gogl"
+)
+
+// Graphs with fewer vertices than this are scaled up when rendered as a matrix, such that
+// each cell of the matrix is a square of pixels and the image is at least this many pixels wide.
+const minMatrixPNGSize = 256
+
+// Renders the adjacency matrix of the provided graph as a grayscale PNG, writing it to the
+// given writer. This is useful as an at-a-glance view of a graph's block structure.
+//
+// Rows and columns are ordered by the natural order of their vertices (see
+// gogl.VertexLess), so the ordering is consistent across runs. Each pixel (i,j) is black
+// if there is an edge from vertex i to vertex j, and white otherwise; undirected graphs
+// are thus rendered symmetrically. For weighted graphs, intensity is proportional to the
+// absolute value of the weight, relative to the largest absolute weight in the graph.
+//
+// The image is Order x Order pixels, except for graphs with fewer than 256 vertices,
+// where each cell is scaled up to a square of 256/Order pixels (rounded down). An error
+// is returned if the graph has no vertices.
+func WriteMatrixPNG(g gogl.Graph, w io.Writer) error {
+	vertices := sortedVertices(g)
+	n := len(vertices)
+	if n == 0 {
+		return errors.New("Cannot render an empty graph.")
+	}
+
+	index := make(map[gogl.Vertex]int, n)
+	for i, v := range vertices {
+		index[v] = i
+	}
+
+	scale := 1
+	if n < minMatrixPNGSize {
+		scale = minMatrixPNGSize / n
+	}
+
+	// First pass finds the max weight, so intensities can be normalized.
+	var max float64
+	_, weighted := g.(gogl.WeightedGraph)
+	if weighted {
+		g.Edges(func(e gogl.Edge) (terminate bool) {
+			if we, ok := e.(gogl.WeightedEdge); ok {
+				max = math.Max(max, math.Abs(we.Weight()))
+			}
+			return
+		})
+	}
+
+	img := image.NewGray(image.Rect(0, 0, n*scale, n*scale))
+	for i := range img.Pix {
+		img.Pix[i] = 255
+	}
+
+	fill := func(i, j int, c color.Gray) {
+		for x := i * scale; x < (i+1)*scale; x++ {
+			for y := j * scale; y < (j+1)*scale; y++ {
+				img.SetGray(x, y, c)
+			}
+		}
+	}
+
+	_, directed := g.(gogl.Digraph)
+	g.Edges(func(e gogl.Edge) (terminate bool) {
+		c := color.Gray{0}
+		if we, ok := e.(gogl.WeightedEdge); ok && weighted && max > 0 {
+			c.Y = uint8(255 - math.Round(255*math.Abs(we.Weight())/max))
+		}
+
+		u, v := e.Both()
+		// Columns are targets, rows are sources
+		fill(index[v], index[u], c)
+		if !directed {
+			fill(index[u], index[v], c)
+		}
+		return
+	})
+
+	return png.Encode(w, img)
+}
@@ -0,0 +1,89 @@
+package viz
+
+import (
+	"bytes"
+	"image"
+	"image/png"
+	"testing"
+
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+	"github.com/sdboyer/gogl/spec"
+)
+
+// Hook gocheck into the go test runner
+func Test(t *testing.T) { TestingT(t) }
+
+type MatrixPNGSuite struct{}
+
+var _ = Suite(&MatrixPNGSuite{})
+
+func (s *MatrixPNGSuite) TestDimensions(c *C) {
+	g := gogl.Spec().Directed().Using(spec.GraphFixtures["arctest"]).Create(al.G)
+
+	var buf bytes.Buffer
+	c.Assert(WriteMatrixPNG(g, &buf), IsNil)
+
+	img, err := png.Decode(&buf)
+	c.Assert(err, IsNil)
+	// 4 vertices, scaled up to 64px cells
+	c.Assert(img.Bounds(), Equals, image.Rect(0, 0, 256, 256))
+
+	// "bar" sorts first, "baz" second; bar->baz is an arc, baz->bar is not.
+	gray := img.(*image.Gray)
+	c.Assert(gray.GrayAt(64, 0).Y, Equals, uint8(0))
+	c.Assert(gray.GrayAt(0, 64).Y, Equals, uint8(255))
+}
+
+func (s *MatrixPNGSuite) TestNaturalOrder(c *C) {
+	g := gogl.Spec().Directed().Using(gogl.ArcList{gogl.NewArc(10, 2)}).Create(al.G)
+
+	var buf bytes.Buffer
+	c.Assert(WriteMatrixPNG(g, &buf), IsNil)
+	img, err := png.Decode(&buf)
+	c.Assert(err, IsNil)
+
+	// 2 sorts before 10, though "10" sorts before "2".
+	gray := img.(*image.Gray)
+	c.Assert(gray.GrayAt(0, 128).Y, Equals, uint8(0))
+	c.Assert(gray.GrayAt(128, 0).Y, Equals, uint8(255))
+}
+
+func (s *MatrixPNGSuite) TestLargeGraphUnscaled(c *C) {
+	el := gogl.EdgeList{}
+	for i := 0; i < 300; i++ {
+		el = append(el, gogl.NewEdge(i, (i+1)%300))
+	}
+
+	var buf bytes.Buffer
+	c.Assert(WriteMatrixPNG(gogl.Spec().Using(el).Create(al.G), &buf), IsNil)
+
+	cfg, err := png.DecodeConfig(&buf)
+	c.Assert(err, IsNil)
+	c.Assert(cfg.Width, Equals, 300)
+	c.Assert(cfg.Height, Equals, 300)
+}
+
+func (s *MatrixPNGSuite) TestWeightedIntensity(c *C) {
+	g := gogl.Spec().Weighted().Using(gogl.WeightedEdgeList{
+		gogl.NewWeightedEdge("a", "b", 4),
+		gogl.NewWeightedEdge("b", "c", 2),
+	}).Create(al.G)
+
+	var buf bytes.Buffer
+	c.Assert(WriteMatrixPNG(g, &buf), IsNil)
+	img, err := png.Decode(&buf)
+	c.Assert(err, IsNil)
+
+	// 85px cells; a-b is the heaviest edge, b-c half as heavy
+	gray := img.(*image.Gray)
+	c.Assert(gray.GrayAt(85, 0).Y, Equals, uint8(0))
+	c.Assert(gray.GrayAt(170, 85).Y, Equals, uint8(127))
+	c.Assert(gray.GrayAt(0, 0).Y, Equals, uint8(255))
+}
+
+func (s *MatrixPNGSuite) TestEmpty(c *C) {
+	var buf bytes.Buffer
+	c.Assert(WriteMatrixPNG(gogl.NullGraph, &buf), NotNil)
+}
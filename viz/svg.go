@@ -0,0 +1,130 @@
+package viz
+
+import (
+	"bufio"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/sdboyer/gogl"
+)
+
+// Dimensions, in SVG user units, of the drawing produced by WriteSVG.
+const (
+	svgSize   = 600.0
+	svgMargin = 30.0
+	svgRadius = 10.0
+)
+
+// Draws the provided graph as a standalone SVG document, writing it to the given writer.
+// This makes it possible to embed graph drawings in web pages and reports without any
+// external dependency, such as Graphviz.
+//
+// Vertex positions are taken from coords, as produced by ForceDirectedLayout; the layout
+// is scaled uniformly to fit a 600x600 canvas. Each vertex is drawn as a labeled circle
+// with class "vertex", and each edge as a line (or, for loops, a path) with class "edge".
+// Digraphs get arrowheads at the target end of each arc. In weighted graphs, each edge's
+// weight is written at its midpoint as text with class "weight".
+//
+// Vertices are labeled with their string representation (via %v). An error is returned
+// if any vertex lacks coordinates.
+func WriteSVG(g gogl.Graph, coords map[gogl.Vertex][2]float64, w io.Writer) error {
+	vertices := sortedVertices(g)
+	for _, v := range vertices {
+		if _, exists := coords[v]; !exists {
+			return fmt.Errorf("No coordinates provided for vertex %v.", v)
+		}
+	}
+
+	// Fit the layout's bounding box to the canvas, preserving its aspect ratio.
+	minx, miny := math.Inf(1), math.Inf(1)
+	maxx, maxy := math.Inf(-1), math.Inf(-1)
+	for _, v := range vertices {
+		p := coords[v]
+		minx, maxx = math.Min(minx, p[0]), math.Max(maxx, p[0])
+		miny, maxy = math.Min(miny, p[1]), math.Max(maxy, p[1])
+	}
+	scale := 1.0
+	if span := math.Max(maxx-minx, maxy-miny); span > 0 {
+		scale = (svgSize - 2*svgMargin) / span
+	}
+	pos := func(v gogl.Vertex) (float64, float64) {
+		p := coords[v]
+		return svgMargin + (p[0]-minx)*scale, svgMargin + (p[1]-miny)*scale
+	}
+
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, `<svg xmlns="http://www.w3.org/2000/svg" width="%v" height="%v" viewBox="0 0 %v %v">`+"\n", svgSize, svgSize, svgSize, svgSize)
+
+	dg, directed := g.(gogl.Digraph)
+	marker := ""
+	if directed {
+		bw.WriteString(`<defs><marker id="arrow" viewBox="0 0 10 10" refX="10" refY="5" markerWidth="6" markerHeight="6" orient="auto"><path d="M0,0 L10,5 L0,10 z"/></marker></defs>` + "\n")
+		marker = ` marker-end="url(#arrow)"`
+	}
+
+	bw.WriteString(`<g stroke="black" fill="none">` + "\n")
+	var labels []string
+	drawEdge := func(e gogl.Edge) (terminate bool) {
+		u, v := e.Both()
+		x1, y1 := pos(u)
+		var lx, ly float64
+
+		if u == v {
+			fmt.Fprintf(bw, `<path class="edge" d="M%s,%s a%s,%s 0 1,1 %s,0"%s/>`+"\n",
+				num(x1-svgRadius/2), num(y1-svgRadius), num(svgRadius/2*1.2), num(svgRadius*1.2), num(svgRadius), marker)
+			lx, ly = x1, y1-3*svgRadius
+		} else {
+			// Trim the line to the circles' edges, so arrowheads are not hidden.
+			x2, y2 := pos(v)
+			dx, dy := x2-x1, y2-y1
+			if d := math.Hypot(dx, dy); d > 2*svgRadius {
+				x1, y1 = x1+dx/d*svgRadius, y1+dy/d*svgRadius
+				x2, y2 = x2-dx/d*svgRadius, y2-dy/d*svgRadius
+			}
+			fmt.Fprintf(bw, `<line class="edge" x1="%s" y1="%s" x2="%s" y2="%s"%s/>`+"\n", num(x1), num(y1), num(x2), num(y2), marker)
+			lx, ly = (x1+x2)/2, (y1+y2)/2
+		}
+
+		if we, ok := e.(gogl.WeightedEdge); ok {
+			labels = append(labels, fmt.Sprintf(`<text class="weight" x="%s" y="%s">%s</text>`, num(lx), num(ly-3), escape(num(we.Weight()))))
+		}
+		return
+	}
+	if directed {
+		dg.Arcs(func(a gogl.Arc) (terminate bool) {
+			return drawEdge(a)
+		})
+	} else {
+		g.Edges(drawEdge)
+	}
+	bw.WriteString("</g>\n")
+
+	bw.WriteString(`<g font-family="sans-serif" font-size="10" text-anchor="middle">` + "\n")
+	for _, l := range labels {
+		bw.WriteString(l + "\n")
+	}
+	for _, v := range vertices {
+		x, y := pos(v)
+		fmt.Fprintf(bw, `<circle class="vertex" cx="%s" cy="%s" r="%s" fill="white" stroke="black"/>`+"\n", num(x), num(y), num(svgRadius))
+		fmt.Fprintf(bw, `<text class="label" x="%s" y="%s">%s</text>`+"\n", num(x), num(y+3), escape(fmt.Sprintf("%v", v)))
+	}
+	bw.WriteString("</g>\n</svg>\n")
+
+	return bw.Flush()
+}
+
+// Formats a number compactly for use in SVG attributes.
+func num(f float64) string {
+	return strconv.FormatFloat(math.Round(f*100)/100, 'f', -1, 64)
+}
+
+// Escapes text for inclusion in XML character data.
+func escape(s string) string {
+	var b strings.Builder
+	xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
@@ -0,0 +1,94 @@
+package viz
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io"
+	"strings"
+
+	. "github.com/sdboyer/gocheck"
+	"github.com/sdboyer/gogl"
+	"github.com/sdboyer/gogl/graph/al"
+)
+
+type SVGSuite struct{}
+
+var _ = Suite(&SVGSuite{})
+
+// Parses the SVG document, checking that it is well-formed, and counts elements by class.
+func svgClasses(c *C, doc []byte) map[string]int {
+	counts := make(map[string]int)
+	dec := xml.NewDecoder(bytes.NewReader(doc))
+
+	var root bool
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			break
+		}
+		c.Assert(err, IsNil)
+
+		if se, ok := tok.(xml.StartElement); ok {
+			if !root {
+				c.Assert(se.Name.Local, Equals, "svg")
+				c.Assert(se.Name.Space, Equals, "http://www.w3.org/2000/svg")
+				root = true
+			}
+			for _, attr := range se.Attr {
+				if attr.Name.Local == "class" {
+					counts[attr.Value]++
+				}
+			}
+		}
+	}
+	c.Assert(root, Equals, true)
+	return counts
+}
+
+func (s *SVGSuite) TestUndirected(c *C) {
+	g := gogl.Spec().Using(gogl.WithIsolates(gogl.EdgeList{
+		gogl.NewEdge("a", "b"),
+		gogl.NewEdge("b", "c"),
+		gogl.NewEdge("c", "c"),
+	}, "<&>")).Create(al.G)
+
+	var buf bytes.Buffer
+	c.Assert(WriteSVG(g, ForceDirectedLayout(g, 50, nil), &buf), IsNil)
+
+	counts := svgClasses(c, buf.Bytes())
+	c.Assert(counts, DeepEquals, map[string]int{"vertex": 4, "label": 4, "edge": 3})
+	c.Assert(strings.Contains(buf.String(), "marker"), Equals, false)
+	c.Assert(strings.Contains(buf.String(), "&lt;&amp;&gt;"), Equals, true)
+}
+
+func (s *SVGSuite) TestWeightedDigraph(c *C) {
+	g := gogl.Spec().Directed().Weighted().Using(gogl.WeightedArcList{
+		gogl.NewWeightedArc(1, 2, 1.5),
+		gogl.NewWeightedArc(2, 1, 3),
+		gogl.NewWeightedArc(2, 3, -2),
+	}).Create(al.G)
+	coords := map[gogl.Vertex][2]float64{1: {0, 0}, 2: {1, 0}, 3: {1, 1}}
+
+	var buf bytes.Buffer
+	c.Assert(WriteSVG(g, coords, &buf), IsNil)
+
+	counts := svgClasses(c, buf.Bytes())
+	c.Assert(counts, DeepEquals, map[string]int{"vertex": 3, "label": 3, "edge": 3, "weight": 3})
+	c.Assert(strings.Count(buf.String(), `marker-end="url(#arrow)"`), Equals, 3)
+	c.Assert(strings.Contains(buf.String(), ">1.5</text>"), Equals, true)
+	c.Assert(strings.Contains(buf.String(), ">-2</text>"), Equals, true)
+}
+
+func (s *SVGSuite) TestMissingCoords(c *C) {
+	g := gogl.Spec().Using(gogl.EdgeList{gogl.NewEdge("a", "b")}).Create(al.G)
+	err := WriteSVG(g, map[gogl.Vertex][2]float64{"a": {0, 0}}, &bytes.Buffer{})
+	c.Assert(err, ErrorMatches, ".*vertex b.*")
+}
+
+func (s *SVGSuite) TestSingleVertex(c *C) {
+	g := gogl.Spec().Using(gogl.WithIsolates(gogl.EdgeList{}, "a")).Create(al.G)
+
+	var buf bytes.Buffer
+	c.Assert(WriteSVG(g, map[gogl.Vertex][2]float64{"a": {5, 5}}, &buf), IsNil)
+	c.Assert(svgClasses(c, buf.Bytes()), DeepEquals, map[string]int{"vertex": 1, "label": 1})
+}